@@ -0,0 +1,254 @@
+package alerts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lbryio/chainquery/metrics"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Interval is how often the rules are evaluated.
+var Interval = 30 * time.Second
+
+// DefaultCooldown is the cooldown of the rules that don't set one.
+var DefaultCooldown = 30 * time.Minute
+
+// Alert is a message about a rule firing or resolved. The key tells apart the alerts of a rule, like the job failing,
+// and is empty for rules about the whole chain.
+type Alert struct {
+	Rule     string    `json:"rule"`
+	Kind     string    `json:"kind"`
+	Key      string    `json:"key,omitempty"`
+	Message  string    `json:"message"`
+	Resolved bool      `json:"resolved"`
+	Since    time.Time `json:"since"`
+	At       time.Time `json:"at"`
+}
+
+// Title is the one line summary of the alert.
+func (a Alert) Title() string {
+	state := "FIRING"
+	if a.Resolved {
+		state = "RESOLVED"
+	}
+	title := "[" + state + "] " + a.Rule
+	if a.Key != "" {
+		title += " (" + a.Key + ")"
+	}
+	return title
+}
+
+// Text is the summary of the alert followed by its message.
+func (a Alert) Text() string {
+	text := a.Title() + ": " + a.Message
+	if a.Resolved {
+		text += fmt.Sprintf(" (firing for %s)", a.At.Sub(a.Since).Round(time.Second))
+	}
+	return text
+}
+
+// Sink sends alerts somewhere.
+type Sink interface {
+	Send(alert Alert) error
+}
+
+type namedSink struct {
+	Sink
+	kind string
+}
+
+type state struct {
+	alert    Alert
+	firing   bool
+	notified bool
+	lastSent time.Time
+}
+
+type engine struct {
+	mu     sync.Mutex
+	rules  []Rule
+	sinks  []namedSink
+	states map[string]*state
+	now    func() time.Time
+}
+
+var current = &engine{states: make(map[string]*state), now: time.Now}
+var evaluating atomic.Bool
+
+// Configure sets the rules and sinks, from their settings in the config file. The state of the alerts of rules that
+// are kept is kept too, so a rule firing isn't reported again when the config file changes.
+func Configure(ruleSettings, sinkSettings []map[string]interface{}) error {
+	rules := make([]Rule, 0, len(ruleSettings))
+	names := make(map[string]bool, len(ruleSettings))
+	for _, settings := range ruleSettings {
+		rule, err := ParseRule(settings)
+		if err != nil {
+			return err
+		}
+		if names[rule.Name] {
+			return errors.Err("alert rule %s is declared twice", rule.Name)
+		}
+		names[rule.Name] = true
+		rules = append(rules, rule)
+	}
+	sinks := make([]namedSink, 0, len(sinkSettings))
+	for _, settings := range sinkSettings {
+		sink, err := NewSink(settings)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	current.configure(rules, sinks)
+	return nil
+}
+
+// Enabled tells if there are rules to evaluate.
+func Enabled() bool {
+	current.mu.Lock()
+	defer current.mu.Unlock()
+	return len(current.rules) > 0
+}
+
+// Evaluate evaluates the rules and sends the alerts that fire, are still firing after the cooldown or are resolved.
+func Evaluate() {
+	if !evaluating.CompareAndSwap(false, true) {
+		return
+	}
+	defer evaluating.Store(false)
+	current.evaluate()
+}
+
+func (e *engine) configure(rules []Rule, sinks []namedSink) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	kept := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		kept[rule.Name] = true
+	}
+	for id, s := range e.states {
+		if !kept[s.alert.Rule] {
+			delete(e.states, id)
+			metrics.AlertsFiring.DeleteLabelValues(s.alert.Rule)
+		}
+	}
+	e.rules = rules
+	e.sinks = sinks
+}
+
+func (e *engine) evaluate() {
+	e.mu.Lock()
+	rules := append([]Rule(nil), e.rules...)
+	e.mu.Unlock()
+	reorg := takeReorg()
+	for _, rule := range rules {
+		conditions, err := rule.check(reorg)
+		if err != nil {
+			e.applyError(rule, err)
+			continue
+		}
+		e.apply(rule, conditions)
+	}
+}
+
+// apply updates the alerts of a rule with the conditions firing, by key, and sends the alerts due. The alerts of the
+// keys not firing are resolved.
+func (e *engine) apply(rule Rule, conditions map[string]string) {
+	e.update(rule, conditions, true)
+}
+
+// applyError reports a rule that can't be checked as firing: the node or the database being down is what it watches
+// for. Its other alerts are left as they are, whether they still fire is unknown.
+func (e *engine) applyError(rule Rule, err error) {
+	e.update(rule, map[string]string{"": "could not check the rule: " + err.Error()}, false)
+}
+
+// update fires the alerts of the conditions, resolves the others if resolve is set and sends the alerts due.
+func (e *engine) update(rule Rule, conditions map[string]string, resolve bool) {
+	e.mu.Lock()
+	now := e.now()
+	var due []Alert
+	keys := make([]string, 0, len(conditions))
+	for key := range conditions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		id := rule.Name + "\x00" + key
+		s := e.states[id]
+		if s == nil {
+			s = &state{}
+			e.states[id] = s
+		}
+		if !s.firing {
+			s.firing = true
+			s.notified = false
+			s.alert = Alert{Rule: rule.Name, Kind: rule.Kind, Key: key, Since: now}
+		}
+		s.alert.Message = conditions[key]
+		s.alert.At = now
+		// An alert is sent when it fires, then at most once per cooldown while it keeps firing. It also waits for the
+		// cooldown after the last message, so a flapping rule doesn't flood the sinks.
+		if s.lastSent.IsZero() || now.Sub(s.lastSent) >= rule.Cooldown {
+			s.notified = true
+			s.lastSent = now
+			due = append(due, s.alert)
+		}
+		if rule.event() {
+			// Events don't last, the next one fires again once the cooldown is over.
+			s.firing = false
+		}
+	}
+	firing := 0
+	for _, s := range e.states {
+		if s.alert.Rule != rule.Name || !s.firing {
+			continue
+		}
+		if _, ok := conditions[s.alert.Key]; ok || !resolve {
+			firing++
+			continue
+		}
+		s.firing = false
+		if s.notified {
+			resolved := s.alert
+			resolved.Resolved = true
+			resolved.At = now
+			s.lastSent = now
+			due = append(due, resolved)
+		}
+	}
+	metrics.AlertsFiring.WithLabelValues(rule.Name).Set(float64(firing))
+	sinks := append([]namedSink(nil), e.sinks...)
+	e.mu.Unlock()
+
+	for _, alert := range due {
+		send(sinks, alert)
+	}
+}
+
+func send(sinks []namedSink, alert Alert) {
+	state := "firing"
+	if alert.Resolved {
+		state = "resolved"
+	}
+	metrics.Alerts.WithLabelValues(alert.Rule, state).Inc()
+	if len(sinks) == 0 {
+		logrus.Warning("Alert: ", alert.Text())
+		return
+	}
+	for _, sink := range sinks {
+		err := sink.Send(alert)
+		if err != nil {
+			metrics.AlertSinkFailures.WithLabelValues(sink.kind).Inc()
+			logrus.Error(errors.Prefix("could not send the alert "+strings.ToLower(alert.Title())+" to "+sink.kind, err))
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+type memorySink struct {
+	alerts []Alert
+}
+
+func (m *memorySink) Send(alert Alert) error {
+	m.alerts = append(m.alerts, alert)
+	return nil
+}
+
+func testEngine(rule Rule) (*engine, *memorySink, *time.Time) {
+	sink := &memorySink{}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := &engine{states: make(map[string]*state), now: func() time.Time { return now }}
+	e.configure([]Rule{rule}, []namedSink{{Sink: sink, kind: "memory"}})
+	return e, sink, &now
+}
+
+func TestAlertFiresOnceUntilCooldownAndResolves(t *testing.T) {
+	rule := Rule{Name: "lag", Kind: KindBlockLag, Threshold: 10, Cooldown: 30 * time.Minute}
+	e, sink, now := testEngine(rule)
+	firing := map[string]string{"": "12 blocks behind"}
+
+	e.apply(rule, firing)
+	*now = now.Add(time.Minute)
+	e.apply(rule, firing)
+	if len(sink.alerts) != 1 || sink.alerts[0].Resolved {
+		t.Fatalf("expected a single firing alert, got %+v", sink.alerts)
+	}
+
+	*now = now.Add(30 * time.Minute)
+	e.apply(rule, firing)
+	if len(sink.alerts) != 2 || sink.alerts[1].Since != sink.alerts[0].Since {
+		t.Fatalf("expected the alert to be sent again after the cooldown, got %+v", sink.alerts)
+	}
+
+	*now = now.Add(time.Minute)
+	e.apply(rule, nil)
+	e.apply(rule, nil)
+	if len(sink.alerts) != 3 || !sink.alerts[2].Resolved {
+		t.Fatalf("expected a single resolve message, got %+v", sink.alerts)
+	}
+}
+
+func TestFlappingAlertWaitsForCooldown(t *testing.T) {
+	rule := Rule{Name: "lag", Kind: KindBlockLag, Threshold: 10, Cooldown: 30 * time.Minute}
+	e, sink, now := testEngine(rule)
+	firing := map[string]string{"": "12 blocks behind"}
+
+	e.apply(rule, firing)
+	*now = now.Add(time.Minute)
+	e.apply(rule, nil)
+	*now = now.Add(time.Minute)
+	e.apply(rule, firing)
+	*now = now.Add(time.Minute)
+	e.apply(rule, nil)
+	if len(sink.alerts) != 2 || !sink.alerts[1].Resolved {
+		t.Fatalf("expected the alert to fire and resolve once, got %+v", sink.alerts)
+	}
+
+	*now = now.Add(30 * time.Minute)
+	e.apply(rule, firing)
+	if len(sink.alerts) != 3 || sink.alerts[2].Resolved {
+		t.Fatalf("expected the alert to fire again after the cooldown, got %+v", sink.alerts)
+	}
+}
+
+func TestAlertsOfRuleAreKeyed(t *testing.T) {
+	rule := Rule{Name: "jobs", Kind: KindJobFailures, Threshold: 3, Cooldown: time.Hour}
+	e, sink, _ := testEngine(rule)
+
+	e.apply(rule, map[string]string{"chainsync": "failed", "claimtriesync": "failed"})
+	e.apply(rule, map[string]string{"claimtriesync": "failed"})
+	if len(sink.alerts) != 3 {
+		t.Fatalf("expected two alerts and a resolve message, got %+v", sink.alerts)
+	}
+	if sink.alerts[2].Key != "chainsync" || !sink.alerts[2].Resolved {
+		t.Fatalf("expected chainsync to be resolved, got %+v", sink.alerts[2])
+	}
+}
+
+func TestCheckErrorKeepsAlertsOfRuleFiring(t *testing.T) {
+	rule := Rule{Name: "jobs", Kind: KindJobFailures, Threshold: 3, Cooldown: time.Hour}
+	e, sink, _ := testEngine(rule)
+
+	e.apply(rule, map[string]string{"chainsync": "failed"})
+	e.applyError(rule, errors.Base("database is down"))
+	if len(sink.alerts) != 2 || sink.alerts[1].Key != "" || sink.alerts[1].Resolved {
+		t.Fatalf("expected an alert of the error, got %+v", sink.alerts)
+	}
+	e.apply(rule, map[string]string{"chainsync": "failed"})
+	if len(sink.alerts) != 3 || sink.alerts[2].Key != "" || !sink.alerts[2].Resolved {
+		t.Fatalf("expected only the alert of the error to be resolved, got %+v", sink.alerts)
+	}
+}
+
+func TestEventAlertIsNeverResolved(t *testing.T) {
+	rule := Rule{Name: "reorg", Kind: KindReorgDepth, Threshold: 2, Cooldown: 10 * time.Minute}
+	e, sink, now := testEngine(rule)
+
+	Reorg(1, 100)
+	Reorg(3, 101)
+	conditions, err := rule.check(takeReorg())
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.apply(rule, conditions)
+	e.apply(rule, nil)
+	*now = now.Add(time.Minute)
+	e.apply(rule, conditions)
+	if len(sink.alerts) != 1 || sink.alerts[0].Message != "reorg of depth 3 at height 101" {
+		t.Fatalf("expected a single alert of the deepest reorg, got %+v", sink.alerts)
+	}
+
+	*now = now.Add(10 * time.Minute)
+	e.apply(rule, conditions)
+	if len(sink.alerts) != 2 || sink.alerts[1].Resolved {
+		t.Fatalf("expected the next reorg after the cooldown to fire, got %+v", sink.alerts)
+	}
+}
+
+func TestConfigureKeepsStateOfKeptRules(t *testing.T) {
+	rule := Rule{Name: "lag", Kind: KindBlockLag, Threshold: 10, Cooldown: time.Hour}
+	e, sink, _ := testEngine(rule)
+	e.apply(rule, map[string]string{"": "12 blocks behind"})
+
+	e.configure([]Rule{rule}, e.sinks)
+	e.apply(rule, map[string]string{"": "12 blocks behind"})
+	if len(sink.alerts) != 1 {
+		t.Fatalf("expected the alert not to fire again, got %+v", sink.alerts)
+	}
+	e.configure(nil, e.sinks)
+	if len(e.states) != 0 {
+		t.Fatal("expected the state of removed rules to be dropped")
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := ParseRule(map[string]interface{}{"kind": "block_stall", "window": "15m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Name != KindBlockStall || rule.Window != 15*time.Minute || rule.Cooldown != DefaultCooldown {
+		t.Fatalf("unexpected rule %+v", rule)
+	}
+
+	invalid := []map[string]interface{}{
+		{"kind": "block_height", "threshold": 1},
+		{"kind": "block_lag"},
+		{"kind": "block_stall", "threshold": 1},
+		{"kind": "dlq_growth", "threshold": 5},
+		{"kind": "job_failures", "threshold": 3, "cooldown": "soon"},
+	}
+	for _, settings := range invalid {
+		_, err := ParseRule(settings)
+		if err == nil {
+			t.Errorf("expected an error for %v", settings)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/notifications"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/spf13/cast"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Kinds of rules.
+const (
+	// KindBlockLag fires when the last block stored is more than threshold blocks behind the node.
+	KindBlockLag = "block_lag"
+	// KindBlockStall fires when no block was stored for the window.
+	KindBlockStall = "block_stall"
+	// KindJobFailures fires for each job of job_status that failed at least threshold times in a row.
+	KindJobFailures = "job_failures"
+	// KindReorgDepth fires when a reorg of at least threshold blocks is handled.
+	KindReorgDepth = "reorg_depth"
+	// KindDLQGrowth fires when at least threshold notifications were dead-lettered within the window.
+	KindDLQGrowth = "dlq_growth"
+)
+
+// Rule is an alert rule as declared in the config file. Its threshold and window mean what its kind says they do.
+type Rule struct {
+	Name      string
+	Kind      string
+	Threshold float64
+	Window    time.Duration
+	Cooldown  time.Duration
+}
+
+// ParseRule parses the settings of a rule: kind, name (the kind by default), threshold, window and cooldown.
+func ParseRule(settings map[string]interface{}) (Rule, error) {
+	var rule Rule
+	var err error
+	rule.Kind, err = cast.ToStringE(settings["kind"])
+	if err != nil {
+		return rule, errors.Prefix("kind", errors.Err(err))
+	}
+	rule.Name = rule.Kind
+	if name, ok := settings["name"]; ok {
+		rule.Name, err = cast.ToStringE(name)
+		if err != nil {
+			return rule, errors.Prefix("name", errors.Err(err))
+		}
+	}
+	rule.Threshold, err = cast.ToFloat64E(settings["threshold"])
+	if err != nil {
+		return rule, errors.Prefix("alert rule "+rule.Name+": threshold", errors.Err(err))
+	}
+	durations := map[string]*time.Duration{"window": &rule.Window, "cooldown": &rule.Cooldown}
+	for key, duration := range durations {
+		if value, ok := settings[key]; ok {
+			*duration, err = cast.ToDurationE(value)
+			if err != nil {
+				return rule, errors.Prefix("alert rule "+rule.Name+": "+key, errors.Err(err))
+			}
+		}
+	}
+	if rule.Cooldown <= 0 {
+		rule.Cooldown = DefaultCooldown
+	}
+
+	switch rule.Kind {
+	case KindBlockLag, KindJobFailures, KindReorgDepth:
+		if rule.Threshold <= 0 {
+			return rule, errors.Err("alert rule %s: threshold must be positive", rule.Name)
+		}
+	case KindBlockStall:
+		if rule.Window <= 0 {
+			return rule, errors.Err("alert rule %s: window must be positive", rule.Name)
+		}
+	case KindDLQGrowth:
+		if rule.Threshold <= 0 || rule.Window <= 0 {
+			return rule, errors.Err("alert rule %s: threshold and window must be positive", rule.Name)
+		}
+	default:
+		return rule, errors.Err("unknown alert rule kind %q", rule.Kind)
+	}
+	return rule, nil
+}
+
+// event tells if the rule fires on events rather than on a lasting condition. Its alerts are never resolved.
+func (r Rule) event() bool {
+	return r.Kind == KindReorgDepth
+}
+
+// check returns the messages of the alerts of the rule firing, by key.
+func (r Rule) check(reorg *reorgEvent) (map[string]string, error) {
+	switch r.Kind {
+	case KindBlockLag:
+		return r.checkBlockLag()
+	case KindBlockStall:
+		return r.checkBlockStall()
+	case KindJobFailures:
+		return r.checkJobFailures()
+	case KindReorgDepth:
+		if reorg != nil && float64(reorg.depth) >= r.Threshold {
+			return map[string]string{"": fmt.Sprintf("reorg of depth %d at height %d", reorg.depth, reorg.height)}, nil
+		}
+		return nil, nil
+	case KindDLQGrowth:
+		return r.checkDLQGrowth()
+	}
+	return nil, errors.Err("unknown alert rule kind %q", r.Kind)
+}
+
+func (r Rule) checkBlockLag() (map[string]string, error) {
+	count, err := lbrycrd.GetBlockCount()
+	if err != nil {
+		return nil, errors.Prefix("could not get the block count of the node", err)
+	}
+	var last struct {
+		Height null.Uint64 `boil:"height"`
+	}
+	err = queries.Raw(`SELECT MAX(height) AS height FROM block`).BindG(context.Background(), &last)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if *count > last.Height.Uint64 && float64(*count-last.Height.Uint64) > r.Threshold {
+		return map[string]string{"": fmt.Sprintf("%d blocks behind the node: at height %d of %d",
+			*count-last.Height.Uint64, last.Height.Uint64, *count)}, nil
+	}
+	return nil, nil
+}
+
+func (r Rule) checkBlockStall() (map[string]string, error) {
+	var last struct {
+		Height  null.Uint64 `boil:"height"`
+		Seconds null.Int64  `boil:"seconds"`
+	}
+	err := queries.Raw(`
+		SELECT height, TIMESTAMPDIFF(SECOND, created_at, NOW()) AS seconds
+		FROM block ORDER BY id DESC LIMIT 1`).BindG(context.Background(), &last)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	since := time.Duration(last.Seconds.Int64) * time.Second
+	if last.Seconds.Valid && since > r.Window {
+		return map[string]string{"": fmt.Sprintf("no new block for %s, last at height %d", since, last.Height.Uint64)}, nil
+	}
+	return nil, nil
+}
+
+func (r Rule) checkJobFailures() (map[string]string, error) {
+	jobs, err := model.JobStatuses(model.JobStatusWhere.Failures.GTE(uint(r.Threshold)),
+		qm.OrderBy(model.JobStatusColumns.JobName)).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	conditions := make(map[string]string, len(jobs))
+	for _, job := range jobs {
+		conditions[job.JobName] = fmt.Sprintf("failed %d times in a row, last with: %s", job.Failures,
+			job.ErrorMessage.String)
+	}
+	return conditions, nil
+}
+
+func (r Rule) checkDLQGrowth() (map[string]string, error) {
+	var dead struct {
+		Count uint64 `boil:"count"`
+	}
+	err := queries.Raw(`
+		SELECT COUNT(*) AS count FROM notification_outbox
+		WHERE status = ? AND modified_at > NOW() - INTERVAL ? SECOND`,
+		notifications.OutboxDead, int64(r.Window.Seconds())).BindG(context.Background(), &dead)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if float64(dead.Count) >= r.Threshold {
+		return map[string]string{"": fmt.Sprintf("%d notifications dead-lettered in the last %s", dead.Count, r.Window)}, nil
+	}
+	return nil, nil
+}
+
+type reorgEvent struct {
+	depth  uint64
+	height uint64
+}
+
+var lastReorg *reorgEvent
+var lastReorgMu sync.Mutex
+
+// Reorg records a reorg for the reorg rules, the deepest since the rules were last evaluated fires them.
+func Reorg(depth, height uint64) {
+	lastReorgMu.Lock()
+	defer lastReorgMu.Unlock()
+	if lastReorg == nil || depth > lastReorg.depth {
+		lastReorg = &reorgEvent{depth: depth, height: height}
+	}
+}
+
+func takeReorg() *reorgEvent {
+	lastReorgMu.Lock()
+	defer lastReorgMu.Unlock()
+	reorg := lastReorg
+	lastReorg = nil
+	return reorg
+}
@@ -0,0 +1,201 @@
+package alerts
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/slack-go/slack"
+	"github.com/spf13/cast"
+)
+
+// Timeout bounds sending an alert to a sink.
+var Timeout = 20 * time.Second
+
+// SlackToken and SlackChannel are the defaults of the slack sinks, the slackbottoken and slackchannel of the config.
+var SlackToken, SlackChannel string
+
+// NewSink returns the sink of its settings, its kind being one of:
+//
+//	slack: posts to the channel (slackchannel by default) as the bot of the token (slackbottoken by default).
+//	webhook: posts the alert as JSON to the url.
+//	email: mails the alert from an address to addresses through the SMTP server of an smtp://[user:password@]host:port
+//	url.
+func NewSink(settings map[string]interface{}) (namedSink, error) {
+	kind := cast.ToString(settings["kind"])
+	text := func(key, fallback string) string {
+		if value, ok := settings[key]; ok {
+			return cast.ToString(value)
+		}
+		return fallback
+	}
+	var sink Sink
+	switch kind {
+	case "slack":
+		token, channel := text("token", SlackToken), text("channel", SlackChannel)
+		if token == "" || channel == "" {
+			return namedSink{}, errors.Err("slack alert sink: token and channel are required")
+		}
+		sink = &SlackSink{poster: slack.New(token, slack.OptionHTTPClient(&http.Client{Timeout: Timeout})), channel: channel}
+	case "webhook":
+		u, err := url.Parse(text("url", ""))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return namedSink{}, errors.Err("webhook alert sink: url must be an http or https url")
+		}
+		sink = &WebhookSink{URL: u.String(), client: &http.Client{Timeout: Timeout}}
+	case "email":
+		u, err := url.Parse(text("url", ""))
+		if err != nil || u.Scheme != "smtp" || u.Port() == "" {
+			return namedSink{}, errors.Err("email alert sink: url must be smtp://[user:password@]host:port")
+		}
+		to, err := cast.ToStringSliceE(settings["to"])
+		if err != nil || len(to) == 0 {
+			return namedSink{}, errors.Err("email alert sink: to is required")
+		}
+		from := text("from", "")
+		if from == "" {
+			return namedSink{}, errors.Err("email alert sink: from is required")
+		}
+		sink = &EmailSink{Server: u, From: from, To: to}
+	default:
+		return namedSink{}, errors.Err("unknown alert sink kind %q", kind)
+	}
+	return namedSink{Sink: sink, kind: kind}, nil
+}
+
+type slackMessagePoster interface {
+	PostMessage(channelID string, options ...slack.MsgOption) (string, string, error)
+}
+
+// SlackSink posts alerts to a Slack channel.
+type SlackSink struct {
+	poster  slackMessagePoster
+	channel string
+}
+
+// Send posts the alert with its message as an attachment, red while firing and green once resolved.
+func (s *SlackSink) Send(alert Alert) error {
+	_, _, err := s.poster.PostMessage(s.channel,
+		slack.MsgOptionText(alert.Title(), false),
+		slack.MsgOptionAttachments(slackAttachment(alert)))
+	return errors.Err(err)
+}
+
+func slackAttachment(alert Alert) slack.Attachment {
+	color := "danger"
+	if alert.Resolved {
+		color = "good"
+	}
+	text := alert.Text()
+	return slack.Attachment{
+		Color:    color,
+		Fallback: text,
+		Text:     alert.Message,
+		Fields: []slack.AttachmentField{
+			{Title: "kind", Value: alert.Kind, Short: true},
+			{Title: "since", Value: alert.Since.UTC().Format(time.RFC3339), Short: true},
+		},
+	}
+}
+
+// WebhookSink posts alerts as JSON to a url.
+type WebhookSink struct {
+	URL    string
+	client *http.Client
+}
+
+// Send posts the alert, failing unless the response is a 2xx.
+func (w *WebhookSink) Send(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Err(err)
+	}
+	res, err := w.client.Post(w.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Err(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.Err("webhook responded %s", res.Status)
+	}
+	return nil
+}
+
+// EmailSink mails alerts through an SMTP server.
+type EmailSink struct {
+	Server *url.URL
+	From   string
+	To     []string
+}
+
+// Send mails the alert, over TLS if the server supports STARTTLS and authenticating with the user and password of the
+// server url if it has them.
+func (e *EmailSink) Send(alert Alert) error {
+	conn, err := net.DialTimeout("tcp", e.Server.Host, Timeout)
+	if err != nil {
+		return errors.Err(err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(Timeout))
+	client, err := smtp.NewClient(conn, e.Server.Hostname())
+	if err != nil {
+		_ = conn.Close()
+		return errors.Err(err)
+	}
+	defer func() { _ = client.Close() }()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: e.Server.Hostname()})
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	if e.Server.User != nil {
+		password, _ := e.Server.User.Password()
+		err = client.Auth(smtp.PlainAuth("", e.Server.User.Username(), password, e.Server.Hostname()))
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	err = client.Mail(e.From)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, to := range e.To {
+		err = client.Rcpt(to)
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return errors.Err(err)
+	}
+	_, err = w.Write(e.message(alert))
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = w.Close()
+	if err != nil {
+		return errors.Err(err)
+	}
+	return errors.Err(client.Quit())
+}
+
+func (e *EmailSink) message(alert Alert) []byte {
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", e.From)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(e.To, ", "))
+	fmt.Fprintf(&message, "Subject: [chainquery] %s\r\n", alert.Title())
+	fmt.Fprintf(&message, "Date: %s\r\n", alert.At.Format(time.RFC1123Z))
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&message, "%s\r\n\r\nkind: %s\r\nsince: %s\r\n", alert.Text(), alert.Kind, alert.Since.UTC().Format(time.RFC3339))
+	return []byte(message.String())
+}
@@ -0,0 +1,145 @@
+package alerts
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testAlert = Alert{
+	Rule:    "lag",
+	Kind:    KindBlockLag,
+	Message: "12 blocks behind the node",
+	Since:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	At:      time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC),
+}
+
+func TestWebhookSinkPostsAlert(t *testing.T) {
+	var received Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(map[string]interface{}{"kind": "webhook", "url": server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testAlert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.Rule != "lag" || received.Message != testAlert.Message {
+		t.Fatalf("unexpected alert %+v", received)
+	}
+}
+
+func TestWebhookSinkFailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(map[string]interface{}{"kind": "webhook", "url": server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sink.Send(testAlert) == nil {
+		t.Fatal("expected an error for a 502 response")
+	}
+}
+
+// smtpStandIn accepts a single mail and hands over its data.
+func smtpStandIn(t *testing.T) (string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mails := make(chan string, 1)
+	go func() {
+		defer func() { _ = listener.Close() }()
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ESMTP")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					mails <- data.String()
+					reply("250 queued")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+			switch command := strings.ToUpper(strings.Fields(line)[0]); command {
+			case "EHLO", "HELO":
+				reply("250 localhost")
+			case "DATA":
+				inData = true
+				reply("354 go ahead")
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), mails
+}
+
+func TestEmailSinkMailsAlert(t *testing.T) {
+	address, mails := smtpStandIn(t)
+	sink, err := NewSink(map[string]interface{}{
+		"kind": "email",
+		"url":  "smtp://" + address,
+		"from": "chainquery@localhost",
+		"to":   []interface{}{"ops@localhost"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testAlert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mail := <-mails
+	if !strings.Contains(mail, "Subject: [chainquery] [FIRING] lag\r\n") || !strings.Contains(mail, testAlert.Message) {
+		t.Fatalf("unexpected mail %q", mail)
+	}
+}
+
+func TestNewSinkRequiresSettings(t *testing.T) {
+	invalid := []map[string]interface{}{
+		{"kind": "pager"},
+		{"kind": "webhook", "url": "ftp://localhost"},
+		{"kind": "email", "url": "smtp://localhost:25", "from": "chainquery@localhost"},
+		{"kind": "email", "url": "smtp://localhost", "from": "chainquery@localhost", "to": []string{"ops@localhost"}},
+		{"kind": "slack", "channel": "alerts"},
+	}
+	for _, settings := range invalid {
+		_, err := NewSink(settings)
+		if err == nil {
+			t.Errorf("expected an error for %v", settings)
+		}
+	}
+}
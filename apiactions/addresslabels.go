@@ -0,0 +1,103 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/auth"
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// AddressLabelsAction lists the labeled addresses, optionally of a single category.
+func AddressLabelsAction(r *http.Request) api.Response {
+	params := struct {
+		Category string
+		Limit    int
+		Offset   int
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+		v.Field(&params.Offset, v.Min(0)),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+
+	mods := []qm.QueryMod{qm.OrderBy(model.AddressLabelColumns.Address), qm.Limit(params.Limit), qm.Offset(params.Offset)}
+	if params.Category != "" {
+		mods = append(mods, model.AddressLabelWhere.Category.EQ(params.Category))
+	}
+	labels, err := model.AddressLabels(mods...).AllG()
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: labels}
+}
+
+// SetAddressLabelAction labels an address if authorized, replacing the label it had.
+func SetAddressLabelAction(r *http.Request) api.Response {
+	params := struct {
+		Address  string
+		Label    string
+		Category string
+		Source   string
+		Key      string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Address, v.Required, v.Length(1, 40)),
+		v.Field(&params.Label, v.Required, v.Length(1, 255)),
+		v.Field(&params.Category, v.Required, v.Length(1, 40)),
+		v.Field(&params.Source, v.Length(0, 255)),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	label := &model.AddressLabel{Address: params.Address, Label: params.Label, Category: params.Category}
+	if params.Source != "" {
+		label.Source = null.StringFrom(params.Source)
+	}
+	err = datastore.PutAddressLabel(label)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: "OK"}
+}
+
+// RemoveAddressLabelAction removes the label of an address if authorized.
+func RemoveAddressLabelAction(r *http.Request) api.Response {
+	params := struct {
+		Address string
+		Key     string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Address, v.Required),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	err = datastore.DeleteAddressLabel(params.Address)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: "OK"}
+}
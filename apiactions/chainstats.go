@@ -0,0 +1,62 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ChainStatsAction returns the aggregated network stats of the hours or days between from and to, given as unix times,
+// oldest first. Without a range the most recent periods are returned.
+func ChainStatsAction(r *http.Request) api.Response {
+	params := struct {
+		Period string
+		From   uint64
+		To     uint64
+		Limit  int
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Period, v.In("hour", "day")),
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if params.Period == "" {
+		params.Period = "day"
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+	if params.To != 0 && params.To < params.From {
+		return api.Response{Error: errors.Err("to must not be before from"), Status: http.StatusBadRequest}
+	}
+
+	c := model.ChainStatColumns
+	mods := []qm.QueryMod{model.ChainStatWhere.Period.EQ(params.Period)}
+	if params.From != 0 {
+		mods = append(mods, model.ChainStatWhere.PeriodStart.GTE(params.From), qm.OrderBy(c.PeriodStart))
+	} else {
+		mods = append(mods, qm.OrderBy(c.PeriodStart+" DESC"))
+	}
+	if params.To != 0 {
+		mods = append(mods, model.ChainStatWhere.PeriodStart.LTE(params.To))
+	}
+	mods = append(mods, qm.Limit(params.Limit))
+	stats, err := model.ChainStats(mods...).AllG()
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	if params.From == 0 {
+		for i, j := 0, len(stats)-1; i < j; i, j = i+1, j-1 {
+			stats[i], stats[j] = stats[j], stats[i]
+		}
+	}
+	return api.Response{Data: stats}
+}
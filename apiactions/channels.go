@@ -0,0 +1,30 @@
+package apiactions
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+)
+
+// ChannelStatsAction returns the rollup of a channel: its claims by type, the supports and tips its claims received,
+// their purchases, the heights it published at and the tags it uses.
+func ChannelStatsAction(r *http.Request) api.Response {
+	channelID := mux.Vars(r)["claim_id"]
+	if channelID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+	stats, err := model.ChannelStats(model.ChannelStatWhere.ChannelClaimID.EQ(channelID)).OneG()
+	if errors.Is(err, sql.ErrNoRows) {
+		return api.Response{Error: errors.Err("no stats found for channel %s", channelID), Status: http.StatusNotFound}
+	}
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: stats}
+}
@@ -0,0 +1,53 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const defaultListLimit = 100
+const maxListLimit = 1000
+
+// ClaimVersionsAction returns the versions of a claim, oldest first. Versions still in the mempool are listed last.
+func ClaimVersionsAction(r *http.Request) api.Response {
+	params := struct {
+		Limit  int
+		Offset int
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+		v.Field(&params.Offset, v.Min(0)),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	claimID := mux.Vars(r)["claim_id"]
+	if claimID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+
+	versions, err := model.ClaimVersions(
+		model.ClaimVersionWhere.ClaimID.EQ(claimID),
+		qm.OrderBy(model.ClaimVersionColumns.Height+" = 0, "+model.ClaimVersionColumns.Height+", "+model.ClaimVersionColumns.ID),
+		qm.Limit(params.Limit),
+		qm.Offset(params.Offset),
+	).AllG()
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	if len(versions) == 0 && params.Offset == 0 {
+		return api.Response{Error: errors.Err("no versions found for claim %s", claimID), Status: http.StatusNotFound}
+	}
+	return api.Response{Data: versions}
+}
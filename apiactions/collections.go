@@ -0,0 +1,55 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/db"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Collection is a list claim along with the claims it references, in the order of the list.
+type Collection struct {
+	ClaimID  string                `json:"claim_id"`
+	Name     string                `json:"name"`
+	Title    null.String           `json:"title"`
+	Type     null.String           `json:"type"`
+	ListType null.Int16            `json:"list_type"`
+	Members  []db.CollectionMember `json:"members"`
+}
+
+// CollectionAction returns a collection, or the featured list of a channel, with its member claims in order.
+func CollectionAction(r *http.Request) api.Response {
+	claimID := mux.Vars(r)["claim_id"]
+	if claimID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+
+	c := model.ClaimColumns
+	claim, err := model.Claims(qm.Select(c.ClaimID, c.Name, c.Title, c.Type, c.ListType),
+		model.ClaimWhere.ClaimID.EQ(claimID), model.ClaimWhere.HasClaimList.EQ(null.BoolFrom(true))).OneG()
+	if err != nil {
+		return api.Response{Error: errors.Err("no collection found for claim %s", claimID), Status: http.StatusNotFound}
+	}
+	members, err := db.GetCollectionMembers(claimID)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	if members == nil {
+		members = []db.CollectionMember{}
+	}
+	return api.Response{Data: Collection{
+		ClaimID:  claim.ClaimID,
+		Name:     claim.Name,
+		Title:    claim.Title,
+		Type:     claim.Type,
+		ListType: claim.ListType,
+		Members:  members,
+	}}
+}
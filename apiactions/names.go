@@ -0,0 +1,73 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/datastore"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// NameStateAction returns the claimtrie state of a name computed from the stored claims and supports, at the local
+// chain height unless a height is passed.
+func NameStateAction(r *http.Request) api.Response {
+	params := struct {
+		Height uint64
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	name := mux.Vars(r)["name"]
+	if name == "" {
+		return api.Response{Error: errors.Err("name is required"), Status: http.StatusBadRequest}
+	}
+	if params.Height == 0 {
+		params.Height, err = datastore.GetChainHeight()
+		if err != nil {
+			return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+		}
+	}
+
+	state, err := claimtrie.GetState(name, params.Height)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: state}
+}
+
+// NameHistoryAction returns the ranges of heights each claim controlled a name. With a height only the range covering
+// it is returned.
+func NameHistoryAction(r *http.Request) api.Response {
+	params := struct {
+		Height uint64
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	name := mux.Vars(r)["name"]
+	if name == "" {
+		return api.Response{Error: errors.Err("name is required"), Status: http.StatusBadRequest}
+	}
+
+	history, err := claimtrie.GetHistory(name)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	if params.Height == 0 {
+		return api.Response{Data: history}
+	}
+	covering := make([]claimtrie.ControllingRange, 0, 1)
+	for _, controlling := range history {
+		if uint64(controlling.FromHeight) <= params.Height && (!controlling.ToHeight.Valid || params.Height <= uint64(controlling.ToHeight.Int32)) {
+			covering = append(covering, controlling)
+		}
+	}
+	return api.Response{Data: covering}
+}
@@ -0,0 +1,81 @@
+package apiactions
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/mux"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// expectTakeovers expects the query of the takeovers of name, a takeover to claim a at 100, to b at 200 and the loss
+// of the name at 300.
+func expectTakeovers(t *testing.T) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := boil.GetDB()
+	boil.SetDB(db)
+	t.Cleanup(func() {
+		boil.SetDB(old)
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+	mock.ExpectQuery("SELECT .* FROM `takeover`").
+		WithArgs("name", claimtrie.NormalizedNameForkHeight, "name", claimtrie.NormalizedNameForkHeight).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "claim_id", "height"}).
+			AddRow(1, "name", "a", 100).
+			AddRow(2, "name", "b", 200).
+			AddRow(3, "name", nil, 300))
+}
+
+func nameHistory(t *testing.T, query string) []claimtrie.ControllingRange {
+	t.Helper()
+	r := mux.SetURLVars(httptest.NewRequest("GET", "/api/names/name/history"+query, nil), map[string]string{"name": "name"})
+	response := NameHistoryAction(r)
+	if response.Error != nil {
+		t.Fatal(response.Error)
+	}
+	return response.Data.([]claimtrie.ControllingRange)
+}
+
+func TestNameHistoryAction(t *testing.T) {
+	expectTakeovers(t)
+
+	history := nameHistory(t, "")
+	expected := []claimtrie.ControllingRange{
+		{ClaimID: "a", FromHeight: 100, ToHeight: null.Int32From(199)},
+		{ClaimID: "b", FromHeight: 200, ToHeight: null.Int32From(299)},
+	}
+	if !reflect.DeepEqual(history, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, history)
+	}
+}
+
+func TestNameHistoryActionAtHeight(t *testing.T) {
+	testCases := []struct {
+		height   string
+		expected []claimtrie.ControllingRange
+	}{
+		{"250", []claimtrie.ControllingRange{{ClaimID: "b", FromHeight: 200, ToHeight: null.Int32From(299)}}},
+		{"199", []claimtrie.ControllingRange{{ClaimID: "a", FromHeight: 100, ToHeight: null.Int32From(199)}}},
+		{"300", []claimtrie.ControllingRange{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.height, func(t *testing.T) {
+			expectTakeovers(t)
+			history := nameHistory(t, "?height="+testCase.height)
+			if !reflect.DeepEqual(history, testCase.expected) {
+				t.Fatalf("expected %+v, got %+v", testCase.expected, history)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package apiactions
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/lbryio/chainquery/auth"
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/notifications"
+	"github.com/lbryio/chainquery/sockety"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NotificationOutboxAction lists the notifications of the outbox if authorized, by default the dead letters, oldest
+// first.
+func NotificationOutboxAction(r *http.Request) api.Response {
+	params := struct {
+		Status     string
+		Subscriber string
+		Limit      int
+		Offset     int
+		Key        string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Status, v.In(notifications.OutboxPending, notifications.OutboxDelivered, notifications.OutboxDead)),
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+		v.Field(&params.Offset, v.Min(0)),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+	if params.Status == "" {
+		params.Status = notifications.OutboxDead
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+
+	mods := []qm.QueryMod{
+		model.NotificationOutboxWhere.Status.EQ(params.Status),
+		qm.OrderBy(model.NotificationOutboxColumns.ID),
+		qm.Limit(params.Limit),
+		qm.Offset(params.Offset),
+	}
+	if params.Subscriber != "" {
+		mods = append(mods, model.NotificationOutboxWhere.Subscriber.EQ(params.Subscriber))
+	}
+	outbox, err := model.NotificationOutboxes(mods...).AllG()
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: outbox}
+}
+
+// ReplayNotificationsAction moves dead letters back to the notification outbox if authorized, either a single one by
+// id or all those of a subscriber.
+func ReplayNotificationsAction(r *http.Request) api.Response {
+	params := struct {
+		ID         uint64
+		Subscriber string
+		Key        string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.ID),
+		v.Field(&params.Subscriber),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if params.ID == 0 && params.Subscriber == "" {
+		return api.Response{Error: errors.Err("id or subscriber is required"), Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	replayed, err := notifications.ReplayDeadLetters(params.ID, params.Subscriber)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: map[string]int64{"replayed": replayed}}
+}
+
+// NotificationStatusAction returns the delivery status of each subscriber if authorized, with the number of sockety
+// notifications waiting to be sent.
+func NotificationStatusAction(r *http.Request) api.Response {
+	params := struct {
+		Key string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	err = notifications.LoadStoredSubscribers()
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	subscribers, err := notifications.Status()
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: map[string]interface{}{
+		"subscribers":   subscribers,
+		"sockety_queue": sockety.QueueDepth(),
+	}}
+}
+
+// NotificationSubscribersAction lists the subscribers in use if authorized, those of the config file and those stored
+// through the API, with their secrets hidden.
+func NotificationSubscribersAction(r *http.Request) api.Response {
+	params := struct {
+		Key string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	err = notifications.LoadStoredSubscribers()
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: notifications.Subscribers()}
+}
+
+// AddNotificationSubscriberAction stores a subscriber of an event type if authorized, replacing the stored subscriber
+// of the same url and type. Its params are a JSON object of the settings and static params a subscriber has in the
+// config file, sent in the form body so its secrets stay out of the logged url. The subscriber survives restarts and is
+// picked up by the daemon without one.
+func AddNotificationSubscriberAction(r *http.Request) api.Response {
+	params := struct {
+		URL    string
+		Type   string
+		Params string
+		Key    string
+	}{}
+	err := formBody(r)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	err = api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.URL, v.Required),
+		v.Field(&params.Type, v.Required),
+		v.Field(&params.Params),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+	u, err := url.Parse(params.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return api.Response{Error: errors.Err("url must be an http or https url"), Status: http.StatusBadRequest}
+	}
+
+	subscriber := notifications.Subscriber{URL: params.URL, Type: params.Type}
+	if params.Params != "" {
+		err = json.Unmarshal([]byte(params.Params), &subscriber.Params)
+		if err != nil {
+			return api.Response{Error: errors.Prefix("params must be a JSON object", errors.Err(err)), Status: http.StatusBadRequest}
+		}
+	}
+	err = notifications.StoreSubscriber(subscriber)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	return api.Response{Data: notifications.Subscribers()}
+}
+
+// RemoveNotificationSubscriberAction removes the stored subscriber of a url if authorized, of a type or of all types.
+// Subscribers of the config file are kept, they can only be removed from the config file.
+func RemoveNotificationSubscriberAction(r *http.Request) api.Response {
+	params := struct {
+		URL  string
+		Type string
+		Key  string
+	}{}
+	err := formBody(r)
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	err = api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.URL, v.Required),
+		v.Field(&params.Type),
+		v.Field(&params.Key),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if !auth.IsAuthorized(params.Key) {
+		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
+	}
+
+	removed, err := notifications.RemoveStoredSubscriber(params.URL, params.Type)
+	if errors.Is(err, notifications.ErrConfiguredSubscriber) {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	} else if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: map[string]int64{"removed": removed}}
+}
+
+// formBody makes the params of a request those of its form body, refusing params in its url.
+func formBody(r *http.Request) error {
+	if r.URL.RawQuery != "" {
+		return errors.Err("params must be sent in the form body, not in the url")
+	}
+	err := r.ParseForm()
+	if err != nil {
+		return errors.Err(err)
+	}
+	r.Form = r.PostForm
+	return nil
+}
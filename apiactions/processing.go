@@ -4,17 +4,17 @@ import (
 	"net/http"
 
 	"github.com/lbryio/chainquery/auth"
+	"github.com/lbryio/chainquery/daemon/claimtrie"
 	"github.com/lbryio/chainquery/daemon/jobs"
 	"github.com/lbryio/chainquery/daemon/processing"
+	"github.com/lbryio/chainquery/datastore"
 	"github.com/lbryio/chainquery/lbrycrd"
-	"github.com/lbryio/chainquery/model"
 
 	"github.com/lbryio/lbry.go/v2/extras/api"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
 	v "github.com/lbryio/ozzo-validation"
 	"github.com/sirupsen/logrus"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 // ProcessBlocks processed a specific block or range of blocks if authorized.
@@ -82,7 +82,8 @@ func processBlocks(block, from, to *uint64) error {
 	return nil
 }
 
-// SyncName syncs the claims for a give name with the lbrycrd claimtrie ( effective amount, valid at height, and bidstate).
+// SyncName recomputes the claimtrie state of a name at the local chain height and verifies it against lbrycrd when
+// lbrycrd is at the same height.
 func SyncName(r *http.Request) api.Response {
 
 	params := struct {
@@ -91,7 +92,7 @@ func SyncName(r *http.Request) api.Response {
 	}{}
 
 	err := api.FormValues(r, &params, []*v.FieldRules{
-		v.Field(&params.Name),
+		v.Field(&params.Name, v.Required),
 		v.Field(&params.Key),
 	})
 	if err != nil {
@@ -102,27 +103,36 @@ func SyncName(r *http.Request) api.Response {
 		return api.Response{Error: errors.Err("not authorized"), Status: http.StatusUnauthorized}
 	}
 
-	claims, err := model.Claims(qm.Where(model.ClaimColumns.Name+"=?", params.Name), qm.Limit(1)).AllG()
+	height, err := datastore.GetChainHeight()
 	if err != nil {
 		return api.Response{Error: errors.Err(err)}
 	}
-
-	count, err := lbrycrd.GetBlockCount()
+	processing.BlockLock.Lock()
+	err = claimtrie.UpdateNames([]string{params.Name}, height)
+	processing.BlockLock.Unlock()
 	if err != nil {
-		logrus.Error("ClaimTrieSyncAsync: Error getting block height", err)
 		return api.Response{Error: errors.Err(err)}
 	}
 
-	err = jobs.SyncClaims(claims)
+	result := struct {
+		Name       string                   `json:"name"`
+		Height     uint64                   `json:"height"`
+		Verified   bool                     `json:"verified"`
+		Mismatches []jobs.ClaimTrieMismatch `json:"mismatches"`
+	}{Name: params.Name, Height: height}
+	count, err := lbrycrd.GetBlockCount()
 	if err != nil {
-		return api.Response{Error: errors.Err(err)}
+		logrus.Error("SyncName: Error getting block height", err)
+		return api.Response{Data: result}
 	}
-
-	err = jobs.SetControllingClaimForNames(claims, *count)
-	if err != nil {
-		return api.Response{Error: errors.Err(err)}
+	if *count == height {
+		result.Mismatches, err = jobs.VerifyName(params.Name, height)
+		if err != nil {
+			logrus.Error("SyncName: ", err)
+		}
+		result.Verified = err == nil
 	}
 
-	return api.Response{Data: "ok"}
+	return api.Response{Data: result}
 
 }
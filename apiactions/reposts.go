@@ -0,0 +1,62 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/global"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+)
+
+const maxRepostDepth = 10
+
+// RepostResolution is the claim a chain of reposts leads to. Claim is nil when the last reposted claim is not known
+// yet, either because it is invalid or because it was not mined when the repost was. IsAbandoned is set for a claim
+// spent or expired, which is no longer in the claimtrie either way.
+type RepostResolution struct {
+	Chain          []string     `json:"chain"`
+	Claim          *model.Claim `json:"claim"`
+	MissingClaimID string       `json:"missing_claim_id,omitempty"`
+	IsAbandoned    bool         `json:"is_abandoned"`
+}
+
+// ClaimOriginalAction follows a repost, and reposts of reposts, to the original claim and returns it with its current
+// metadata. A claim that is not a repost resolves to itself.
+func ClaimOriginalAction(r *http.Request) api.Response {
+	claimID := mux.Vars(r)["claim_id"]
+	if claimID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+	claim := datastore.GetClaim(claimID)
+	if claim == nil {
+		return api.Response{Error: errors.Err("no claim found for %s", claimID), Status: http.StatusNotFound}
+	}
+
+	resolution := RepostResolution{Chain: []string{claim.ClaimID}}
+	for claim.Type.String == global.ClaimReferenceClaimType && claim.ClaimReference.Valid {
+		if len(resolution.Chain) > maxRepostDepth {
+			return api.Response{Error: errors.Err("repost chain of %s is longer than %d", claimID, maxRepostDepth), Status: http.StatusUnprocessableEntity}
+		}
+		reference := claim.ClaimReference.String
+		for _, seen := range resolution.Chain {
+			if seen == reference {
+				return api.Response{Error: errors.Err("repost chain of %s is circular", claimID), Status: http.StatusUnprocessableEntity}
+			}
+		}
+		claim = datastore.GetClaim(reference)
+		if claim == nil {
+			resolution.MissingClaimID = reference
+			return api.Response{Data: resolution}
+		}
+		resolution.Chain = append(resolution.Chain, claim.ClaimID)
+	}
+	resolution.Claim = claim
+	resolution.IsAbandoned = claim.BidState == claimtrie.BidStateSpent || claim.BidState == claimtrie.BidStateExpired
+	return api.Response{Data: resolution}
+}
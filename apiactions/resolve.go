@@ -0,0 +1,143 @@
+package apiactions
+
+import (
+	"database/sql"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	lbryurl "github.com/lbryio/lbry.go/v2/url"
+
+	v "github.com/lbryio/ozzo-validation"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Resolution is the claim a LBRY URL points to along with the channel that signed it.
+type Resolution struct {
+	URL     string       `json:"url"`
+	Claim   *model.Claim `json:"claim"`
+	Channel *model.Claim `json:"channel"`
+}
+
+// urlModifier selects one of the claims of a name: by claim id prefix, by the order the claims were made in (*n) or
+// by their position in the bids of the name ($n).
+type urlModifier struct {
+	claimID     string
+	sequence    int
+	bidPosition int
+}
+
+var sequenceSuffix = regexp.MustCompile(`^(.+)\*([0-9]+)$`)
+
+// withSequence splits a *n sequence modifier off a name, since the URL parser leaves it as part of the name.
+func withSequence(name string, modifier urlModifier) (string, urlModifier) {
+	matches := sequenceSuffix.FindStringSubmatch(name)
+	if matches == nil {
+		return name, modifier
+	}
+	modifier.sequence, _ = strconv.Atoi(matches[2])
+	return matches[1], modifier
+}
+
+// streamClaimID returns the claim id of the stream in a channel URL path. The parser falls back to the claim id of the
+// channel when the stream has none, so it is taken from the path instead.
+func streamClaimID(path string) string {
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	if i := strings.IndexAny(parts[1], "#:"); i >= 0 {
+		return parts[1][i+1:]
+	}
+	return ""
+}
+
+// ResolveAction resolves a LBRY URL like lbry://name, lbry://name#claimid, lbry://name$2, lbry://name*1,
+// lbry://@channel/name or lbry://@channel#id/name:id against the stored claims. Without a modifier a name resolves to
+// its controlling claim, and a name within a channel to the first claim the channel made with it. Claims that were
+// abandoned or expired never resolve.
+func ResolveAction(r *http.Request) api.Response {
+	params := struct {
+		URL string
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.URL, v.Required),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	uri, err := lbryurl.Parse(params.URL, false)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusBadRequest}
+	}
+
+	resolution := Resolution{URL: params.URL}
+	primary, primaryModifier := withSequence(uri.ClaimName, urlModifier{claimID: uri.ClaimId, bidPosition: uri.PrimaryBidPosition})
+	claim, err := resolveName(primary, primaryModifier, "")
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusInternalServerError}
+	}
+	if claim == nil {
+		return api.Response{Error: errors.Err("could not resolve %s", params.URL), Status: http.StatusNotFound}
+	}
+	if strings.HasPrefix(uri.ClaimName, "@") && uri.StreamName != "" {
+		channel := claim
+		stream, streamModifier := withSequence(uri.StreamName, urlModifier{claimID: streamClaimID(uri.Path), bidPosition: uri.SecondaryBidPosition})
+		claim, err = resolveName(stream, streamModifier, channel.ClaimID)
+		if err != nil {
+			return api.Response{Error: err, Status: http.StatusInternalServerError}
+		}
+		if claim == nil {
+			return api.Response{Error: errors.Err("could not resolve %s in %s", uri.StreamName, channel.Name), Status: http.StatusNotFound}
+		}
+	}
+	resolution.Claim = claim
+	if claim.PublisherID.Valid {
+		resolution.Channel, err = model.Claims(model.ClaimWhere.ClaimID.EQ(claim.PublisherID.String)).OneG()
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+		}
+	}
+	return api.Response{Data: resolution}
+}
+
+// resolveName returns the claim of a name a modifier selects, or nil if there is none. With a channel only claims
+// validly signed by it are considered.
+func resolveName(name string, modifier urlModifier, channelID string) (*model.Claim, error) {
+	c := model.ClaimColumns
+	mods := []qm.QueryMod{
+		model.ClaimWhere.Name.EQ(name),
+		model.ClaimWhere.BidState.NIN([]string{claimtrie.BidStateSpent, claimtrie.BidStateExpired}),
+	}
+	if channelID != "" {
+		mods = append(mods, model.ClaimWhere.PublisherID.EQ(null.StringFrom(channelID)), model.ClaimWhere.IsCertValid.EQ(true))
+	}
+	switch {
+	case modifier.claimID != "":
+		mods = append(mods, qm.Where(c.ClaimID+" LIKE ?", strings.ToLower(modifier.claimID)+"%"), qm.OrderBy(c.ID))
+	case modifier.sequence > 0:
+		mods = append(mods, qm.OrderBy(c.ID), qm.Offset(modifier.sequence-1))
+	case modifier.bidPosition > 0:
+		mods = append(mods, qm.OrderBy(c.EffectiveAmount+" DESC, "+c.ID), qm.Offset(modifier.bidPosition-1))
+	case channelID != "":
+		mods = append(mods, qm.OrderBy(c.ID))
+	default:
+		mods = append(mods, qm.Where(c.BidState+" = ?", claimtrie.BidStateControlling))
+	}
+	mods = append(mods, qm.Limit(1))
+	claim, err := model.Claims(mods...).OneG()
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	return claim, nil
+}
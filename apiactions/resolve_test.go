@@ -0,0 +1,49 @@
+package apiactions
+
+import (
+	"testing"
+)
+
+func TestWithSequence(t *testing.T) {
+	testCases := []struct {
+		name             string
+		modifier         urlModifier
+		expectedName     string
+		expectedModifier urlModifier
+	}{
+		{"name", urlModifier{}, "name", urlModifier{}},
+		{"name*2", urlModifier{}, "name", urlModifier{sequence: 2}},
+		{"@channel*1", urlModifier{}, "@channel", urlModifier{sequence: 1}},
+		{"name*10", urlModifier{bidPosition: 3}, "name", urlModifier{sequence: 10, bidPosition: 3}},
+		{"name*", urlModifier{}, "name*", urlModifier{}},
+		{"*2", urlModifier{}, "*2", urlModifier{}},
+		{"name*a", urlModifier{claimID: "abc"}, "name*a", urlModifier{claimID: "abc"}},
+	}
+	for _, testCase := range testCases {
+		name, modifier := withSequence(testCase.name, testCase.modifier)
+		if name != testCase.expectedName || modifier != testCase.expectedModifier {
+			t.Errorf("%s: expected %s %+v, got %s %+v", testCase.name, testCase.expectedName, testCase.expectedModifier,
+				name, modifier)
+		}
+	}
+}
+
+func TestStreamClaimID(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{"@channel", ""},
+		{"@channel#abc", ""},
+		{"@channel/stream", ""},
+		{"@channel#abc/stream", ""},
+		{"@channel/stream#def", "def"},
+		{"@channel#abc/stream:def", "def"},
+		{"@channel:abc/stream#d", "d"},
+	}
+	for _, testCase := range testCases {
+		if got := streamClaimID(testCase.path); got != testCase.expected {
+			t.Errorf("%s: expected %q, got %q", testCase.path, testCase.expected, got)
+		}
+	}
+}
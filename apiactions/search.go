@@ -0,0 +1,61 @@
+package apiactions
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/lbryio/chainquery/db"
+	"github.com/lbryio/chainquery/global"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	v "github.com/lbryio/ozzo-validation"
+)
+
+// SearchAction searches the title, description and author of claims, ranked by relevance and effective amount. Tags
+// are comma separated and a claim matches if it has any of them.
+func SearchAction(r *http.Request) api.Response {
+	params := struct {
+		Q           string
+		ClaimType   string
+		ContentType string
+		Tags        string
+		Channel     string
+		NSFW        *bool `json:"nsfw"`
+		Language    string
+		Limit       int
+		Offset      int
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Q, v.Required),
+		v.Field(&params.ClaimType, v.In(global.StreamClaimType, global.ChannelClaimType, global.ClaimListClaimType, global.ClaimReferenceClaimType)),
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+		v.Field(&params.Offset, v.Min(0)),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+
+	search := db.ClaimSearch{
+		Query:       params.Q,
+		ClaimType:   params.ClaimType,
+		ContentType: params.ContentType,
+		ChannelID:   params.Channel,
+		NSFW:        params.NSFW,
+		Language:    params.Language,
+	}
+	for _, tag := range strings.Split(params.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			search.Tags = append(search.Tags, tag)
+		}
+	}
+	results, err := db.SearchClaims(search, params.Limit, params.Offset)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: results}
+}
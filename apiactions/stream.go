@@ -0,0 +1,232 @@
+package apiactions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lbryio/chainquery/stream"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+const streamKeepAlive = 15 * time.Second
+const streamWriteTimeout = 10 * time.Second
+
+var streamUpgrader = websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+
+// StreamAction streams new blocks, mempool transactions, claims and payments as server-sent events, or as JSON
+// messages over a websocket when the request is an upgrade. Clients select messages with comma separated types,
+// addresses, channel_ids and names. A client resuming from a height, given as from_height or by the Last-Event-ID of
+// server-sent events, first gets the messages of the blocks from that height on.
+func StreamAction(w http.ResponseWriter, r *http.Request) {
+	params := struct {
+		Types      string `json:"types"`
+		Addresses  string `json:"addresses"`
+		ChannelIDs string `json:"channel_ids"`
+		Names      string `json:"names"`
+		FromHeight uint64 `json:"from_height"`
+	}{}
+	err := api.FormValues(r, &params, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter := stream.Filter{
+		Types:      splitList(params.Types),
+		Addresses:  splitList(params.Addresses),
+		ChannelIDs: splitList(params.ChannelIDs),
+		Names:      splitList(params.Names),
+	}
+	for _, t := range filter.Types {
+		if !slices.Contains(stream.Types, t) {
+			http.Error(w, "types: unknown type "+t, http.StatusBadRequest)
+			return
+		}
+	}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" && params.FromHeight == 0 {
+		// Messages of the last height seen may have been missed, it is sent again.
+		params.FromHeight, err = strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			http.Error(w, "Last-Event-ID must be a height", http.StatusBadRequest)
+			return
+		}
+	}
+
+	messages, head, unsubscribe, err := stream.Subscribe()
+	if err != nil {
+		logrus.Error(errors.Prefix("Stream", err))
+		http.Error(w, "stream unavailable", http.StatusInternalServerError)
+		return
+	}
+	defer unsubscribe()
+	if params.FromHeight > 0 && params.FromHeight+stream.MaxResumeBlocks < head {
+		http.Error(w, fmt.Sprintf("from_height must be at most %d blocks behind the head %d", stream.MaxResumeBlocks, head),
+			http.StatusBadRequest)
+		return
+	}
+
+	var client streamClient
+	if websocket.IsWebSocketUpgrade(r) {
+		client, err = newWebsocketClient(w, r)
+	} else {
+		client, err = newEventStreamClient(w, r)
+	}
+	if err != nil {
+		logrus.Debug(errors.Prefix("Stream", err))
+		return
+	}
+	defer client.close()
+
+	send := func(message stream.Message) error {
+		if !filter.Matches(message) {
+			return nil
+		}
+		return client.send(message)
+	}
+	if params.FromHeight > 0 && params.FromHeight <= head {
+		err = stream.Replay(params.FromHeight, head, send)
+		if err != nil {
+			logrus.Debug(errors.Prefix("Stream", err))
+			return
+		}
+	}
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return // too far behind, the client resumes from its last height
+			}
+			err = send(message)
+		case <-keepAlive.C:
+			err = client.keepAlive()
+		case <-client.done():
+			return
+		}
+		if err != nil {
+			logrus.Debug(errors.Prefix("Stream", err))
+			return
+		}
+	}
+}
+
+type streamClient interface {
+	send(message stream.Message) error
+	keepAlive() error
+	done() <-chan struct{}
+	close()
+}
+
+// eventStreamClient sends server-sent events, with the height of mined messages as id.
+type eventStreamClient struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	closed  <-chan struct{}
+}
+
+func newEventStreamClient(w http.ResponseWriter, r *http.Request) (*eventStreamClient, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return nil, errors.Err("response writer can't flush")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &eventStreamClient{w: w, flusher: flusher, closed: r.Context().Done()}, nil
+}
+
+func (c *eventStreamClient) send(message stream.Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if message.Height > 0 {
+		_, err = fmt.Fprintf(c.w, "id: %d\n", message.Height)
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	_, err = fmt.Fprintf(c.w, "event: %s\ndata: %s\n\n", message.Type, data)
+	if err != nil {
+		return errors.Err(err)
+	}
+	c.flusher.Flush()
+	return nil
+}
+
+func (c *eventStreamClient) keepAlive() error {
+	_, err := fmt.Fprint(c.w, ": keepalive\n\n")
+	if err != nil {
+		return errors.Err(err)
+	}
+	c.flusher.Flush()
+	return nil
+}
+
+func (c *eventStreamClient) done() <-chan struct{} { return c.closed }
+
+func (c *eventStreamClient) close() {}
+
+// websocketClient sends each message as a JSON text message.
+type websocketClient struct {
+	conn   *websocket.Conn
+	closed chan struct{}
+}
+
+func newWebsocketClient(w http.ResponseWriter, r *http.Request) (*websocketClient, error) {
+	conn, err := streamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	c := &websocketClient{conn: conn, closed: make(chan struct{})}
+	// Messages from the client are ignored, reading is how the close of the connection is noticed.
+	go func() {
+		defer close(c.closed)
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return c, nil
+}
+
+func (c *websocketClient) send(message stream.Message) error {
+	err := c.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil {
+		return errors.Err(err)
+	}
+	return errors.Err(c.conn.WriteJSON(message))
+}
+
+func (c *websocketClient) keepAlive() error {
+	return errors.Err(c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)))
+}
+
+func (c *websocketClient) done() <-chan struct{} { return c.closed }
+
+func (c *websocketClient) close() { _ = c.conn.Close() }
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,80 @@
+package apiactions
+
+import (
+	"net/http"
+
+	"github.com/lbryio/chainquery/db"
+
+	"github.com/lbryio/lbry.go/v2/extras/api"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/gorilla/mux"
+	v "github.com/lbryio/ozzo-validation"
+)
+
+var tipIntervalFormats = map[string]string{
+	"hour":  "%Y-%m-%d %H:00",
+	"day":   "%Y-%m-%d",
+	"week":  "%x-W%v",
+	"month": "%Y-%m",
+}
+
+// ChannelTipsAction returns the tips received by a channel and the claims it published, grouped by interval.
+func ChannelTipsAction(r *http.Request) api.Response {
+	params := struct {
+		Interval string
+		From     uint64
+		To       uint64
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Interval, v.In("hour", "day", "week", "month")),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	channelID := mux.Vars(r)["claim_id"]
+	if channelID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+	if params.Interval == "" {
+		params.Interval = "day"
+	}
+	if params.To != 0 && params.To < params.From {
+		return api.Response{Error: errors.Err("to must not be before from"), Status: http.StatusBadRequest}
+	}
+
+	tips, err := db.GetChannelTips(channelID, tipIntervalFormats[params.Interval], params.From, params.To)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: tips}
+}
+
+// ClaimSupportersAction returns the addresses supporting a claim, largest active support first.
+func ClaimSupportersAction(r *http.Request) api.Response {
+	params := struct {
+		TipsOnly bool
+		Limit    int
+		Offset   int
+	}{}
+	err := api.FormValues(r, &params, []*v.FieldRules{
+		v.Field(&params.Limit, v.Min(0), v.Max(maxListLimit)),
+		v.Field(&params.Offset, v.Min(0)),
+	})
+	if err != nil {
+		return api.Response{Error: err, Status: http.StatusBadRequest}
+	}
+	claimID := mux.Vars(r)["claim_id"]
+	if claimID == "" {
+		return api.Response{Error: errors.Err("claim_id is required"), Status: http.StatusBadRequest}
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultListLimit
+	}
+
+	supporters, err := db.GetTopSupporters(claimID, params.TipsOnly, params.Limit, params.Offset)
+	if err != nil {
+		return api.Response{Error: errors.Err(err), Status: http.StatusInternalServerError}
+	}
+	return api.Response{Data: supporters}
+}
@@ -20,7 +20,6 @@ var apiCmd = &cobra.Command{
 	Short: "Start only the api server",
 	Long:  `This runs the API Server for chainquery only. The daemon does not run, however, the db is still required and all APIs are available.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		config.InitSlack()
 		//Main Chainquery DB connection
 		dbInstance, err := db.Init(config.GetMySQLDSN(), config.GetDebugQueryMode())
 		if err != nil {
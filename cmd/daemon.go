@@ -19,7 +19,6 @@ var daemonCmd = &cobra.Command{
 	Short: "Run only Daemon routines",
 	Long:  `Run only Daemon routines, without the API Server. Check github.com/lbryio/chainquery#what-does-chainquery-consist-of`,
 	Run: func(cmd *cobra.Command, args []string) {
-		config.InitSlack()
 		//Main Chainquery DB connection
 		dbInstance, err := db.Init(config.GetMySQLDSN(), config.GetDebugQueryMode())
 		if err != nil {
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lbryio/chainquery/config"
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/db"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+)
+
+func init() {
+	labelCmd.AddCommand(labelSetCmd, labelRemoveCmd, labelImportCmd)
+	rootCmd.AddCommand(labelCmd)
+}
+
+var labelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Manages the labels of addresses",
+	Long:  `Labels known addresses, like those of exchanges or the team, so they show up in address summaries.`,
+}
+
+var labelSetCmd = &cobra.Command{
+	Use:   "set <address> <label> <category> [source]",
+	Short: "Labels an address, replacing the label it had",
+	Args:  cobra.RangeArgs(3, 4),
+	Run: func(cmd *cobra.Command, args []string) {
+		withLabelDB(func() error {
+			source := ""
+			if len(args) == 4 {
+				source = args[3]
+			}
+			return putLabel(args[0], args[1], args[2], source)
+		})
+	},
+}
+
+var labelRemoveCmd = &cobra.Command{
+	Use:   "remove <address>",
+	Short: "Removes the label of an address",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		withLabelDB(func() error {
+			return datastore.DeleteAddressLabel(args[0])
+		})
+	},
+}
+
+var labelImportCmd = &cobra.Command{
+	Use:   "import <file.csv>",
+	Short: "Labels the addresses of a CSV file",
+	Long: `Labels the addresses of a CSV file with the columns address, label, category and optionally source, like a
+spreadsheet exported as CSV. A header row starting with "address" is skipped.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		withLabelDB(func() error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return errors.Err(err)
+			}
+			defer func() { _ = file.Close() }()
+			reader := csv.NewReader(file)
+			reader.FieldsPerRecord = -1
+			imported := 0
+			for line := 1; ; line++ {
+				record, err := reader.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return errors.Err(err)
+				}
+				if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+					continue
+				}
+				if len(record) < 3 {
+					return errors.Err("line %d: expected address, label, category and optionally source", line)
+				}
+				source := ""
+				if len(record) > 3 {
+					source = record[3]
+				}
+				err = putLabel(record[0], record[1], record[2], source)
+				if err != nil {
+					return errors.Prefix(fmt.Sprintf("line %d", line), err)
+				}
+				imported++
+			}
+			logrus.Infof("Imported %d address labels", imported)
+			return nil
+		})
+	},
+}
+
+func putLabel(address, label, category, source string) error {
+	address, label, category = strings.TrimSpace(address), strings.TrimSpace(label), strings.TrimSpace(category)
+	if address == "" || label == "" || category == "" {
+		return errors.Err("address, label and category are required")
+	}
+	addressLabel := &model.AddressLabel{Address: address, Label: label, Category: category}
+	if source = strings.TrimSpace(source); source != "" {
+		addressLabel.Source = null.StringFrom(source)
+	}
+	return datastore.PutAddressLabel(addressLabel)
+}
+
+func withLabelDB(run func() error) {
+	//Main Chainquery DB connection
+	dbInstance, err := db.Init(config.GetMySQLDSN(), config.GetDebugQueryMode())
+	if err != nil {
+		logrus.Panic(err)
+	}
+	defer db.CloseDB(dbInstance)
+	err = run()
+	if err != nil {
+		logrus.Fatal(errors.FullTrace(err))
+	}
+}
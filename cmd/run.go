@@ -34,6 +34,9 @@ var jobsMap = map[string]func(){
 	"transactionvalue": jobs.TransactionValueSync,
 	"chain":            jobs.ChainSync,
 	"outputfix":        jobs.OutputFixSync,
+	"abnormalclaims":   jobs.AbnormalClaimSync,
+	"chainstats":       jobs.ChainStatSync,
+	"addressclusters":  jobs.AddressClusterSync,
 }
 
 var runCmd = &cobra.Command{
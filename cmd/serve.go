@@ -27,7 +27,6 @@ var serveCmd = &cobra.Command{
 		if viper.GetBool("codeprofile") {
 			defer profile.Start(profile.NoShutdownHook).Stop()
 		}
-		config.InitSlack()
 		//Main Chainquery DB connection
 		dbInstance, err := db.Init(config.GetMySQLDSN(), config.GetDebugQueryMode())
 		if err != nil {
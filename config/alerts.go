@@ -0,0 +1,53 @@
+package config
+
+import (
+	"github.com/lbryio/chainquery/alerts"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+)
+
+// applyAlerts sets the alert rules and sinks of the config file. Without sinks, the alerts go to the slack channel if
+// slackbottoken and slackchannel are set.
+func applyAlerts() error {
+	alerts.SlackToken = viper.GetString(slackbottoken)
+	alerts.SlackChannel = viper.GetString(slackchannel)
+	alerts.DefaultCooldown = viper.GetDuration(alertcooldown)
+	rules, err := settingsList(viper.Get(alertrules))
+	if err != nil {
+		return errors.Prefix("alert rules", err)
+	}
+	sinks, err := settingsList(viper.Get(alertsinks))
+	if err != nil {
+		return errors.Prefix("alert sinks", err)
+	}
+	if len(sinks) == 0 && alerts.SlackToken != "" && alerts.SlackChannel != "" {
+		sinks = append(sinks, map[string]interface{}{"kind": "slack"})
+	}
+	return alerts.Configure(rules, sinks)
+}
+
+// settingsList is the list of settings of an array of tables of the config file.
+func settingsList(value interface{}) ([]map[string]interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if list, ok := value.([]map[string]interface{}); ok {
+		return list, nil
+	}
+	items, err := cast.ToSliceE(value)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	list := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		settings, err := cast.ToStringMapE(item)
+		if err != nil {
+			return nil, errors.Err("could not find the settings of an item: %v", err)
+		}
+		list = append(list, settings)
+	}
+	return list, nil
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSettingsListOfArrayOfTables(t *testing.T) {
+	expected := []map[string]interface{}{{"kind": "block_lag", "threshold": int64(10)}}
+	list, err := settingsList([]interface{}{map[string]interface{}{"kind": "block_lag", "threshold": int64(10)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(list, expected) {
+		t.Fatalf("expected %v, got %v", expected, list)
+	}
+	list, err = settingsList(expected)
+	if err != nil || !reflect.DeepEqual(list, expected) {
+		t.Fatalf("expected %v, got %v (%v)", expected, list, err)
+	}
+	_, err = settingsList([]interface{}{"block_lag"})
+	if err == nil {
+		t.Fatal("expected an error for an item without settings")
+	}
+}
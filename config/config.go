@@ -6,12 +6,15 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/lbryio/chainquery/sockety"
 
 	"github.com/lbryio/chainquery/notifications"
 
+	"github.com/lbryio/chainquery/alerts"
 	"github.com/lbryio/chainquery/apiactions"
 	"github.com/lbryio/chainquery/auth"
 	"github.com/lbryio/chainquery/daemon"
@@ -20,6 +23,7 @@ import (
 	"github.com/lbryio/chainquery/db"
 	"github.com/lbryio/chainquery/global"
 	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/publisher"
 	server "github.com/lbryio/chainquery/swagger/apiserver/go"
 
 	"github.com/lbryio/lbry.go/v2/extras/errors"
@@ -58,7 +62,6 @@ const ( // config setting keys
 	apihostport               = "apihostport"
 	slackbottoken             = "slackbottoken"
 	slackchannel              = "slackchannel"
-	slackloglevel             = "slackloglevel"
 	apikeys                   = "apikeys"
 	maxfailures               = "maxfailures"
 	blockchainname            = "blockchainname"
@@ -72,6 +75,16 @@ const ( // config setting keys
 	prompass                  = "prompass"
 	socketytoken              = "socketytoken"
 	socketyurl                = "socketyurl"
+	autorepairchain           = "autorepairchain"
+	chainrepairlimit          = "chainrepairlimit"
+	chainrepairdelay          = "chainrepairdelay"
+	addressclustering         = "addressclustering"
+	notificationmaxattempts   = "notificationmaxattempts"
+	changepublisherurl        = "changepublisherurl"
+	changepublishersubject    = "changepublishersubject"
+	alertcooldown             = "alerts.cooldown"
+	alertrules                = "alerts.rules"
+	alertsinks                = "alerts.sinks"
 )
 
 const (
@@ -122,11 +135,10 @@ func readConfig() {
 	if err != nil {                                      // Handle errors reading the config file
 		logrus.Warning("Error reading config file...defaults will be used: ", err)
 	}
-	notifications.ClearSubscribers()
 	subscriptions := viper.GetStringMap("subscription")
 	err = applySubscribers(subscriptions)
 	if err != nil {
-		logrus.Error("could not apply subsribers: ", err)
+		logrus.Error("could not apply subscribers: ", err)
 	}
 }
 
@@ -156,7 +168,6 @@ func initDefaults() {
 	viper.SetDefault(mysqlprofile, false)
 	viper.SetDefault("codeprofile", false)
 	viper.SetDefault(apihostport, "0.0.0.0:6300")
-	viper.SetDefault(slackloglevel, int(logrus.WarnLevel))
 	viper.SetDefault(maxfailures, 1000)
 	viper.SetDefault(blockchainname, "lbrycrd_main")
 	viper.SetDefault(chainsyncrunduration, 60)
@@ -165,6 +176,14 @@ func initDefaults() {
 	viper.SetDefault(maxparalleltxprocessing, runtime.NumCPU())
 	viper.SetDefault(maxparallelvinprocessing, runtime.NumCPU())
 	viper.SetDefault(maxparallelvoutprocessing, runtime.NumCPU())
+	viper.SetDefault(autorepairchain, false)
+	viper.SetDefault(chainrepairlimit, 10)
+	viper.SetDefault(chainrepairdelay, 1000)
+	viper.SetDefault(addressclustering, false)
+	viper.SetDefault(notificationmaxattempts, 10)
+	viper.SetDefault(changepublisherurl, "")
+	viper.SetDefault(changepublishersubject, "chainquery")
+	viper.SetDefault(alertcooldown, 30*time.Minute)
 }
 
 func processConfiguration() {
@@ -205,6 +224,7 @@ func processConfiguration() {
 	lbrycrd.DefaultClientTimeout = GetDefaultClientTimeout()
 	http.DefaultClient.Timeout = GetDefaultClientTimeout()
 	notifications.Timeout = GetDefaultClientTimeout()
+	notifications.MaxAttempts = viper.GetInt(notificationmaxattempts)
 	sockety.Timeout = GetDefaultClientTimeout()
 	auth.APIKeys = viper.GetStringSlice(apikeys)
 	processing.MaxFailures = viper.GetInt(maxfailures)
@@ -214,11 +234,25 @@ func processConfiguration() {
 	global.BlockChainName = viper.GetString(blockchainname)
 	jobs.ChainSyncDelay = viper.GetInt(chainsyncdelay)
 	jobs.ChainSyncRunDuration = viper.GetInt(chainsyncrunduration)
+	jobs.AutoRepairChain = viper.GetBool(autorepairchain)
+	jobs.ChainRepairLimit = viper.GetInt(chainrepairlimit)
+	jobs.ChainRepairDelay = viper.GetInt(chainrepairdelay)
+	jobs.AddressClustering = viper.GetBool(addressclustering)
 	apiactions.MaxSQLAPITimeout = viper.GetInt(maxsqlapitimeout)
 	server.PromUser = viper.GetString(promuser)
 	server.PromPassword = viper.GetString(prompass)
 	sockety.Token = viper.GetString(socketytoken)
 	sockety.URL = viper.GetString(socketyurl)
+	publisher.Timeout = GetDefaultClientTimeout()
+	err := publisher.Configure(viper.GetString(changepublisherurl), viper.GetString(changepublishersubject))
+	if err != nil {
+		logrus.Error("could not configure the change publisher: ", err)
+	}
+	alerts.Timeout = GetDefaultClientTimeout()
+	err = applyAlerts()
+	if err != nil {
+		logrus.Error("could not configure the alerts: ", err)
+	}
 
 	//Flags last so they override everything before, even config
 	if viper.IsSet(debugmodeflag) {
@@ -275,8 +309,16 @@ func getLbrycrdURLFromConfFile() (string, error) {
 	return "rpc://" + userpass + host + port, nil
 }
 
+// applySubscribers replaces the subscribers of the config file with those of the subscription map. The subscribers in
+// place are kept if one of them is invalid. Subscribers of unknown types are skipped, with a warning.
 func applySubscribers(subs map[string]interface{}) error {
+	var subscribers []notifications.Subscriber
 	for subType, p := range subs {
+		if !slices.Contains(notifications.EventTypes, subType) {
+			logrus.Warningf("skipping the subscribers of unknown subscription type %s, the types are %s", subType,
+				strings.Join(notifications.EventTypes, ", "))
+			continue
+		}
 		typeSubsInt, ok := p.([]interface{})
 		if ok {
 			for _, typeSub := range typeSubsInt {
@@ -284,8 +326,7 @@ func applySubscribers(subs map[string]interface{}) error {
 				if ok {
 					url, ok := params["url"].(string)
 					if ok {
-						delete(params, "url")
-						notifications.AddSubscriber(url, subType, params)
+						subscribers = append(subscribers, notifications.Subscriber{URL: url, Type: subType, Params: params})
 					} else {
 						return errors.Err("url is required")
 					}
@@ -297,5 +338,5 @@ func applySubscribers(subs map[string]interface{}) error {
 			return errors.Err("could not find sub type array under subscription")
 		}
 	}
-	return nil
+	return notifications.SetSubscribers(subscribers)
 }
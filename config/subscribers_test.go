@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/lbryio/chainquery/notifications"
+)
+
+func TestApplySubscribersSkipsUnknownType(t *testing.T) {
+	defer notifications.ClearSubscribers()
+	err := applySubscribers(map[string]interface{}{
+		"newclaim": []interface{}{map[string]interface{}{"url": "http://localhost/claim"}},
+		"payment":  []interface{}{map[string]interface{}{"url": "http://localhost/payment"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := notifications.Subscribers()
+	if len(subs) != 1 || subs[0].Type != "payment" {
+		t.Fatalf("expected only the payment subscriber, got %+v", subs)
+	}
+}
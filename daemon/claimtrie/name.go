@@ -0,0 +1,539 @@
+package claimtrie
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/util"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/lbryio/lbry.go/v2/extras/query"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Bid states stored for claims and supports.
+const (
+	BidStateAccepted    = "Accepted"
+	BidStateActive      = "Active"
+	BidStateControlling = "Controlling"
+	BidStateSpent       = "Spent"
+	BidStateExpired     = "Expired"
+)
+
+// State is the claimtrie state of a name at a height.
+type State struct {
+	Name               string       `json:"name"`
+	NormalizedName     string       `json:"normalized_name"`
+	Height             int32        `json:"height"`
+	ControllingClaimID string       `json:"controlling_claim_id,omitempty"`
+	TakeoverHeight     int32        `json:"takeover_height"`
+	Claims             []ClaimState `json:"claims"`
+}
+
+// ClaimState is the state of a claim in its name. Amounts are in dewies.
+type ClaimState struct {
+	ClaimID          string         `json:"claim_id"`
+	TransactionHash  string         `json:"transaction_hash"`
+	Vout             uint32         `json:"vout"`
+	BidState         string         `json:"bid_state"`
+	Amount           uint64         `json:"amount"`
+	EffectiveAmount  uint64         `json:"effective_amount"`
+	PendingAmount    uint64         `json:"pending_amount"`
+	AcceptedHeight   int32          `json:"accepted_height"`
+	ActivationHeight int32          `json:"activation_height"`
+	ExpirationHeight int32          `json:"expiration_height"`
+	Supports         []SupportState `json:"supports,omitempty"`
+}
+
+// SupportState is the state of a support of a claim.
+type SupportState struct {
+	TransactionHash  string `json:"transaction_hash"`
+	Vout             uint32 `json:"vout"`
+	BidState         string `json:"bid_state"`
+	Amount           uint64 `json:"amount"`
+	AcceptedHeight   int32  `json:"accepted_height"`
+	ActivationHeight int32  `json:"activation_height"`
+}
+
+// trieOutput is a claim, update or support output along with the stored state of its claim or support.
+type trieOutput struct {
+	TransactionHash      string      `boil:"transaction_hash"`
+	Vout                 uint        `boil:"vout"`
+	Value                float64     `boil:"value"`
+	ScriptPubKeyHex      string      `boil:"script_pub_key_hex"`
+	Height               int32       `boil:"height"`
+	SpentTransactionHash null.String `boil:"spent_transaction_hash"`
+	SpentHeight          null.Int32  `boil:"spent_height"`
+	SupportBidState      null.String `boil:"support_bid_state"`
+	SupportValidAtHeight null.Uint   `boil:"support_valid_at_height"`
+
+	change   ChangeType
+	name     string
+	claimID  string
+	outPoint OutPoint
+	amount   int64
+}
+
+// nameData is everything stored about the claims and supports that could belong to a name.
+type nameData struct {
+	outputs []*trieOutput
+	claims  map[string]*model.Claim
+}
+
+// GetState computes the claimtrie state of a name at a height from the stored claims and supports.
+func GetState(name string, height uint64) (*State, error) {
+	defer util.TimeTrack(time.Now(), "GetState", "mysqlprofile")
+	data, err := loadName(name)
+	if err != nil {
+		return nil, err
+	}
+	node, outputs, _ := data.replay(name, int32(height))
+	return newState(name, int32(height), node, outputs), nil
+}
+
+// UpdateNames recomputes the claimtrie state of names at a height and stores the bid state, effective amount and
+// activation height of their claims and supports along with the takeover history of the names.
+func UpdateNames(names []string, height uint64) error {
+	defer util.TimeTrack(time.Now(), "UpdateNames", "mysqlprofile")
+	done := make(map[string]bool, len(names))
+	for _, name := range names {
+		normalized := NormalizeName(name, int32(height))
+		if done[normalized] {
+			continue
+		}
+		done[normalized] = true
+		data, err := loadName(name)
+		if err != nil {
+			return err
+		}
+		node, outputs, history := data.replay(name, int32(height))
+		err = data.store(node, outputs, int32(height))
+		if err != nil {
+			return errors.Prefix("claimtrie: could not store state of "+name, err)
+		}
+		for historyName, takeovers := range history {
+			err = storeTakeovers(historyName, takeovers)
+			if err != nil {
+				return errors.Prefix("claimtrie: could not store takeovers of "+historyName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NamesOfBlock returns the names of the claims and supports added or spent by the transactions of a block.
+func NamesOfBlock(blockHash string) ([]string, error) {
+	var rows []struct {
+		Name string `boil:"name"`
+	}
+	err := queries.Raw(`
+		SELECT c.name FROM transaction t
+		INNER JOIN output o ON o.transaction_id = t.id
+		INNER JOIN claim c ON c.claim_id = o.claim_id
+		WHERE t.block_hash_id = ?
+		UNION
+		SELECT c.name FROM transaction t
+		INNER JOIN input i ON i.transaction_id = t.id
+		INNER JOIN output o ON o.spent_by_input_id = i.id
+		INNER JOIN claim c ON c.claim_id = o.claim_id
+		WHERE t.block_hash_id = ?
+		UNION
+		SELECT a.name FROM transaction t
+		INNER JOIN output o ON o.transaction_id = t.id
+		INNER JOIN abnormal_claim a ON a.output_id = o.id
+		WHERE t.block_hash_id = ?
+		UNION
+		SELECT a.name FROM transaction t
+		INNER JOIN input i ON i.transaction_id = t.id
+		INNER JOIN output o ON o.spent_by_input_id = i.id
+		INNER JOIN abnormal_claim a ON a.output_id = o.id
+		WHERE t.block_hash_id = ?`, blockHash, blockHash, blockHash, blockHash).BindG(context.Background(), &rows)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.Name
+	}
+	return names, nil
+}
+
+// NamesPendingAtHeight returns the names with claims or supports that activate or expire at a height.
+func NamesPendingAtHeight(height uint64) ([]string, error) {
+	acceptedHeights := []interface{}{-1}
+	for _, expirationTime := range []int32{OriginalClaimExpirationTime, ExtendedClaimExpirationTime} {
+		accepted := int32(height) - expirationTime
+		if accepted > 0 && ExpirationHeight(accepted) == int32(height) {
+			acceptedHeights = append(acceptedHeights, accepted)
+		}
+	}
+	args := []interface{}{height, BidStateAccepted, height, BidStateAccepted}
+	args = append(args, acceptedHeights...)
+	args = append(args, BidStateSpent, BidStateExpired)
+	args = append(args, acceptedHeights...)
+	args = append(args, BidStateSpent, BidStateExpired)
+	var rows []struct {
+		Name string `boil:"name"`
+	}
+	err := queries.Raw(`
+		SELECT name FROM claim WHERE valid_at_height = ? AND bid_state = ?
+		UNION
+		SELECT c.name FROM support s
+		INNER JOIN claim c ON c.claim_id = s.supported_claim_id
+		WHERE s.valid_at_height = ? AND s.bid_state = ?
+		UNION
+		SELECT name FROM claim WHERE height IN (`+query.Qs(len(acceptedHeights))+`) AND bid_state NOT IN (?, ?)
+		UNION
+		SELECT c.name FROM support s
+		INNER JOIN claim c ON c.claim_id = s.supported_claim_id
+		WHERE s.height IN (`+query.Qs(len(acceptedHeights))+`) AND s.bid_state NOT IN (?, ?)`, args...).BindG(context.Background(), &rows)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.Name
+	}
+	return names, nil
+}
+
+// loadName loads the claim, update and support outputs of every claim that could share a name with name. Names are
+// matched by the case insensitive collation of the claim table and narrowed down by replay.
+func loadName(name string) (*nameData, error) {
+	data := &nameData{claims: make(map[string]*model.Claim)}
+	var claims model.ClaimSlice
+	err := queries.Raw(`SELECT id, claim_id, name, bid_state, effective_amount, valid_at_height, claim_reference FROM claim WHERE name = ?`, name).BindG(context.Background(), &claims)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	var abnormal []struct {
+		OutputID uint64 `boil:"output_id"`
+	}
+	err = queries.Raw(`SELECT DISTINCT output_id FROM abnormal_claim WHERE name = ?`, name).BindG(context.Background(), &abnormal)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	if len(claims) == 0 && len(abnormal) == 0 {
+		return data, nil
+	}
+
+	claimIDs := []interface{}{""}
+	for _, c := range claims {
+		data.claims[c.ClaimID] = c
+		claimIDs = append(claimIDs, c.ClaimID)
+	}
+	outputIDs := []interface{}{0}
+	for _, a := range abnormal {
+		outputIDs = append(outputIDs, a.OutputID)
+	}
+	args := append(claimIDs, outputIDs...)
+	err = queries.Raw(`
+		SELECT o.transaction_hash, o.vout, COALESCE(o.value, 0) AS value, COALESCE(o.script_pub_key_hex, '') AS script_pub_key_hex,
+			b.height, st.hash AS spent_transaction_hash, sb.height AS spent_height,
+			s.bid_state AS support_bid_state, s.valid_at_height AS support_valid_at_height
+		FROM output o
+		INNER JOIN transaction t ON t.id = o.transaction_id
+		INNER JOIN block b ON b.hash = t.block_hash_id AND b.hash != 'MEMPOOL'
+		LEFT JOIN input i ON i.id = o.spent_by_input_id
+		LEFT JOIN transaction st ON st.id = i.transaction_id
+		LEFT JOIN block sb ON sb.hash = st.block_hash_id AND sb.hash != 'MEMPOOL'
+		LEFT JOIN support s ON s.transaction_hash_id = o.transaction_hash AND s.vout = o.vout
+		WHERE o.claim_id IN (`+query.Qs(len(claimIDs))+`) OR o.id IN (`+query.Qs(len(outputIDs))+`)`, args...).BindG(context.Background(), &data.outputs)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	outputs := data.outputs[:0]
+	for _, o := range data.outputs {
+		if err := o.parse(); err != nil {
+			logrus.Debugf("claimtrie: skipping output %s:%d: %s", o.TransactionHash, o.Vout, err)
+			continue
+		}
+		outputs = append(outputs, o)
+	}
+	data.outputs = outputs
+	return data, nil
+}
+
+func (o *trieOutput) parse() error {
+	script, err := hex.DecodeString(o.ScriptPubKeyHex)
+	if err != nil {
+		return errors.Err(err)
+	}
+	o.outPoint = OutPoint{TxHash: o.TransactionHash, N: uint32(o.Vout)}
+	o.amount = int64(math.Round(o.Value * 1e8))
+	if !o.SpentHeight.Valid {
+		o.SpentTransactionHash = null.String{}
+	}
+	switch {
+	case lbrycrd.IsClaimNameScript(script):
+		o.change = AddClaim
+		o.name, _, _, err = lbrycrd.ParseClaimNameScript(script)
+		if err != nil {
+			return err
+		}
+		o.claimID, err = lbrycrd.ClaimIDFromOutpoint(o.TransactionHash, int(o.Vout))
+	case lbrycrd.IsClaimUpdateScript(script):
+		o.change = UpdateClaim
+		o.name, o.claimID, _, _, err = lbrycrd.ParseClaimUpdateScript(script)
+	case lbrycrd.IsClaimSupportScript(script):
+		o.change = AddSupport
+		o.name, o.claimID, _, _, err = lbrycrd.ParseClaimSupportScript(script)
+	default:
+		return errors.Err("not a claim script")
+	}
+	return err
+}
+
+func (o *trieOutput) isSupport() bool {
+	return o.change == AddSupport
+}
+
+func (o *trieOutput) changes() []Change {
+	changes := []Change{{
+		Type:     o.change,
+		Height:   o.Height,
+		TxHash:   o.TransactionHash,
+		OutPoint: o.outPoint,
+		ClaimID:  o.claimID,
+		Amount:   o.amount,
+	}}
+	if o.SpentHeight.Valid {
+		spend := SpendClaim
+		if o.isSupport() {
+			spend = SpendSupport
+		}
+		changes = append(changes, Change{
+			Type:     spend,
+			Height:   o.SpentHeight.Int32,
+			TxHash:   o.SpentTransactionHash.String,
+			OutPoint: o.outPoint,
+			ClaimID:  o.claimID,
+		})
+	}
+	return changes
+}
+
+// replay computes the state of name at height. Before the normalization fork only outputs with exactly that name take
+// part. From the fork on all variants of the normalized name do, and claims and supports of variants that existed
+// before the fork are carried over with the heights they had under their own name. It also returns the takeovers of
+// every name involved: before the fork a variant had a controlling claim of its own.
+func (d *nameData) replay(name string, height int32) (*Node, []*trieOutput, map[string][]Takeover) {
+	history := make(map[string][]Takeover)
+	if height < NormalizedNameForkHeight {
+		var outputs []*trieOutput
+		var changes []Change
+		for _, o := range d.outputs {
+			if o.name == name {
+				outputs = append(outputs, o)
+				changes = append(changes, o.changes()...)
+			}
+		}
+		node := Replay(name, changes, height)
+		history[name] = node.Takeovers
+		return node, outputs, history
+	}
+
+	normalized := NormalizeName(name, height)
+	var outputs []*trieOutput
+	var changes []Change
+	variants := make(map[string][]Change)
+	for _, o := range d.outputs {
+		if NormalizeName(o.name, height) != normalized {
+			continue
+		}
+		outputs = append(outputs, o)
+		for _, chg := range o.changes() {
+			if o.name != normalized && chg.Height < NormalizedNameForkHeight {
+				variants[o.name] = append(variants[o.name], chg)
+				continue
+			}
+			changes = append(changes, chg)
+		}
+	}
+	for variantName, variantChanges := range variants {
+		variant := Replay(variantName, variantChanges, NormalizedNameForkHeight-1)
+		history[variantName] = variant.Takeovers
+		changes = append(changes, carryOver(variant.Claims, AddClaim)...)
+		changes = append(changes, carryOver(variant.Supports, AddSupport)...)
+	}
+	node := Replay(normalized, changes, height)
+	history[normalized] = node.Takeovers
+	return node, outputs, history
+}
+
+func carryOver(claims []*Claim, changeType ChangeType) []Change {
+	var changes []Change
+	for _, c := range claims {
+		if c.Status == Deactivated {
+			continue
+		}
+		changes = append(changes, Change{
+			Type:          changeType,
+			Height:        c.AcceptedAt,
+			OutPoint:      c.OutPoint,
+			ClaimID:       c.ClaimID,
+			Amount:        c.Amount,
+			ActiveHeight:  c.ActiveAt,
+			VisibleHeight: NormalizedNameForkHeight,
+		})
+	}
+	return changes
+}
+
+func newState(name string, height int32, node *Node, outputs []*trieOutput) *State {
+	state := &State{
+		Name:           name,
+		NormalizedName: NormalizeName(name, height),
+		Height:         height,
+		TakeoverHeight: node.TakenOverAt,
+		Claims:         make([]ClaimState, 0, len(node.Claims)),
+	}
+	if node.BestClaim != nil && node.BestClaim.Status == Activated {
+		state.ControllingClaimID = node.BestClaim.ClaimID
+	}
+	supports := make(map[string][]SupportState)
+	for _, s := range node.Supports {
+		supports[s.ClaimID] = append(supports[s.ClaimID], SupportState{
+			TransactionHash:  s.OutPoint.TxHash,
+			Vout:             s.OutPoint.N,
+			BidState:         node.supportBidState(s),
+			Amount:           uint64(s.Amount),
+			AcceptedHeight:   s.AcceptedAt,
+			ActivationHeight: s.ActiveAt,
+		})
+	}
+	for _, c := range node.Claims {
+		state.Claims = append(state.Claims, ClaimState{
+			ClaimID:          c.ClaimID,
+			TransactionHash:  c.OutPoint.TxHash,
+			Vout:             c.OutPoint.N,
+			BidState:         node.claimBidState(c),
+			Amount:           uint64(c.Amount),
+			EffectiveAmount:  uint64(node.EffectiveAmount(c)),
+			PendingAmount:    uint64(node.PendingAmount(c)),
+			AcceptedHeight:   c.AcceptedAt,
+			ActivationHeight: c.ActiveAt,
+			ExpirationHeight: c.ExpireAt(),
+			Supports:         supports[c.ClaimID],
+		})
+	}
+	sort.SliceStable(state.Claims, func(i, j int) bool {
+		if state.Claims[i].EffectiveAmount != state.Claims[j].EffectiveAmount {
+			return state.Claims[i].EffectiveAmount > state.Claims[j].EffectiveAmount
+		}
+		return state.Claims[i].PendingAmount > state.Claims[j].PendingAmount
+	})
+	return state
+}
+
+func (n *Node) claimBidState(c *Claim) string {
+	switch {
+	case n.IsControlling(c):
+		return BidStateControlling
+	case c.Status == Activated:
+		return BidStateActive
+	default:
+		return BidStateAccepted
+	}
+}
+
+func (n *Node) supportBidState(s *Claim) string {
+	if s.Status == Activated {
+		return BidStateActive
+	}
+	return BidStateAccepted
+}
+
+// removedBidState is the bid state of a claim or support output that is no longer part of its name.
+func removedBidState(o *trieOutput, height int32) string {
+	if o.SpentHeight.Valid && o.SpentHeight.Int32 <= height {
+		return BidStateSpent
+	}
+	return BidStateExpired
+}
+
+// store saves the bid state, effective amount and activation height of the claims and supports of a name where they
+// changed.
+func (d *nameData) store(node *Node, outputs []*trieOutput, height int32) error {
+	claimsByID := make(map[string]*Claim, len(node.Claims))
+	for _, c := range node.Claims {
+		claimsByID[c.ClaimID] = c
+	}
+	supportsByOutPoint := make(map[OutPoint]*Claim, len(node.Supports))
+	for _, s := range node.Supports {
+		supportsByOutPoint[s.OutPoint] = s
+	}
+
+	latest := make(map[string]*trieOutput)
+	for _, o := range outputs {
+		if o.isSupport() {
+			err := storeSupport(o, supportsByOutPoint[o.outPoint], node, height)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if current, ok := latest[o.claimID]; !ok || o.Height >= current.Height {
+			latest[o.claimID] = o
+		}
+	}
+	var reposted []string
+	for claimID, o := range latest {
+		stored, ok := d.claims[claimID]
+		if !ok {
+			continue
+		}
+		bidState := removedBidState(o, height)
+		effectiveAmount := stored.EffectiveAmount
+		validAtHeight := stored.ValidAtHeight
+		if c, ok := claimsByID[claimID]; ok {
+			bidState = node.claimBidState(c)
+			effectiveAmount = uint64(node.PendingAmount(c))
+			validAtHeight = uint(c.ActiveAt)
+		}
+		if stored.BidState == bidState && stored.EffectiveAmount == effectiveAmount && stored.ValidAtHeight == validAtHeight {
+			continue
+		}
+		_, err := boil.GetDB().Exec(`UPDATE claim SET bid_state = ?, effective_amount = ?, valid_at_height = ? WHERE id = ?`,
+			bidState, effectiveAmount, validAtHeight, stored.ID)
+		if err != nil {
+			return errors.Err(err)
+		}
+		if stored.ClaimReference.Valid && isRemoved(stored.BidState) != isRemoved(bidState) {
+			reposted = append(reposted, stored.ClaimReference.String)
+		}
+	}
+	return datastore.UpdateRepostCounts(reposted...)
+}
+
+func isRemoved(bidState string) bool {
+	return bidState == BidStateSpent || bidState == BidStateExpired
+}
+
+func storeSupport(o *trieOutput, s *Claim, node *Node, height int32) error {
+	if !o.SupportBidState.Valid {
+		return nil
+	}
+	bidState := removedBidState(o, height)
+	validAtHeight := o.SupportValidAtHeight.Uint
+	if s != nil {
+		bidState = node.supportBidState(s)
+		validAtHeight = uint(s.ActiveAt)
+	}
+	if o.SupportBidState.String == bidState && o.SupportValidAtHeight.Uint == validAtHeight {
+		return nil
+	}
+	_, err := boil.GetDB().Exec(`UPDATE support SET bid_state = ?, valid_at_height = ? WHERE transaction_hash_id = ? AND vout = ?`,
+		bidState, validAtHeight, o.TransactionHash, o.Vout)
+	return errors.Err(err)
+}
@@ -0,0 +1,481 @@
+package claimtrie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"sort"
+)
+
+// Status is the state of a claim or support within its name.
+type Status int
+
+// The states a claim or support goes through. Deactivated ones are spent and removed at the end of the block.
+const (
+	Accepted Status = iota
+	Activated
+	Deactivated
+)
+
+// OutPoint identifies the output holding a claim or support.
+type OutPoint struct {
+	TxHash string
+	N      uint32
+}
+
+// less orders outpoints the way lbcd does, by the transaction hash in internal byte order and then by index.
+func (o OutPoint) less(other OutPoint) bool {
+	cmp := bytes.Compare(internalHash(o.TxHash), internalHash(other.TxHash))
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return o.N < other.N
+}
+
+func internalHash(txHash string) []byte {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return []byte(txHash)
+	}
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+	return hash
+}
+
+// Claim is a claim or a support within a name. For supports ClaimID is the supported claim.
+type Claim struct {
+	OutPoint   OutPoint
+	ClaimID    string
+	Amount     int64
+	AcceptedAt int32
+	ActiveAt   int32
+	VisibleAt  int32
+	Status     Status
+}
+
+// ExpireAt returns the height at which the claim or support expires.
+func (c *Claim) ExpireAt() int32 {
+	return ExpirationHeight(c.AcceptedAt)
+}
+
+// ChangeType is the kind of change made to a name.
+type ChangeType int
+
+// The changes a transaction can make to a name.
+const (
+	AddClaim ChangeType = iota
+	UpdateClaim
+	SpendClaim
+	AddSupport
+	SpendSupport
+)
+
+// Change is a claim or support added to or spent from a name. TxHash is the transaction making the change and only
+// used to order changes within a block.
+type Change struct {
+	Type     ChangeType
+	Height   int32
+	TxHash   string
+	OutPoint OutPoint
+	ClaimID  string
+	Amount   int64
+	// ActiveHeight and VisibleHeight are only set for claims and supports carried over from another name at the
+	// normalization fork. They keep their activation height and take part from VisibleHeight on.
+	ActiveHeight  int32
+	VisibleHeight int32
+}
+
+func (c Change) isSpend() bool {
+	return c.Type == SpendClaim || c.Type == SpendSupport
+}
+
+// appliedAt is the height of the block the change belongs to.
+func (c Change) appliedAt() int32 {
+	if c.VisibleHeight > c.Height {
+		return c.VisibleHeight
+	}
+	return c.Height
+}
+
+// Takeover is a change of the controlling claim of a name. ClaimID is empty when the name lost its controlling claim.
+type Takeover struct {
+	Height  int32
+	ClaimID string
+}
+
+// Node is the state of a name.
+type Node struct {
+	Name        string
+	BestClaim   *Claim
+	TakenOverAt int32
+	Claims      []*Claim
+	Supports    []*Claim
+	SupportSums map[string]int64
+	// Takeovers are the changes of the controlling claim up to the height the node was replayed to, oldest first.
+	Takeovers []Takeover
+}
+
+func newNode(name string) *Node {
+	return &Node{Name: name, SupportSums: make(map[string]int64)}
+}
+
+// Replay applies the changes of a name in block order and returns the state of the name at height.
+func Replay(name string, changes []Change, height int32) *Node {
+	n := newNode(name)
+	changes = orderChanges(changes)
+	applied := 0
+	var previous int32
+	for _, chg := range changes {
+		at := chg.appliedAt()
+		if at > height {
+			break
+		}
+		if applied == 0 {
+			previous = at
+		}
+		if previous < at {
+			n.adjustTo(previous, at-1)
+			previous = at
+		}
+		n.apply(chg, n.delayFor(chg))
+		applied++
+	}
+	if applied > 0 {
+		n.adjustTo(previous, height)
+	}
+	return n
+}
+
+// EffectiveAmount returns the amount of an active claim plus its active supports. Inactive claims have none.
+func (n *Node) EffectiveAmount(c *Claim) int64 {
+	if c.Status != Activated {
+		return 0
+	}
+	return c.Amount + n.SupportSums[c.ClaimID]
+}
+
+// PendingAmount returns the amount of a claim plus all its unspent supports, including those not active yet.
+func (n *Node) PendingAmount(c *Claim) int64 {
+	amount := c.Amount
+	for _, s := range n.Supports {
+		if s.ClaimID == c.ClaimID && s.Status != Deactivated {
+			amount += s.Amount
+		}
+	}
+	return amount
+}
+
+// IsControlling reports whether the claim controls the name.
+func (n *Node) IsControlling(c *Claim) bool {
+	return n.BestClaim != nil && n.BestClaim.Status == Activated && n.BestClaim.ClaimID == c.ClaimID
+}
+
+func (n *Node) delayFor(chg Change) int32 {
+	hasBest := n.BestClaim != nil
+	if hasBest && n.BestClaim.ClaimID == chg.ClaimID {
+		return 0
+	}
+	if chg.ActiveHeight >= chg.Height {
+		return chg.ActiveHeight - chg.Height
+	}
+	if !hasBest {
+		return 0
+	}
+	return ActivationDelay(chg.Height, n.TakenOverAt)
+}
+
+func (n *Node) apply(chg Change, delay int32) {
+	switch chg.Type {
+	case AddClaim:
+		n.Claims = append(n.Claims, newClaim(chg, delay))
+	case UpdateClaim:
+		// An update only takes effect when the claim it updates was spent by the same transaction.
+		c := findByID(n.Claims, chg.ClaimID)
+		if c != nil && c.Status == Deactivated {
+			c.OutPoint = chg.OutPoint
+			c.Amount = chg.Amount
+			c.AcceptedAt = chg.Height
+			c.ActiveAt = chg.Height + delay
+			c.Status = Accepted
+		}
+	case SpendClaim:
+		if c := findByOutPoint(n.Claims, chg.OutPoint); c != nil {
+			c.Status = Deactivated
+		}
+	case AddSupport:
+		n.Supports = append(n.Supports, newClaim(chg, delay))
+	case SpendSupport:
+		if s := findByOutPoint(n.Supports, chg.OutPoint); s != nil {
+			if s.Status == Activated {
+				n.SupportSums[s.ClaimID] -= s.Amount
+			}
+			s.Status = Deactivated
+		}
+	}
+}
+
+func newClaim(chg Change, delay int32) *Claim {
+	return &Claim{
+		OutPoint:   chg.OutPoint,
+		ClaimID:    chg.ClaimID,
+		Amount:     chg.Amount,
+		AcceptedAt: chg.Height,
+		ActiveAt:   chg.Height + delay,
+		VisibleAt:  chg.VisibleHeight,
+		Status:     Accepted,
+	}
+}
+
+// adjustTo brings the name from height to maxHeight, stopping at every height where a claim or support activates or
+// expires.
+func (n *Node) adjustTo(height, maxHeight int32) {
+	changed := n.handleExpiredAndActivated(height) > 0
+	n.updateTakeoverHeight(height, changed)
+	for h := n.nextUpdate(); h <= maxHeight; h = n.nextUpdate() {
+		changed = n.handleExpiredAndActivated(h) > 0
+		n.updateTakeoverHeight(h, changed)
+	}
+}
+
+func (n *Node) handleExpiredAndActivated(height int32) int {
+	changes := 0
+	update := func(items []*Claim, sums map[string]int64) []*Claim {
+		kept := items[:0]
+		for _, c := range items {
+			if c.Status == Accepted && c.ActiveAt <= height && c.VisibleAt <= height {
+				c.Status = Activated
+				changes++
+				if sums != nil {
+					sums[c.ClaimID] += c.Amount
+				}
+			}
+			if c.ExpireAt() <= height || c.Status == Deactivated {
+				changes++
+				if sums != nil && c.Status != Deactivated {
+					sums[c.ClaimID] -= c.Amount
+				}
+				continue
+			}
+			kept = append(kept, c)
+		}
+		return kept
+	}
+	n.Claims = update(n.Claims, nil)
+	n.Supports = update(n.Supports, n.SupportSums)
+	return changes
+}
+
+func (n *Node) updateTakeoverHeight(height int32, refindBest bool) {
+	candidate := n.BestClaim
+	if refindBest {
+		candidate = n.findBestClaim()
+	}
+	hasCandidate := candidate != nil
+	hasCurrentWinner := n.BestClaim != nil && n.BestClaim.Status == Activated
+	takeoverHappening := !hasCandidate || !hasCurrentWinner || candidate.ClaimID != n.BestClaim.ClaimID
+	if takeoverHappening {
+		// On a takeover everything waiting for activation on the name is activated right away.
+		if n.activateAllClaims(height) > 0 {
+			candidate = n.findBestClaim()
+		}
+	}
+	if !takeoverHappening {
+		// lbrycrd reset the takeover height of a name updated after one of its supports was added or spent, lbcd
+		// reproduces it for the names it happened to.
+		takeoverHappening = IsTakeoverWorkaround(height, n.Name)
+	}
+	n.BestClaim = candidate
+	if takeoverHappening {
+		n.TakenOverAt = height
+		n.recordTakeover(height)
+	}
+}
+
+func (n *Node) recordTakeover(height int32) {
+	controlling := ""
+	if n.BestClaim != nil && n.BestClaim.Status == Activated {
+		controlling = n.BestClaim.ClaimID
+	}
+	last := ""
+	if len(n.Takeovers) > 0 {
+		last = n.Takeovers[len(n.Takeovers)-1].ClaimID
+	}
+	if controlling == last {
+		return
+	}
+	n.Takeovers = append(n.Takeovers, Takeover{Height: height, ClaimID: controlling})
+}
+
+func (n *Node) activateAllClaims(height int32) int {
+	count := 0
+	for _, c := range n.Claims {
+		if c.Status == Accepted && c.ActiveAt > height && c.VisibleAt <= height {
+			c.ActiveAt = height
+			c.Status = Activated
+			count++
+		}
+	}
+	for _, s := range n.Supports {
+		if s.Status == Accepted && s.ActiveAt > height && s.VisibleAt <= height {
+			s.ActiveAt = height
+			s.Status = Activated
+			count++
+			n.SupportSums[s.ClaimID] += s.Amount
+		}
+	}
+	return count
+}
+
+// findBestClaim returns the active claim with the highest effective amount. Ties go to the claim accepted first and
+// then to the lower outpoint.
+func (n *Node) findBestClaim() *Claim {
+	var best *Claim
+	for _, candidate := range n.Claims {
+		if candidate.Status != Activated {
+			continue
+		}
+		if best == nil {
+			best = candidate
+			continue
+		}
+		candidateAmount := candidate.Amount + n.SupportSums[candidate.ClaimID]
+		bestAmount := best.Amount + n.SupportSums[best.ClaimID]
+		switch {
+		case candidateAmount > bestAmount:
+			best = candidate
+		case candidateAmount < bestAmount:
+		case candidate.AcceptedAt < best.AcceptedAt:
+			best = candidate
+		case candidate.AcceptedAt > best.AcceptedAt:
+		case candidate.OutPoint.less(best.OutPoint):
+			best = candidate
+		}
+	}
+	return best
+}
+
+// nextUpdate returns the next height at which a claim or support of the name activates or expires.
+func (n *Node) nextUpdate() int32 {
+	next := int32(math.MaxInt32)
+	for _, items := range [][]*Claim{n.Claims, n.Supports} {
+		for _, c := range items {
+			if c.ExpireAt() < next {
+				next = c.ExpireAt()
+			}
+			if c.Status == Accepted {
+				at := c.ActiveAt
+				if c.VisibleAt > at {
+					at = c.VisibleAt
+				}
+				if at < next {
+					next = at
+				}
+			}
+		}
+	}
+	return next
+}
+
+func findByID(claims []*Claim, claimID string) *Claim {
+	for _, c := range claims {
+		if c.ClaimID == claimID {
+			return c
+		}
+	}
+	return nil
+}
+
+func findByOutPoint(claims []*Claim, outPoint OutPoint) *Claim {
+	for _, c := range claims {
+		if c.OutPoint == outPoint {
+			return c
+		}
+	}
+	return nil
+}
+
+// orderChanges sorts changes into the order the chain applied them: by block, then transactions that spend outputs
+// created in the same block after the transaction creating them, and within a transaction spends before additions.
+// Changes without a transaction, carried over at the normalization fork, come first in their block.
+func orderChanges(changes []Change) []Change {
+	ordered := make([]Change, len(changes))
+	copy(ordered, changes)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].appliedAt() < ordered[j].appliedAt()
+	})
+	for start := 0; start < len(ordered); {
+		end := start
+		for end < len(ordered) && ordered[end].appliedAt() == ordered[start].appliedAt() {
+			end++
+		}
+		orderBlock(ordered[start:end])
+		start = end
+	}
+	return ordered
+}
+
+func orderBlock(changes []Change) {
+	createdBy := make(map[OutPoint]string)
+	for _, chg := range changes {
+		if !chg.isSpend() {
+			createdBy[chg.OutPoint] = chg.TxHash
+		}
+	}
+	dependsOn := make(map[string]map[string]bool)
+	for _, chg := range changes {
+		if !chg.isSpend() {
+			continue
+		}
+		if creator, ok := createdBy[chg.OutPoint]; ok && creator != chg.TxHash {
+			if dependsOn[chg.TxHash] == nil {
+				dependsOn[chg.TxHash] = make(map[string]bool)
+			}
+			dependsOn[chg.TxHash][creator] = true
+		}
+	}
+	var txs []string
+	seen := make(map[string]bool)
+	for _, chg := range changes {
+		if !seen[chg.TxHash] {
+			seen[chg.TxHash] = true
+			txs = append(txs, chg.TxHash)
+		}
+	}
+	sort.Strings(txs)
+	position := make(map[string]int, len(txs))
+	for len(position) < len(txs) {
+		progressed := false
+		for _, tx := range txs {
+			if _, done := position[tx]; done {
+				continue
+			}
+			ready := true
+			for dependency := range dependsOn[tx] {
+				if _, done := position[dependency]; !done {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				position[tx] = len(position)
+				progressed = true
+			}
+		}
+		if !progressed {
+			// Only possible with inconsistent data; keep the remaining transactions in hash order.
+			for _, tx := range txs {
+				if _, done := position[tx]; !done {
+					position[tx] = len(position)
+				}
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		pi, pj := position[changes[i].TxHash], position[changes[j].TxHash]
+		if pi != pj {
+			return pi < pj
+		}
+		return changes[i].isSpend() && !changes[j].isSpend()
+	})
+}
@@ -0,0 +1,173 @@
+package claimtrie
+
+import (
+	"testing"
+)
+
+func addClaim(tx string, height int32, claimID string, amount int64) Change {
+	return Change{Type: AddClaim, Height: height, TxHash: tx, OutPoint: OutPoint{TxHash: tx}, ClaimID: claimID, Amount: amount}
+}
+
+func spendClaim(tx string, height int32, spent OutPoint, claimID string) Change {
+	return Change{Type: SpendClaim, Height: height, TxHash: tx, OutPoint: spent, ClaimID: claimID}
+}
+
+func TestReplayFirstClaimTakesOverImmediately(t *testing.T) {
+	node := Replay("name", []Change{addClaim("aa", 10, "a", 100)}, 10)
+
+	if node.BestClaim == nil || node.BestClaim.ClaimID != "a" {
+		t.Fatalf("expected claim a to control the name, got %+v", node.BestClaim)
+	}
+	if node.TakenOverAt != 10 {
+		t.Fatalf("expected takeover at 10, got %d", node.TakenOverAt)
+	}
+	if node.EffectiveAmount(node.BestClaim) != 100 {
+		t.Fatalf("expected effective amount 100, got %d", node.EffectiveAmount(node.BestClaim))
+	}
+}
+
+func TestReplayDelaysChallengerByTimeSinceTakeover(t *testing.T) {
+	changes := []Change{
+		addClaim("aa", 10, "a", 100),
+		addClaim("bb", 330, "b", 200),
+	}
+
+	before := Replay("name", changes, 339)
+	if before.BestClaim.ClaimID != "a" {
+		t.Fatalf("expected claim a to control before activation of b, got %s", before.BestClaim.ClaimID)
+	}
+	challenger := findByID(before.Claims, "b")
+	if challenger.ActiveAt != 340 || challenger.Status != Accepted {
+		t.Fatalf("expected claim b to wait until 340, got %+v", challenger)
+	}
+
+	after := Replay("name", changes, 340)
+	if after.BestClaim.ClaimID != "b" || after.TakenOverAt != 340 {
+		t.Fatalf("expected claim b to take over at 340, got %s at %d", after.BestClaim.ClaimID, after.TakenOverAt)
+	}
+}
+
+func TestReplayActivationDelayIsCapped(t *testing.T) {
+	if delay := ActivationDelay(1000000, 0); delay != MaxActiveDelay {
+		t.Fatalf("expected delay capped at %d, got %d", MaxActiveDelay, delay)
+	}
+}
+
+func TestReplaySupportOfControllingClaimIsImmediate(t *testing.T) {
+	node := Replay("name", []Change{
+		addClaim("aa", 10, "a", 100),
+		{Type: AddSupport, Height: 1000, TxHash: "cc", OutPoint: OutPoint{TxHash: "cc"}, ClaimID: "a", Amount: 50},
+	}, 1000)
+
+	if node.EffectiveAmount(node.BestClaim) != 150 {
+		t.Fatalf("expected support to be active immediately, got effective amount %d", node.EffectiveAmount(node.BestClaim))
+	}
+}
+
+func TestReplayTakeoverActivatesPendingClaims(t *testing.T) {
+	node := Replay("name", []Change{
+		addClaim("aa", 100, "a", 100),
+		addClaim("bb", 3300, "b", 50),
+		spendClaim("cc", 3310, OutPoint{TxHash: "aa"}, "a"),
+	}, 3310)
+
+	if node.BestClaim == nil || node.BestClaim.ClaimID != "b" {
+		t.Fatalf("expected claim b to take over when a is spent, got %+v", node.BestClaim)
+	}
+	if node.TakenOverAt != 3310 || node.BestClaim.ActiveAt != 3310 {
+		t.Fatalf("expected b activated by the takeover at 3310, got takeover %d active %d", node.TakenOverAt, node.BestClaim.ActiveAt)
+	}
+}
+
+func TestReplayClaimsExpire(t *testing.T) {
+	changes := []Change{addClaim("aa", 500000, "a", 100)}
+
+	if node := Replay("name", changes, 500000+ExtendedClaimExpirationTime-1); len(node.Claims) != 1 {
+		t.Fatalf("expected claim to be alive one block before expiration")
+	}
+	node := Replay("name", changes, 500000+ExtendedClaimExpirationTime)
+	if len(node.Claims) != 0 || node.BestClaim != nil {
+		t.Fatalf("expected claim to be expired, got %+v", node.Claims)
+	}
+}
+
+func TestReplayOrdersUpdatesWithinBlock(t *testing.T) {
+	update := func(tx string, spent string) []Change {
+		return []Change{
+			spendClaim(tx, 20, OutPoint{TxHash: spent}, "a"),
+			{Type: UpdateClaim, Height: 20, TxHash: tx, OutPoint: OutPoint{TxHash: tx}, ClaimID: "a", Amount: 100},
+		}
+	}
+	// Stored order is by hash, not by the order the transactions were mined in.
+	changes := []Change{addClaim("ff", 10, "a", 100)}
+	changes = append(changes, update("11", "ee")...)
+	changes = append(changes, update("ee", "ff")...)
+
+	node := Replay("name", changes, 20)
+	if len(node.Claims) != 1 || node.Claims[0].OutPoint.TxHash != "11" {
+		t.Fatalf("expected the claim to end up at the last update, got %+v", node.Claims)
+	}
+	if node.BestClaim == nil || node.BestClaim.ClaimID != "a" {
+		t.Fatalf("expected the updated claim to keep control, got %+v", node.BestClaim)
+	}
+}
+
+func TestFindBestClaimBreaksTiesByOutPoint(t *testing.T) {
+	node := Replay("name", []Change{
+		addClaim("00ff", 10, "a", 100),
+		addClaim("ff00", 10, "b", 100),
+	}, 10)
+
+	// Transaction hashes are compared in internal byte order, so ff00 sorts before 00ff.
+	if node.BestClaim.ClaimID != "b" {
+		t.Fatalf("expected claim b to win the tie, got %s", node.BestClaim.ClaimID)
+	}
+}
+
+func TestNormalizeNameOnlyAfterFork(t *testing.T) {
+	if NormalizeName("Foo", NormalizedNameForkHeight-1) != "Foo" {
+		t.Fatalf("expected names to be left as is before the fork")
+	}
+	if NormalizeName("Foo", NormalizedNameForkHeight) != "foo" {
+		t.Fatalf("expected names to be case folded from the fork on")
+	}
+}
+
+func TestReplayRecordsTakeovers(t *testing.T) {
+	node := Replay("name", []Change{
+		addClaim("aa", 10, "a", 100),
+		addClaim("bb", 330, "b", 200),
+		spendClaim("cc", 500, OutPoint{TxHash: "bb"}, "b"),
+		spendClaim("dd", 600, OutPoint{TxHash: "aa"}, "a"),
+	}, 700)
+
+	expected := []Takeover{{10, "a"}, {340, "b"}, {500, "a"}, {600, ""}}
+	if len(node.Takeovers) != len(expected) {
+		t.Fatalf("expected takeovers %v, got %v", expected, node.Takeovers)
+	}
+	for i, takeover := range expected {
+		if node.Takeovers[i] != takeover {
+			t.Fatalf("expected takeovers %v, got %v", expected, node.Takeovers)
+		}
+	}
+}
+
+func TestReplayForcesTheTakeoversOfTheWorkarounds(t *testing.T) {
+	changes := []Change{
+		addClaim("aa", 496000, "a", 100),
+		{Type: AddSupport, Height: 496856, TxHash: "bb", OutPoint: OutPoint{TxHash: "bb"}, ClaimID: "a", Amount: 50},
+	}
+
+	node := Replay("HunterxHunterAMV", changes, 496856)
+	if node.BestClaim.ClaimID != "a" || node.TakenOverAt != 496856 {
+		t.Fatalf("expected claim a to take over again at 496856, got %s at %d", node.BestClaim.ClaimID, node.TakenOverAt)
+	}
+	if len(node.Takeovers) != 1 {
+		t.Fatalf("expected the forced takeover not to change the controlling claim, got %+v", node.Takeovers)
+	}
+
+	other := Replay("other", changes, 496856)
+	if other.TakenOverAt != 496000 {
+		t.Fatalf("expected the takeover of other names to be kept at 496000, got %d", other.TakenOverAt)
+	}
+}
@@ -0,0 +1,80 @@
+package claimtrie
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Mainnet claimtrie consensus parameters, as enforced by lbcd.
+const (
+	// MaxActiveDelay is the longest a claim or support waits to be activated.
+	MaxActiveDelay int32 = 4032
+	// ActiveDelayFactor divides the blocks since the last takeover of a name to get the activation delay.
+	ActiveDelayFactor int32 = 32
+	// OriginalClaimExpirationTime is the number of blocks a claim lived before the expiration fork.
+	OriginalClaimExpirationTime int32 = 262974
+	// ExtendedClaimExpirationTime is the number of blocks a claim lives after the expiration fork.
+	ExtendedClaimExpirationTime int32 = 2102400
+	// ExtendedClaimExpirationForkHeight is the height of the expiration fork. https://github.com/lbryio/lbrycrd/pull/137
+	ExtendedClaimExpirationForkHeight int32 = 400155
+	// NormalizedNameForkHeight is the height from which names are normalized before they are compared.
+	NormalizedNameForkHeight int32 = 539940
+	// MaxRemovalWorkaroundHeight is the height up to which the takeovers of TakeoverWorkarounds are forced.
+	MaxRemovalWorkaroundHeight int32 = 658300
+)
+
+// TakeoverWorkarounds are the heights and names, keyed height_name, at which lbrycrd reset the takeover height of a
+// name while its controlling claim kept it. lbcd forces a takeover there to stay in consensus, the entries are those of
+// its claimtrie/param/takeovers.go.
+var TakeoverWorkarounds = map[string]struct{}{
+	"496856_HunterxHunterAMV":                         {},
+	"542978_namethattune1":                            {},
+	"543508_namethattune-5":                           {},
+	"546780_forecasts":                                {},
+	"548730_forecasts":                                {},
+	"551540_forecasts":                                {},
+	"552380_chicthinkingofyou":                        {},
+	"560363_takephotowithlbryteam":                    {},
+	"563710_test-img":                                 {},
+	"566750_itila":                                    {},
+	"567082_malabarismo-com-bolas-de-futebol-vs-chap": {},
+}
+
+// IsTakeoverWorkaround reports whether a takeover of name is forced at height.
+func IsTakeoverWorkaround(height int32, name string) bool {
+	if height >= MaxRemovalWorkaroundHeight {
+		return false
+	}
+	_, ok := TakeoverWorkarounds[strconv.Itoa(int(height))+"_"+name]
+	return ok
+}
+
+// ExpirationHeight returns the height at which a claim or support accepted at acceptedAt is removed from its name.
+func ExpirationHeight(acceptedAt int32) int32 {
+	if acceptedAt+OriginalClaimExpirationTime > ExtendedClaimExpirationForkHeight {
+		return acceptedAt + ExtendedClaimExpirationTime
+	}
+	return acceptedAt + OriginalClaimExpirationTime
+}
+
+// ActivationDelay returns how many blocks a claim or support added at height waits before it is activated when its
+// name was last taken over at takenOverAt.
+func ActivationDelay(height, takenOverAt int32) int32 {
+	delay := (height - takenOverAt) / ActiveDelayFactor
+	if delay > MaxActiveDelay {
+		return MaxActiveDelay
+	}
+	return delay
+}
+
+// NormalizeName returns the name a claim is filed under at height. From the normalization fork on, names are NFD
+// normalized and case folded so that all variants of a name compete for it.
+func NormalizeName(name string, height int32) string {
+	if height < NormalizedNameForkHeight || !utf8.ValidString(name) {
+		return name
+	}
+	return cases.Fold().String(norm.NFD.String(name))
+}
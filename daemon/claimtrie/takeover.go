@@ -0,0 +1,86 @@
+package claimtrie
+
+import (
+	"time"
+
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/util"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ControllingRange is a range of heights during which a claim controlled a name. ToHeight is null while the claim
+// still controls it.
+type ControllingRange struct {
+	ClaimID    string     `json:"claim_id"`
+	FromHeight int32      `json:"from_height"`
+	ToHeight   null.Int32 `json:"to_height"`
+}
+
+// GetHistory returns the ranges of heights each claim controlled a name, oldest first. Before the normalization fork
+// the name is matched exactly, from the fork on its normalized form is.
+func GetHistory(name string) ([]ControllingRange, error) {
+	defer util.TimeTrack(time.Now(), "GetHistory", "mysqlprofile")
+	t := model.TakeoverColumns
+	takeovers, err := model.Takeovers(
+		qm.Where("("+t.Name+" = ? AND "+t.Height+" < ?) OR ("+t.Name+" = ? AND "+t.Height+" >= ?)",
+			name, NormalizedNameForkHeight, NormalizeName(name, NormalizedNameForkHeight), NormalizedNameForkHeight),
+		qm.OrderBy(t.Height)).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+
+	ranges := make([]ControllingRange, 0, len(takeovers))
+	for _, takeover := range takeovers {
+		if n := len(ranges); n > 0 && !ranges[n-1].ToHeight.Valid {
+			if ranges[n-1].ClaimID == takeover.ClaimID.String {
+				continue
+			}
+			ranges[n-1].ToHeight.SetValid(int32(takeover.Height) - 1)
+		}
+		if takeover.ClaimID.String != "" {
+			ranges = append(ranges, ControllingRange{ClaimID: takeover.ClaimID.String, FromHeight: int32(takeover.Height)})
+		}
+	}
+	return ranges, nil
+}
+
+// storeTakeovers makes the stored takeover history of a name match the replayed one. Takeovers of blocks that were
+// orphaned are removed this way as well.
+func storeTakeovers(name string, takeovers []Takeover) error {
+	stored, err := model.Takeovers(model.TakeoverWhere.Name.EQ(name)).AllG()
+	if err != nil {
+		return errors.Err(err)
+	}
+	missing := make(map[int32]string, len(takeovers))
+	for _, takeover := range takeovers {
+		missing[takeover.Height] = takeover.ClaimID
+	}
+	var obsolete []uint64
+	for _, takeover := range stored {
+		claimID, ok := missing[int32(takeover.Height)]
+		if ok && claimID == takeover.ClaimID.String {
+			delete(missing, int32(takeover.Height))
+			continue
+		}
+		obsolete = append(obsolete, takeover.ID)
+	}
+	if len(obsolete) > 0 {
+		err = model.Takeovers(model.TakeoverWhere.ID.IN(obsolete)).DeleteAllG()
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	for height, claimID := range missing {
+		takeover := &model.Takeover{Name: name, ClaimID: null.NewString(claimID, claimID != ""), Height: uint(height)}
+		err = takeover.InsertG(boil.Infer())
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	return nil
+}
@@ -13,12 +13,15 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/lbryio/chainquery/alerts"
 	"github.com/lbryio/chainquery/daemon/jobs"
 	"github.com/lbryio/chainquery/daemon/processing"
 	"github.com/lbryio/chainquery/daemon/upgrademanager"
 	"github.com/lbryio/chainquery/global"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/notifications"
+	"github.com/lbryio/chainquery/publisher"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 	"github.com/lbryio/lbry.go/v2/extras/stop"
 
@@ -36,6 +39,7 @@ const (
 	daemonmode = 3
 
 	legacyBlockStateBackfillInterval = 15 * time.Minute
+	storedSubscribersReloadInterval  = 10 * time.Second
 )
 
 var lastHeightProcessed uint64 // Around 165,000 is when protobuf takes affect.
@@ -87,6 +91,13 @@ func DoYourThing() {
 	if err != nil {
 		log.Fatal(errors.Prefix("could not clean up incomplete head block", err))
 	}
+	reloadStoredSubscribers()
+	// Subscribers added or removed through the API of another process are picked up by reloading them.
+	scheduleJob(reloadStoredSubscribers, "Subscriber Reload", storedSubscribersReloadInterval)
+	// Notifications are delivered while catching up too, the outbox would otherwise hold them all until the head.
+	scheduleJob(notifications.DeliverOutbox, "Notification Delivery", 1*time.Second)
+	scheduleJob(publisher.PublishOutbox, "Change Publishing", 1*time.Second)
+	scheduleJob(alerts.Evaluate, "Alerts", alerts.Interval)
 	asyncStoppable(runDaemon)
 
 	interruptChan := make(chan os.Signal, 1)
@@ -103,11 +114,22 @@ func initJobs() {
 	scheduleJob(jobs.SyncAddressBalancesJob, "Address Balance Sync", 24*time.Hour)
 	scheduleJob(jobs.TransactionValueASync, "Transaction Value Sync", 24*time.Hour)
 	scheduleJob(jobs.SyncClaimsInChannelJob, "Claim Count in Channel Sync", 24*time.Hour)
+	scheduleJob(jobs.ChainStatSync, "Chain Stats Sync", 10*time.Minute)
+	if jobs.AddressClustering {
+		scheduleJob(jobs.AddressClusterSync, "Address Cluster Sync", 1*time.Hour)
+	}
 	//ChainSync job should never be run later than 2.5 minutes or its possible it will never loop back due to coinbase time
 	scheduleJob(jobs.ChainSyncAsync, "Chain Sync", 5*time.Second)
 	scheduleJob(backfillLegacyBlockStates, "Legacy Block State Backfill", legacyBlockStateBackfillInterval)
 }
 
+func reloadStoredSubscribers() {
+	err := notifications.LoadStoredSubscribers()
+	if err != nil {
+		log.Error(errors.Prefix("could not load the stored subscribers", err))
+	}
+}
+
 func backfillLegacyBlockStates() {
 	count, err := processing.BackfillLegacyBlockStates(processing.LegacyBlockBackfillBatchSize)
 	if err != nil {
@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/daemon/processing"
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const abnormalClaimSync = "AbnormalClaimSync: "
+
+// maxReasonLength is the length of the failure_reason column.
+const maxReasonLength = 1024
+
+// AbnormalClaimSync retries decoding the claims that were stored as abnormal because they could not be decoded when
+// they were processed. The ones the current decoder understands are promoted into the claim table, the others keep
+// the reason they still cannot be promoted.
+func AbnormalClaimSync() {
+	metrics.JobLoad.WithLabelValues("abnormal_claim_sync").Inc()
+	defer metrics.JobLoad.WithLabelValues("abnormal_claim_sync").Dec()
+	defer metrics.Job(time.Now(), "abnormal_claim_sync")
+	promoted, failed, err := reprocessAbnormalClaims()
+	if err != nil {
+		logrus.Error(abnormalClaimSync, errors.FullTrace(err))
+	}
+	logrus.Info(abnormalClaimSync, "promoted ", promoted, " claims, ", failed, " remain abnormal")
+}
+
+func reprocessAbnormalClaims() (promoted, failed int, err error) {
+	const batchSize = 1000
+	lastID := uint64(0)
+	for {
+		abnormalClaims, err := model.AbnormalClaims(
+			model.AbnormalClaimWhere.ID.GT(lastID),
+			qm.OrderBy(model.AbnormalClaimColumns.ID),
+			qm.Limit(batchSize)).AllG()
+		if err != nil {
+			return promoted, failed, errors.Err(err)
+		}
+		if len(abnormalClaims) == 0 {
+			return promoted, failed, nil
+		}
+		var names []string
+		for _, abnormal := range abnormalClaims {
+			lastID = abnormal.ID
+			reason, err := processing.PromoteAbnormalClaim(abnormal)
+			if err != nil {
+				metrics.AbnormalClaims.WithLabelValues("error").Inc()
+				logrus.Error(abnormalClaimSync, "could not promote claim ", abnormal.ClaimID, ": ", err)
+				reason = err.Error()
+			}
+			if reason != "" {
+				failed++
+				if err == nil {
+					metrics.AbnormalClaims.WithLabelValues("failed").Inc()
+				}
+				if err := setAbnormalClaimFailure(abnormal, reason); err != nil {
+					return promoted, failed, err
+				}
+				continue
+			}
+			promoted++
+			metrics.AbnormalClaims.WithLabelValues("promoted").Inc()
+			names = append(names, abnormal.Name)
+		}
+		if len(names) > 0 {
+			err = updatePromotedNames(names)
+			if err != nil {
+				return promoted, failed, err
+			}
+		}
+		logrus.Debug(abnormalClaimSync, "reprocessed abnormal claims up to id ", lastID)
+	}
+}
+
+// updatePromotedNames recomputes the claimtrie state and short URLs of the names of promoted claims so the new claim
+// rows get their bid state, amounts and short URL.
+func updatePromotedNames(names []string) error {
+	processing.BlockLock.Lock()
+	defer processing.BlockLock.Unlock()
+	height, err := datastore.GetChainHeight()
+	if err != nil {
+		return err
+	}
+	err = claimtrie.UpdateNames(names, height)
+	if err != nil {
+		return err
+	}
+	return processing.UpdateShortURLs(names)
+}
+
+// setAbnormalClaimFailure records why an abnormal claim could not be promoted.
+func setAbnormalClaimFailure(abnormal *model.AbnormalClaim, reason string) error {
+	abnormal.FailureReason.SetValid(truncateReason(reason))
+	return errors.Err(abnormal.UpdateG(boil.Whitelist(model.AbnormalClaimColumns.FailureReason)))
+}
+
+// truncateReason shortens a failure reason longer than maxReasonLength bytes, cutting it on a rune boundary.
+func truncateReason(reason string) string {
+	if len(reason) <= maxReasonLength {
+		return reason
+	}
+	cut := maxReasonLength - 3
+	for cut > 0 && !utf8.RuneStart(reason[cut]) {
+		cut--
+	}
+	return reason[:cut] + "..."
+}
@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateReason(t *testing.T) {
+	short := "could not decode"
+	if truncateReason(short) != short {
+		t.Fatalf("expected a short reason to be kept, got %s", truncateReason(short))
+	}
+	cases := []string{
+		strings.Repeat("a", maxReasonLength+1),
+		strings.Repeat("é", maxReasonLength),
+		"a" + strings.Repeat("€", maxReasonLength),
+	}
+	for _, reason := range cases {
+		truncated := truncateReason(reason)
+		if len(truncated) > maxReasonLength || !utf8.ValidString(truncated) || !strings.HasSuffix(truncated, "...") {
+			t.Errorf("expected a valid reason of at most %d bytes ending with ..., got %d bytes: %q", maxReasonLength,
+				len(truncated), truncated[max(0, len(truncated)-10):])
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package jobs
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/lbryio/lbry.go/v2/extras/query"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const addressClusterSyncJob = "addressclustersyncjob"
+const addressClusterSync = "AddressClusterSync: "
+
+// AddressClustering turns on the address clustering job, which groups addresses into wallets.
+var AddressClustering bool
+
+// addressClusterConfirmations is how many blocks below the chain head are left to be clustered later, so reorgs
+// rarely replace transactions that were already clustered.
+const addressClusterConfirmations = 6
+
+const addressClusterBlocksPerBatch = 1000
+const addressClusterRowsPerQuery = 5000
+
+var addressClusterSyncRunning atomic.Bool
+
+type addressClusterSyncStatus struct {
+	LastHeight uint64 `json:"last_height"`
+}
+
+// AddressClusterSync groups addresses into wallets with the common-input-ownership heuristic: the addresses spending
+// inputs of the same transaction are assumed to be controlled by the same wallet. Every address clustered is stored
+// in address_cluster with the id of its wallet, the lowest address id in it. It continues from the last clustered
+// height.
+func AddressClusterSync() {
+	if !addressClusterSyncRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer addressClusterSyncRunning.Store(false)
+	metrics.JobLoad.WithLabelValues("address_cluster_sync").Inc()
+	defer metrics.JobLoad.WithLabelValues("address_cluster_sync").Dec()
+	defer metrics.Job(time.Now(), "address_cluster_sync")
+
+	status := &addressClusterSyncStatus{}
+	jobStatus, err := getJobState(addressClusterSyncJob, status)
+	if err != nil {
+		logrus.Error(addressClusterSync, errors.FullTrace(err))
+		return
+	}
+	head, err := model.Blocks(qm.OrderBy(model.BlockColumns.Height+" DESC"), qm.Limit(1)).OneG()
+	if err != nil {
+		logrus.Error(addressClusterSync, errors.FullTrace(errors.Err(err)))
+		return
+	}
+	for status.LastHeight+addressClusterConfirmations < head.Height {
+		started := time.Now()
+		to := min(status.LastHeight+addressClusterBlocksPerBatch, head.Height-addressClusterConfirmations)
+		clustered, err := clusterAddressesOfBlocks(status.LastHeight+1, to)
+		if err != nil {
+			logrus.Error(addressClusterSync, errors.FullTrace(err))
+			saveJobError(jobStatus, err)
+			return
+		}
+		status.LastHeight = to
+		if err := saveJobState(jobStatus, started, status); err != nil {
+			logrus.Error(addressClusterSync, errors.Prefix("could not save job status", err))
+			return
+		}
+		logrus.Debug(addressClusterSync, "clustered ", clustered, " addresses up to height ", to)
+	}
+}
+
+// clusterAddressesOfBlocks merges the wallets of the addresses spending in each transaction of the blocks between two
+// heights and returns the number of addresses it clustered.
+func clusterAddressesOfBlocks(from, to uint64) (int, error) {
+	var rows []struct {
+		TransactionID uint64 `boil:"transaction_id"`
+		AddressID     uint64 `boil:"address_id"`
+	}
+	err := queries.Raw(`
+		SELECT ta.transaction_id, ta.address_id
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN transaction_address ta ON ta.transaction_id = t.id
+		WHERE b.height BETWEEN ? AND ? AND ta.debit_amount > 0
+		ORDER BY ta.transaction_id`, from, to).BindG(context.Background(), &rows)
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	var spenders [][]uint64
+	var addressIDs []interface{}
+	for i, row := range rows {
+		if i == 0 || rows[i-1].TransactionID != row.TransactionID {
+			spenders = append(spenders, nil)
+		}
+		spenders[len(spenders)-1] = append(spenders[len(spenders)-1], row.AddressID)
+		addressIDs = append(addressIDs, row.AddressID)
+	}
+
+	clusters := make(map[uint64]uint64)
+	for start := 0; start < len(addressIDs); start += addressClusterRowsPerQuery {
+		batch := addressIDs[start:min(start+addressClusterRowsPerQuery, len(addressIDs))]
+		existing, err := model.AddressClusters(qm.WhereIn(model.AddressClusterColumns.AddressID+" IN ?", batch...)).AllG()
+		if err != nil {
+			return 0, errors.Err(err)
+		}
+		for _, cluster := range existing {
+			clusters[cluster.AddressID] = cluster.ClusterID
+		}
+	}
+
+	assigned, merged := mergeAddressClusters(spenders, clusters)
+	for into, clusterIDs := range merged {
+		ids := make([]interface{}, len(clusterIDs))
+		for i, clusterID := range clusterIDs {
+			ids[i] = clusterID
+		}
+		_, err := boil.GetDB().Exec(`UPDATE address_cluster SET cluster_id = ? WHERE cluster_id IN (`+query.Qs(len(ids))+`)`,
+			append([]interface{}{into}, ids...)...)
+		if err != nil {
+			return 0, errors.Err(err)
+		}
+	}
+	addresses := make([]uint64, 0, len(assigned))
+	for addressID := range assigned {
+		addresses = append(addresses, addressID)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i] < addresses[j] })
+	for start := 0; start < len(addresses); start += addressClusterRowsPerQuery {
+		batch := addresses[start:min(start+addressClusterRowsPerQuery, len(addresses))]
+		args := make([]interface{}, 0, 2*len(batch))
+		values := make([]string, len(batch))
+		for i, addressID := range batch {
+			args = append(args, addressID, assigned[addressID])
+			values[i] = "(?, ?)"
+		}
+		_, err := boil.GetDB().Exec(`INSERT INTO address_cluster (address_id, cluster_id) VALUES `+strings.Join(values, ", ")+
+			` ON DUPLICATE KEY UPDATE cluster_id = VALUES(cluster_id)`, args...)
+		if err != nil {
+			return 0, errors.Err(err)
+		}
+	}
+	return len(addresses), nil
+}
+
+// mergeAddressClusters merges the clusters of addresses spending in the same transaction. clusters holds the stored
+// cluster of the addresses that have one. It returns the cluster each address of a transaction with more than one
+// spending address belongs to, and for every cluster the stored clusters merged into it. A cluster is identified by
+// its lowest address id.
+func mergeAddressClusters(spenders [][]uint64, clusters map[uint64]uint64) (assigned map[uint64]uint64, merged map[uint64][]uint64) {
+	parent := make(map[uint64]uint64)
+	var find func(id uint64) uint64
+	find = func(id uint64) uint64 {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	union := func(a, b uint64) {
+		a, b = find(a), find(b)
+		if a == b {
+			return
+		}
+		if b < a {
+			a, b = b, a
+		}
+		parent[b] = a
+	}
+	clusterOf := func(addressID uint64) uint64 {
+		if clusterID, ok := clusters[addressID]; ok {
+			return clusterID
+		}
+		return addressID
+	}
+
+	var clustered []uint64
+	for _, addressIDs := range spenders {
+		if len(addressIDs) < 2 {
+			continue
+		}
+		for _, addressID := range addressIDs[1:] {
+			union(clusterOf(addressIDs[0]), clusterOf(addressID))
+		}
+		clustered = append(clustered, addressIDs...)
+	}
+
+	assigned = make(map[uint64]uint64, len(clustered))
+	for _, addressID := range clustered {
+		assigned[addressID] = find(clusterOf(addressID))
+	}
+	merged = make(map[uint64][]uint64)
+	seen := make(map[uint64]bool)
+	for _, clusterID := range clusters {
+		if seen[clusterID] {
+			continue
+		}
+		seen[clusterID] = true
+		if into := find(clusterID); into != clusterID {
+			merged[into] = append(merged[into], clusterID)
+		}
+	}
+	return assigned, merged
+}
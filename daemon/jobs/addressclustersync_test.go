@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"testing"
+)
+
+func TestMergeAddressClustersJoinsTransactionInputs(t *testing.T) {
+	assigned, merged := mergeAddressClusters([][]uint64{{5, 7}, {7, 9}, {11}, {12, 13}}, map[uint64]uint64{})
+
+	expected := map[uint64]uint64{5: 5, 7: 5, 9: 5, 12: 12, 13: 12}
+	if len(assigned) != len(expected) {
+		t.Fatalf("expected %d clustered addresses, got %v", len(expected), assigned)
+	}
+	for addressID, clusterID := range expected {
+		if assigned[addressID] != clusterID {
+			t.Fatalf("expected address %d in cluster %d, got %v", addressID, clusterID, assigned)
+		}
+	}
+	if len(merged) != 0 {
+		t.Fatalf("expected no stored clusters to be merged, got %v", merged)
+	}
+}
+
+func TestMergeAddressClustersMergesStoredClusters(t *testing.T) {
+	// 3 and 8 are already in cluster 2, 10 is already in cluster 4.
+	stored := map[uint64]uint64{3: 2, 8: 2, 10: 4}
+	assigned, merged := mergeAddressClusters([][]uint64{{8, 10}, {10, 1}}, stored)
+
+	for _, addressID := range []uint64{8, 10, 1} {
+		if assigned[addressID] != 1 {
+			t.Fatalf("expected address %d in cluster 1, got %v", addressID, assigned)
+		}
+	}
+	if len(merged) != 1 || len(merged[1]) != 2 {
+		t.Fatalf("expected clusters 2 and 4 to be merged into 1, got %v", merged)
+	}
+}
@@ -0,0 +1,385 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/global"
+	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const chainStatSyncJob = "chainstatsyncjob"
+const chainStatSync = "ChainStatSync: "
+
+// chainStatsReorgDepth is how many blocks below the last aggregated height are aggregated again on every run. It is
+// the deepest reorg the block processing handles, so periods that lost or gained blocks in a reorg are always
+// recomputed.
+const chainStatsReorgDepth = 100
+
+// ChainStatPeriods are the lengths in seconds of the periods chain stats are aggregated over.
+var ChainStatPeriods = map[string]uint64{
+	"hour": 60 * 60,
+	"day":  24 * 60 * 60,
+}
+
+var chainStatSyncRunning atomic.Bool
+
+type chainStatSyncStatus struct {
+	LastHeight uint64 `json:"last_height"`
+}
+
+// chainStatRow is a row of one of the aggregation queries, which each select a subset of these columns per period.
+type chainStatRow struct {
+	PeriodStart        uint64  `boil:"period_start"`
+	FirstHeight        uint    `boil:"first_height"`
+	LastHeight         uint    `boil:"last_height"`
+	Count              uint    `boil:"count"`
+	Amount             float64 `boil:"amount"`
+	NewStreamCount     uint    `boil:"new_stream_count"`
+	NewChannelCount    uint    `boil:"new_channel_count"`
+	NewCollectionCount uint    `boil:"new_collection_count"`
+	NewRepostCount     uint    `boil:"new_repost_count"`
+}
+
+// ChainStatSync aggregates hourly and daily network stats: blocks, transactions, fees, new addresses, new claims by
+// type, supports added and withdrawn, purchases and the number of active claims. It continues from the last aggregated
+// height, recomputing the periods of the blocks a reorg could have replaced since.
+func ChainStatSync() {
+	if !chainStatSyncRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer chainStatSyncRunning.Store(false)
+	metrics.JobLoad.WithLabelValues("chain_stat_sync").Inc()
+	defer metrics.JobLoad.WithLabelValues("chain_stat_sync").Dec()
+	defer metrics.Job(time.Now(), "chain_stat_sync")
+
+	started := time.Now()
+	status := &chainStatSyncStatus{}
+	jobStatus, err := getJobState(chainStatSyncJob, status)
+	if err != nil {
+		logrus.Error(chainStatSync, errors.FullTrace(err))
+		return
+	}
+	height, err := aggregateChainStats(status.LastHeight)
+	if err != nil {
+		logrus.Error(chainStatSync, errors.FullTrace(err))
+		saveJobError(jobStatus, err)
+		return
+	}
+	status.LastHeight = height
+	if err := saveJobState(jobStatus, started, status); err != nil {
+		logrus.Error(chainStatSync, errors.Prefix("could not save job status", err))
+	}
+}
+
+// aggregateChainStats recomputes every period from the one holding the block chainStatsReorgDepth below lastHeight
+// and returns the height of the last aggregated block.
+func aggregateChainStats(lastHeight uint64) (uint64, error) {
+	head, err := model.Blocks(qm.OrderBy(model.BlockColumns.Height+" DESC"), qm.Limit(1)).OneG()
+	if errors.Is(err, sql.ErrNoRows) {
+		return lastHeight, nil
+	}
+	if err != nil {
+		return lastHeight, errors.Err(err)
+	}
+	fromHeight := uint64(0)
+	if lastHeight > chainStatsReorgDepth {
+		fromHeight = lastHeight - chainStatsReorgDepth
+	}
+	from, err := model.Blocks(model.BlockWhere.Height.GTE(fromHeight), qm.OrderBy(model.BlockColumns.Height), qm.Limit(1)).OneG()
+	if err != nil {
+		return lastHeight, errors.Err(err)
+	}
+	for period, length := range ChainStatPeriods {
+		err := aggregateChainStatsOfPeriod(period, length, periodStart(from.BlockTime, length))
+		if err != nil {
+			return lastHeight, errors.Prefix("could not aggregate "+period+" stats", err)
+		}
+	}
+	logrus.Debug(chainStatSync, "aggregated up to height ", head.Height)
+	return head.Height, nil
+}
+
+// periodStart returns the start of the period of a length holding a time, as the queries bucket blocks.
+func periodStart(blockTime, length uint64) uint64 {
+	return blockTime - blockTime%length
+}
+
+// aggregateChainStatsOfPeriod recomputes the stats of every period of a length starting at or after start. Periods
+// are keyed by the unix time they start at and only exist while blocks were mined in them.
+func aggregateChainStatsOfPeriod(period string, length, start uint64) error {
+	stats := make(map[uint64]*model.ChainStat)
+	err := bindChainStatRows(func(row chainStatRow) {
+		stats[row.PeriodStart] = &model.ChainStat{Period: period, PeriodStart: row.PeriodStart,
+			FirstHeight: row.FirstHeight, LastHeight: row.LastHeight, BlockCount: row.Count}
+	}, `
+		SELECT b.block_time - b.block_time % ? AS period_start,
+			MIN(b.height) AS first_height, MAX(b.height) AS last_height, COUNT(*) AS count
+		FROM block b
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	apply := func(update func(stat *model.ChainStat, row chainStatRow)) func(row chainStatRow) {
+		return func(row chainStatRow) {
+			if stat, ok := stats[row.PeriodStart]; ok {
+				update(stat, row)
+			}
+		}
+	}
+
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.TransactionCount = row.Count
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	// The fees are what the inputs of the non coinbase transactions spend beyond their outputs.
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.FeeAmount += row.Amount
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COALESCE(SUM(i.value), 0) AS amount
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN input i ON i.transaction_id = t.id
+		WHERE b.block_time >= ? AND i.is_coinbase = 0
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.FeeAmount -= row.Amount
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COALESCE(SUM(o.value), 0) AS amount
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN output o ON o.transaction_id = t.id
+		WHERE b.block_time >= ?
+			AND NOT EXISTS (SELECT 1 FROM input ci WHERE ci.transaction_id = t.id AND ci.is_coinbase = 1)
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.NewAddressCount = row.Count
+	}), `
+		SELECT TIMESTAMPDIFF(SECOND, '1970-01-01', a.first_seen) - TIMESTAMPDIFF(SECOND, '1970-01-01', a.first_seen) % ? AS period_start,
+			COUNT(*) AS count
+		FROM address a
+		WHERE a.first_seen >= ?
+		GROUP BY period_start`, length, time.Unix(int64(start), 0).UTC())
+	if err != nil {
+		return err
+	}
+	created := make(map[uint64]uint)
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.NewStreamCount = row.NewStreamCount
+		stat.NewChannelCount = row.NewChannelCount
+		stat.NewCollectionCount = row.NewCollectionCount
+		stat.NewRepostCount = row.NewRepostCount
+		created[row.PeriodStart] = row.Count
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start,
+			COALESCE(SUM(c.type = '`+global.StreamClaimType+`'), 0) AS new_stream_count,
+			COALESCE(SUM(c.type = '`+global.ChannelClaimType+`'), 0) AS new_channel_count,
+			COALESCE(SUM(c.type = '`+global.ClaimListClaimType+`'), 0) AS new_collection_count,
+			COALESCE(SUM(c.type = '`+global.ClaimReferenceClaimType+`'), 0) AS new_repost_count,
+			COUNT(*) AS count
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN claim c ON c.transaction_hash_id = t.hash
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.SupportAddedCount = row.Count
+		stat.SupportAddedAmount = row.Amount
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count, COALESCE(SUM(s.support_amount), 0) AS amount
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN support s ON s.transaction_hash_id = t.hash
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.SupportWithdrawnCount = row.Count
+		stat.SupportWithdrawnAmount = row.Amount
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count, COALESCE(SUM(s.support_amount), 0) AS amount
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN support s ON s.spent_transaction_hash = t.hash
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	err = bindChainStatRows(apply(func(stat *model.ChainStat, row chainStatRow) {
+		stat.PurchaseCount = row.Count
+		stat.PurchaseAmount = row.Amount
+	}), `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count,
+			COALESCE(SUM(p.amount_satoshi), 0) / 100000000 AS amount
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN purchase p ON p.transaction_by_hash_id = t.hash
+		WHERE b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	abandoned := make(map[uint64]uint)
+	err = bindChainStatRows(func(row chainStatRow) {
+		abandoned[row.PeriodStart] = row.Count
+	}, `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count`+abandonedClaimsOfBlocks+`
+			AND b.block_time >= ?
+		GROUP BY period_start`, length, start)
+	if err != nil {
+		return err
+	}
+	expired := make(map[uint64]uint)
+	err = bindChainStatRows(func(row chainStatRow) {
+		expired[row.PeriodStart] = row.Count
+	}, `
+		SELECT b.block_time - b.block_time % ? AS period_start, COUNT(*) AS count`+expiredClaimsOfBlocks+`
+			AND b.block_time >= ?
+		GROUP BY period_start`, append(append([]interface{}{length}, expiredClaimsArgs()...), start)...)
+	if err != nil {
+		return err
+	}
+
+	active, err := activeClaimsBefore(period, start)
+	if err != nil {
+		return err
+	}
+	periodStarts := make([]uint64, 0, len(stats))
+	for periodStart := range stats {
+		periodStarts = append(periodStarts, periodStart)
+	}
+	sort.Slice(periodStarts, func(i, j int) bool { return periodStarts[i] < periodStarts[j] })
+	activeCounts := activeClaimCounts(active, periodStarts, created, abandoned, expired)
+	c := model.ChainStatColumns
+	for _, periodStart := range periodStarts {
+		stat := stats[periodStart]
+		stat.ActiveClaimCount = activeCounts[periodStart]
+		err := stat.UpsertG(boil.Whitelist(c.FirstHeight, c.LastHeight, c.BlockCount, c.TransactionCount, c.FeeAmount,
+			c.NewAddressCount, c.NewStreamCount, c.NewChannelCount, c.NewCollectionCount, c.NewRepostCount,
+			c.SupportAddedCount, c.SupportAddedAmount, c.SupportWithdrawnCount, c.SupportWithdrawnAmount,
+			c.PurchaseCount, c.PurchaseAmount, c.ActiveClaimCount), boil.Infer())
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+
+	// Periods whose blocks were all removed by a reorg no longer exist.
+	mods := []qm.QueryMod{model.ChainStatWhere.Period.EQ(period), model.ChainStatWhere.PeriodStart.GTE(start)}
+	if len(periodStarts) > 0 {
+		mods = append(mods, model.ChainStatWhere.PeriodStart.NIN(periodStarts))
+	}
+	return errors.Err(model.ChainStats(mods...).DeleteAllG())
+}
+
+// abandonedClaimsOfBlocks joins the blocks with the claims whose last outpoint they spent, abandoning the claim.
+const abandonedClaimsOfBlocks = `
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN input i ON i.transaction_id = t.id
+		INNER JOIN output o ON o.spent_by_input_id = i.id
+		INNER JOIN claim c ON c.claim_id = o.claim_id
+		WHERE o.transaction_hash = COALESCE(c.transaction_hash_update, c.transaction_hash_id)
+			AND o.vout = COALESCE(c.vout_update, c.vout)`
+
+// expiredClaimsOfBlocks joins the blocks with the claims that expired at their height, a claim expiring a number of
+// blocks after the height it was accepted at. Claims abandoned before they expired are left to abandonedClaimsOfBlocks.
+const expiredClaimsOfBlocks = `
+		FROM claim c
+		INNER JOIN block b ON b.height = IF(c.height + ? > ?, c.height + ?, c.height + ?)
+		WHERE c.bid_state = ?`
+
+func expiredClaimsArgs() []interface{} {
+	return []interface{}{claimtrie.OriginalClaimExpirationTime, claimtrie.ExtendedClaimExpirationForkHeight,
+		claimtrie.ExtendedClaimExpirationTime, claimtrie.OriginalClaimExpirationTime, claimtrie.BidStateExpired}
+}
+
+// activeClaimCounts carries the number of active claims forward from active, at the start of the first period, through
+// the periods in order: each period adds the claims created in it and removes those abandoned or expired in it.
+func activeClaimCounts(active int64, periodStarts []uint64, created, abandoned, expired map[uint64]uint) map[uint64]uint {
+	counts := make(map[uint64]uint, len(periodStarts))
+	for _, periodStart := range periodStarts {
+		active = active + int64(created[periodStart]) - int64(abandoned[periodStart]) - int64(expired[periodStart])
+		counts[periodStart] = uint(max(active, 0))
+	}
+	return counts
+}
+
+// activeClaimsBefore returns the number of active claims at the start of a period: the count stored with the
+// period before it, or if there is none, the claims created before it less the ones abandoned or expired before it.
+func activeClaimsBefore(period string, start uint64) (int64, error) {
+	previous, err := model.ChainStats(model.ChainStatWhere.Period.EQ(period), model.ChainStatWhere.PeriodStart.LT(start),
+		qm.OrderBy(model.ChainStatColumns.PeriodStart+" DESC"), qm.Limit(1)).OneG()
+	if err == nil {
+		return int64(previous.ActiveClaimCount), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.Err(err)
+	}
+	var created, abandoned, expired int64
+	err = bindChainStatRows(func(row chainStatRow) { created = int64(row.Count) }, `
+		SELECT COUNT(*) AS count
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN claim c ON c.transaction_hash_id = t.hash
+		WHERE b.block_time < ?`, start)
+	if err != nil {
+		return 0, err
+	}
+	err = bindChainStatRows(func(row chainStatRow) { abandoned = int64(row.Count) }, `
+		SELECT COUNT(*) AS count`+abandonedClaimsOfBlocks+`
+			AND b.block_time < ?`, start)
+	if err != nil {
+		return 0, err
+	}
+	err = bindChainStatRows(func(row chainStatRow) { expired = int64(row.Count) }, `
+		SELECT COUNT(*) AS count`+expiredClaimsOfBlocks+`
+			AND b.block_time < ?`, append(expiredClaimsArgs(), start)...)
+	if err != nil {
+		return 0, err
+	}
+	return created - abandoned - expired, nil
+}
+
+func bindChainStatRows(apply func(row chainStatRow), sqlQuery string, args ...interface{}) error {
+	var rows []chainStatRow
+	err := queries.Raw(sqlQuery, args...).BindG(context.Background(), &rows)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, row := range rows {
+		apply(row)
+	}
+	return nil
+}
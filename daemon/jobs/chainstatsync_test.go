@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"testing"
+)
+
+func TestPeriodStart(t *testing.T) {
+	hour, day := ChainStatPeriods["hour"], ChainStatPeriods["day"]
+	cases := []struct {
+		blockTime, length, expected uint64
+	}{
+		{1700000000, hour, 1699999200},
+		{1699999200, hour, 1699999200},
+		{1699999199, hour, 1699995600},
+		{1700000000, day, 1699920000},
+		{1699920000 + day - 1, day, 1699920000},
+	}
+	for _, c := range cases {
+		if start := periodStart(c.blockTime, c.length); start != c.expected {
+			t.Errorf("expected period of %d with length %d to start at %d, got %d", c.blockTime, c.length, c.expected, start)
+		}
+	}
+}
+
+func TestActiveClaimCountsCarryForward(t *testing.T) {
+	periodStarts := []uint64{0, 3600, 7200, 10800}
+	created := map[uint64]uint{0: 5, 3600: 2, 10800: 1}
+	abandoned := map[uint64]uint{3600: 1, 7200: 3}
+	expired := map[uint64]uint{7200: 2}
+
+	counts := activeClaimCounts(10, periodStarts, created, abandoned, expired)
+	expected := map[uint64]uint{0: 15, 3600: 16, 7200: 11, 10800: 12}
+	for periodStart, count := range expected {
+		if counts[periodStart] != count {
+			t.Errorf("expected %d active claims in period %d, got %d", count, periodStart, counts[periodStart])
+		}
+	}
+}
+
+func TestActiveClaimCountsNeverGoNegative(t *testing.T) {
+	counts := activeClaimCounts(1, []uint64{0, 3600}, map[uint64]uint{3600: 2}, nil, map[uint64]uint{0: 3})
+	if counts[0] != 0 || counts[3600] != 0 {
+		t.Fatalf("expected the counts to stay at zero, got %v", counts)
+	}
+}
@@ -590,6 +590,7 @@ func getChainSyncJobStatus() (*model.JobStatus, error) {
 func doneChainSyncJob(jobStatus *model.JobStatus) {
 	jobStatus.LastSync = time.Now()
 	jobStatus.IsSuccess = true
+	jobStatus.Failures = 0
 	bytes, err := json.Marshal(&chainSync)
 	if err != nil {
 		logrus.Error(err)
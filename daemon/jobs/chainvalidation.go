@@ -1,9 +1,14 @@
 package jobs
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"sync/atomic"
 	"time"
 
+	"github.com/lbryio/chainquery/daemon/processing"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/metrics"
 	"github.com/lbryio/chainquery/model"
@@ -11,49 +16,100 @@ import (
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
 	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-var validatingChain = false
+var validatingChain atomic.Bool
 var debug = false
 
+// AutoRepairChain turns on the repair of the heights the chain validation finds to be missing data. The missing data of
+// repaired heights is processed again from lbrycrd.
+var AutoRepairChain bool
+
+// ChainRepairLimit is the maximum number of heights repaired in a single chain validation run.
+var ChainRepairLimit = 10
+
+// ChainRepairDelay Specifies the duration, in milliseconds, between each repaired height so repairs do not starve the
+// chain sync of the block lock.
+var ChainRepairDelay = 1000
+
 const chainValidationJob = "chainvalidationjob"
+const maxChainRepairHistory = 100
+
+var repairBlock = processing.RepairBlock
+
+type chainValidationState struct {
+	LastRun      time.Time          `json:"last_run"`
+	MissingData  int                `json:"missing_data"`
+	Repaired     []chainRepairEntry `json:"repaired"`
+	Failed       []chainRepairEntry `json:"failed"`
+	SkippedHead  int                `json:"skipped_head"`
+	Deferred     int                `json:"deferred"`
+	TotalRepairs int                `json:"total_repairs"`
+}
+
+type chainRepairEntry struct {
+	Height         uint64    `json:"height"`
+	TxHashes       []string  `json:"tx_hashes,omitempty"`
+	MissingInputs  int       `json:"missing_inputs"`
+	MissingOutputs int       `json:"missing_outputs"`
+	Error          string    `json:"error,omitempty"`
+	RepairedAt     time.Time `json:"repaired_at"`
+}
 
 // ValidateChain goes through the entire chain to make sure the data matches what is in the block chain. If there are
-// differences it will log an error message identifying the magnitude of the difference.
+// differences it will log an error message identifying the magnitude of the difference. When AutoRepairChain is set the
+// heights with differences are reprocessed, up to ChainRepairLimit per run.
 func ValidateChain() {
-	if !validatingChain {
+	if validatingChain.CompareAndSwap(false, true) {
 		go func() {
+			defer validatingChain.Store(false)
 			metrics.JobLoad.WithLabelValues("validate_chain").Inc()
 			defer metrics.JobLoad.WithLabelValues("validate_chain").Dec()
 			defer metrics.Job(time.Now(), "validate_chain")
-			var job *model.JobStatus
-			exists, err := model.JobStatuses(qm.Where(model.JobStatusColumns.JobName+"=?", chainValidationJob)).ExistsG()
+			job, err := model.FindJobStatusG(chainValidationJob)
 			if err != nil {
-				logrus.Error("Chain Validation: ", err)
-				return
-			}
-			if !exists {
+				if !errors.Is(err, sql.ErrNoRows) {
+					logrus.Error("Chain Validation: ", err)
+					return
+				}
 				job = &model.JobStatus{JobName: chainValidationJob}
-			} else {
-				job, err = model.JobStatuses(qm.Where(model.JobStatusColumns.JobName+"=?", chainValidationJob)).OneG()
+			}
+			state := &chainValidationState{}
+			if job.State.Valid {
+				err = json.Unmarshal(job.State.JSON, state)
 				if err != nil {
 					logrus.Error("Chain Validation: ", err)
-					return
 				}
 			}
 			startOfChain := uint64(0)
 			missingData, err := ValidateChainRange(&startOfChain, nil)
+			job.IsSuccess = err == nil
+			job.ErrorMessage = null.String{}
 			if err != nil {
 				job.ErrorMessage.SetValid(err.Error())
-				job.IsSuccess = false
+				job.Failures++
+			} else {
+				job.Failures = 0
 			}
 
 			if len(missingData) > 0 {
 				job.ErrorMessage.SetValid(fmt.Sprintf("%d pieces of missing data", len(missingData)))
 			}
 
+			state.LastRun = time.Now()
+			state.MissingData = len(missingData)
+			if AutoRepairChain && len(missingData) > 0 {
+				state.repair(missingData, ChainRepairLimit, time.Duration(ChainRepairDelay)*time.Millisecond)
+			}
+			bytes, err := json.Marshal(state)
+			if err != nil {
+				logrus.Error("Chain Validation: ", err)
+				return
+			}
+			job.State.SetValid(bytes)
 			job.LastSync = time.Now()
 
 			err = job.UpsertG(boil.Infer(), boil.Infer())
@@ -65,6 +121,66 @@ func ValidateChain() {
 	}
 }
 
+// repair reprocesses the heights found with missing data, at most limit heights per call, and records the outcome.
+func (s *chainValidationState) repair(missingData []BlockData, limit int, delay time.Duration) {
+	s.SkippedHead = 0
+	s.Deferred = 0
+	repairs := 0
+	for _, entry := range groupByHeight(missingData) {
+		if repairs >= limit {
+			s.Deferred++
+			continue
+		}
+		if repairs > 0 && delay > 0 {
+			time.Sleep(delay)
+		}
+		err := repairBlock(entry.Height)
+		if errors.Is(err, processing.ErrRepairHeadBlock) {
+			s.SkippedHead++
+			continue
+		}
+		repairs++
+		entry.RepairedAt = time.Now()
+		if err != nil {
+			logrus.Errorf("Chain Validation: failed to repair height %d: %s", entry.Height, err.Error())
+			entry.Error = err.Error()
+			s.Failed = appendRepairEntry(s.Failed, entry)
+			continue
+		}
+		logrus.Infof("Chain Validation: repaired height %d", entry.Height)
+		s.Repaired = appendRepairEntry(s.Repaired, entry)
+		s.TotalRepairs++
+	}
+}
+
+func groupByHeight(missingData []BlockData) []chainRepairEntry {
+	var entries []chainRepairEntry
+	index := make(map[uint64]int)
+	for _, data := range missingData {
+		i, ok := index[data.Block]
+		if !ok {
+			i = len(entries)
+			index[data.Block] = i
+			entries = append(entries, chainRepairEntry{Height: data.Block})
+		}
+		if data.TxHash != "" {
+			entries[i].TxHashes = append(entries[i].TxHashes, data.TxHash)
+		}
+		entries[i].MissingInputs += data.MissingInputs
+		entries[i].MissingOutputs += data.MissingOutputs
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Height < entries[j].Height })
+	return entries
+}
+
+func appendRepairEntry(entries []chainRepairEntry, entry chainRepairEntry) []chainRepairEntry {
+	entries = append(entries, entry)
+	if len(entries) > maxChainRepairHistory {
+		entries = entries[len(entries)-maxChainRepairHistory:]
+	}
+	return entries
+}
+
 // BlockData type holds information about where differences are in Chainquery vs the Blockchain.
 type BlockData struct {
 	Block          uint64
@@ -96,12 +212,12 @@ func ValidateChainRange(from, to *uint64) ([]BlockData, error) {
 		if err != nil {
 			return nil, errors.Err(err)
 		}
-		block, err := model.Blocks(qm.Select(model.BlockColumns.Hash), qm.Where(model.BlockColumns.Height+"=?", *from)).OneG()
-		if err != nil {
-			return nil, errors.Err(err)
-		}
 
 		if haveBlock {
+			block, err := model.Blocks(qm.Select(model.BlockColumns.Hash), qm.Where(model.BlockColumns.Height+"=?", *from)).OneG()
+			if err != nil {
+				return nil, errors.Err(err)
+			}
 			hash, err := lbrycrd.GetBlockHash(*from)
 			if err != nil {
 				return nil, errors.Err(err)
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/lbryio/chainquery/daemon/processing"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+func TestGroupByHeightMergesTransactions(t *testing.T) {
+	entries := groupByHeight([]BlockData{
+		{Block: 12, TxHash: "b", MissingInputs: 1},
+		{Block: 10},
+		{Block: 12, TxHash: "a", MissingOutputs: 2},
+	})
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 heights, got %d", len(entries))
+	}
+	if entries[0].Height != 10 || entries[1].Height != 12 {
+		t.Fatalf("expected heights ordered 10,12 got %d,%d", entries[0].Height, entries[1].Height)
+	}
+	if len(entries[1].TxHashes) != 2 || entries[1].MissingInputs != 1 || entries[1].MissingOutputs != 2 {
+		t.Fatalf("expected merged tx data for height 12, got %+v", entries[1])
+	}
+}
+
+func TestChainValidationRepairRespectsLimitAndHead(t *testing.T) {
+	original := repairBlock
+	defer func() { repairBlock = original }()
+
+	var repaired []uint64
+	repairBlock = func(height uint64) error {
+		switch {
+		case height >= 5:
+			return processing.ErrRepairHeadBlock
+		case height == 3:
+			return errors.Err("lbrycrd unavailable")
+		}
+		repaired = append(repaired, height)
+		return nil
+	}
+
+	state := &chainValidationState{}
+	state.repair([]BlockData{{Block: 1}, {Block: 2}, {Block: 3}, {Block: 4}, {Block: 5}}, 3, 0)
+
+	if len(repaired) != 2 || repaired[0] != 1 || repaired[1] != 2 {
+		t.Fatalf("expected heights 1 and 2 to be repaired, got %v", repaired)
+	}
+	if len(state.Failed) != 1 || state.Failed[0].Height != 3 || state.Failed[0].Error == "" {
+		t.Fatalf("expected height 3 to be recorded as failed, got %+v", state.Failed)
+	}
+	if state.Deferred != 2 {
+		t.Fatalf("expected 2 deferred heights, got %d", state.Deferred)
+	}
+	if state.TotalRepairs != 2 {
+		t.Fatalf("expected 2 total repairs, got %d", state.TotalRepairs)
+	}
+
+	// The heights at the head are skipped without counting against the limit.
+	repaired = nil
+	state = &chainValidationState{}
+	state.repair([]BlockData{{Block: 4}, {Block: 5}, {Block: 6}}, 2, 0)
+
+	if len(repaired) != 1 || repaired[0] != 4 {
+		t.Fatalf("expected height 4 to be repaired, got %v", repaired)
+	}
+	if state.SkippedHead != 2 || state.Deferred != 0 || len(state.Failed) != 0 {
+		t.Fatalf("expected heights 5 and 6 to be skipped as the head, got %+v", state)
+	}
+}
@@ -3,21 +3,19 @@ package jobs
 import (
 	"database/sql"
 	"encoding/json"
-	"fmt"
 	"runtime"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/lbryio/chainquery/daemon/processing"
+	"github.com/lbryio/chainquery/daemon/claimtrie"
 	"github.com/lbryio/chainquery/datastore"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/metrics"
 	"github.com/lbryio/chainquery/model"
 
 	"github.com/lbryio/lbry.go/v2/extras/errors"
-	"github.com/lbryio/lbry.go/v2/extras/query"
 
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
@@ -27,6 +25,7 @@ import (
 
 const claimTrieSyncJob = "claimtriesyncjob"
 const debugClaimTrieSync = false
+const maxRecentClaimTrieMismatches = 100
 
 var expirationHardForkHeight uint = 400155    // https://github.com/lbryio/lbrycrd/pull/137
 var hardForkBlocksToExpiration uint = 2102400 // https://github.com/lbryio/lbrycrd/pull/137
@@ -36,13 +35,32 @@ var claimTrieSyncRunning atomic.Bool
 
 var lastSync *claimTrieSyncStatus
 
+// errLbrycrdHeightChanged is returned when lbrycrd moved to another block while a name was being verified.
+var errLbrycrdHeightChanged = errors.Base("lbrycrd height changed during verification")
+
 type claimTrieSyncStatus struct {
-	JobStatus        *model.JobStatus `json:"-"`
-	PreviousSyncTime time.Time        `json:"previous_sync"`
-	LastHeight       int64            `json:"last_height"`
+	JobStatus        *model.JobStatus    `json:"-"`
+	PreviousSyncTime time.Time           `json:"previous_sync"`
+	LastHeight       int64               `json:"last_height"`
+	NamesChecked     int64               `json:"names_checked"`
+	NamesSkipped     int64               `json:"names_skipped"`
+	Mismatches       int64               `json:"mismatches"`
+	RecentMismatches []ClaimTrieMismatch `json:"recent_mismatches"`
+
+	mu sync.Mutex
+}
+
+// ClaimTrieMismatch is a difference between the claimtrie state computed by chainquery and the one reported by lbrycrd.
+type ClaimTrieMismatch struct {
+	Name    string      `json:"name"`
+	ClaimID string      `json:"claim_id,omitempty"`
+	Field   string      `json:"field"`
+	Height  uint64      `json:"height"`
+	Lbrycrd interface{} `json:"lbrycrd"`
+	Local   interface{} `json:"local"`
 }
 
-// ClaimTrieSyncAsync synchronizes claimtrie information that is calculated and enforced by lbrycrd.
+// ClaimTrieSyncAsync verifies the locally computed claimtrie against lbrycrd in the background.
 func ClaimTrieSyncAsync() {
 	if claimTrieSyncRunning.CompareAndSwap(false, true) {
 		//Run in background so the application can shutdown properly.
@@ -50,7 +68,9 @@ func ClaimTrieSyncAsync() {
 	}
 }
 
-// ClaimTrieSync syncs the claim trie bidstate, effective amount and effective height
+// ClaimTrieSync verifies the bid state, effective amount, activation height and controlling claim computed by
+// chainquery for the names changed since the last run against the claimtrie of lbrycrd. Nothing is written to the
+// claims, mismatches are logged, counted and kept in the job status.
 func ClaimTrieSync() {
 	metrics.JobLoad.WithLabelValues("claimtrie_sync").Inc()
 	defer metrics.JobLoad.WithLabelValues("claimtrie_sync").Dec()
@@ -67,40 +87,41 @@ func ClaimTrieSync() {
 		return
 	}
 	isFirstClaimTrieSync := jobStatus.LastSync.IsZero()
-	printDebug("ClaimTrieSync: updating spent claims")
-	//For Updating claims that are spent ( no longer in claimtrie )
-	if err := updateSpentClaims(); err != nil {
-		logrus.Error("ClaimTrieSync:", err)
-		saveJobError(jobStatus, err)
-		return
-	}
 
 	started := time.Now()
 	printDebug("ClaimTrieSync: getting block height")
-	//Get blockheight for calculating expired status
 	count, err := lbrycrd.GetBlockCount()
 	if err != nil {
 		logrus.Error("ClaimTrieSync: Error getting block height", err)
 		return
 	}
 	blockHeight = *count
+	localHeight, err := datastore.GetChainHeight()
+	if err != nil {
+		logrus.Error("ClaimTrieSync:", err)
+		saveJobError(jobStatus, err)
+		return
+	}
+	if localHeight < blockHeight {
+		logrus.Infof("ClaimTrieSync: local chain at %d is behind lbrycrd at %d, skipping verification", localHeight, blockHeight)
+		return
+	}
 
 	lastSync.PreviousSyncTime = jobStatus.LastSync
-	lastSync.LastHeight = int64(blockHeight)
+	lastSync.NamesChecked = 0
+	lastSync.NamesSkipped = 0
+	lastSync.Mismatches = 0
+	lastSync.RecentMismatches = nil
 
-	if isFirstClaimTrieSync {
-		logrus.Infof("first claimtriesync run detected. Boosters equipped for faster processing!")
-	}
 	claimsChan := make(chan *model.Claim, 50000)
-	processedClaims := int64(0)
-	reprocessResult := make(chan error, 1)
-	go reprocessUpdatedClaimsAsync(claimsChan, blockHeight, &processedClaims, reprocessResult)
+	verifyResult := make(chan error, 1)
+	go verifyUpdatedClaimsAsync(claimsChan, blockHeight, verifyResult)
 
 	printDebug("ClaimTrieSync: getting modified claims since " + jobStatus.LastSync.String())
 	err = getModifiedClaims(jobStatus.LastSync, claimsChan)
 	if err != nil {
 		logrus.Error("ClaimTrieSync:", err)
-		stopClaimReprocess(claimsChan, reprocessResult)
+		stopClaimVerification(claimsChan, verifyResult)
 		saveJobError(jobStatus, err)
 		return
 	}
@@ -109,7 +130,7 @@ func ClaimTrieSync() {
 		err = getSupportedClaims(jobStatus.LastSync, claimsChan)
 		if err != nil {
 			logrus.Error("ClaimTrieSync:", err)
-			stopClaimReprocess(claimsChan, reprocessResult)
+			stopClaimVerification(claimsChan, verifyResult)
 			saveJobError(jobStatus, err)
 			return
 		}
@@ -117,21 +138,23 @@ func ClaimTrieSync() {
 		err = getNewValidClaims(uint(lastSync.LastHeight), claimsChan)
 		if err != nil {
 			logrus.Error("ClaimTrieSync:", err)
-			stopClaimReprocess(claimsChan, reprocessResult)
+			stopClaimVerification(claimsChan, verifyResult)
 			saveJobError(jobStatus, err)
 			return
 		}
 	}
 	close(claimsChan)
-	logrus.Infof("ClaimTrieSync: finished getting claims to reprocess. Now waiting on consumer")
-	err = <-reprocessResult
+	logrus.Infof("ClaimTrieSync: finished getting claims to verify. Now waiting on consumer")
+	err = <-verifyResult
 	if err != nil {
 		logrus.Error("ClaimTrieSync:", err)
 		saveJobError(jobStatus, err)
 		return
 	}
+	lastSync.LastHeight = int64(blockHeight)
 	jobStatus.LastSync = started
 	jobStatus.IsSuccess = true
+	jobStatus.Failures = 0
 	jobStatus.ErrorMessage.Valid = false
 	bytes, err := json.Marshal(&lastSync)
 	if err != nil {
@@ -142,14 +165,14 @@ func ClaimTrieSync() {
 	if err := jobStatus.UpdateG(boil.Infer()); err != nil {
 		logrus.Panic(err)
 	}
-	printDebug("ClaimTrieSync: Processed " + strconv.Itoa(int(atomic.LoadInt64(&processedClaims))) + " claims.")
+	logrus.Infof("ClaimTrieSync: verified %d names at height %d, %d mismatches, %d skipped", lastSync.NamesChecked, blockHeight, lastSync.Mismatches, lastSync.NamesSkipped)
 }
 
-func reprocessUpdatedClaimsAsync(claimsChan chan *model.Claim, currentHeight uint64, processedClaims *int64, result chan<- error) {
-	result <- reprocessUpdatedClaims(claimsChan, currentHeight, processedClaims)
+func verifyUpdatedClaimsAsync(claimsChan chan *model.Claim, atHeight uint64, result chan<- error) {
+	result <- verifyUpdatedClaims(claimsChan, atHeight)
 }
 
-func stopClaimReprocess(claimsChan chan *model.Claim, result <-chan error) {
+func stopClaimVerification(claimsChan chan *model.Claim, result <-chan error) {
 	close(claimsChan)
 	err := <-result
 	if err != nil {
@@ -157,242 +180,143 @@ func stopClaimReprocess(claimsChan chan *model.Claim, result <-chan error) {
 	}
 }
 
-func reprocessUpdatedClaims(claimsChan chan *model.Claim, currentHeight uint64, processedClaims *int64) error {
-	const BatchSize = 5000
-	reprocessedNamesMap := make(map[string]bool, 500000)
-	claimsBatch := make(model.ClaimSlice, 0, BatchSize)
-	for {
-		select {
-		case c, hasMore := <-claimsChan:
-			if hasMore && !reprocessedNamesMap[c.Name] {
-				claimsBatch = append(claimsBatch, c)
-				reprocessedNamesMap[c.Name] = true
-			}
-			if len(claimsBatch) > BatchSize || !hasMore {
-				printDebug("ClaimTrieSync: Claims to update " + strconv.Itoa(len(claimsBatch)))
-				//For syncing the claims
-				err := SyncClaims(claimsBatch)
-				if err != nil {
-					return err
-				}
-
-				//For Setting Controlling Claims
-				err = SetControllingClaimForNames(claimsBatch, currentHeight)
-				if err != nil {
-					return err
-				}
-				atomic.AddInt64(processedClaims, int64(len(claimsBatch)))
-				claimsBatch = make(model.ClaimSlice, 0, BatchSize)
-			}
-			if !hasMore {
-				return nil
-			}
-		}
-	}
-}
-
-func initSyncWorkers(nrWorkers int, jobs <-chan lbrycrd.Claim, wg *sync.WaitGroup) {
-
-	for i := 0; i < nrWorkers; i++ {
+func verifyUpdatedClaims(claimsChan chan *model.Claim, atHeight uint64) error {
+	verifiedNames := make(map[string]bool, 500000)
+	names := make(chan string, 1000)
+	errs := make(chan error, 1)
+	wg := sync.WaitGroup{}
+	for i := 0; i < runtime.NumCPU(); i++ {
 		wg.Add(1)
-		go syncProcessor(jobs, wg)
+		go verifyProcessor(names, atHeight, errs, &wg)
 	}
-}
-
-func initControllingWorkers(nrWorkers int, jobs <-chan string, wg *sync.WaitGroup, atHeight uint64) {
-
-	for i := 0; i < nrWorkers; i++ {
-		wg.Add(1)
-		go controllingProcessor(jobs, wg, atHeight)
+	for c := range claimsChan {
+		if verifiedNames[c.Name] {
+			continue
+		}
+		verifiedNames[c.Name] = true
+		names <- c.Name
 	}
-}
-
-func syncProcessor(jobs <-chan lbrycrd.Claim, wg *sync.WaitGroup) {
-	defer wg.Done()
-	for job := range jobs {
-		syncClaim(&job)
+	close(names)
+	wg.Wait()
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
 	}
 }
 
-func controllingProcessor(names <-chan string, wg *sync.WaitGroup, atHeight uint64) {
+func verifyProcessor(names <-chan string, atHeight uint64, errs chan<- error, wg *sync.WaitGroup) {
 	defer wg.Done()
 	for name := range names {
-		err := setBidStateOfClaimsForName(name, atHeight)
+		mismatches, err := VerifyName(name, atHeight)
+		if errors.Is(err, errLbrycrdHeightChanged) {
+			lastSync.recordSkipped()
+			continue
+		}
 		if err != nil {
-			logrus.Error("ClaimTrieSync:", err)
+			select {
+			case errs <- err:
+			default:
+			}
+			continue
 		}
+		lastSync.record(mismatches)
 	}
 }
 
-// SetControllingClaimForNames sets the bid state for claims with these names.
-func SetControllingClaimForNames(claims model.ClaimSlice, atHeight uint64) error {
-	printDebug("ClaimTrieSync: controlling claim status update started... ")
-	controlWg := sync.WaitGroup{}
-	names := make(map[string]string)
-	printDebug("ClaimTrieSync: Making name map...")
-	for _, claim := range claims {
-		names[claim.Name] = claim.Name
-	}
-	printDebug("ClaimTrieSync: Finished making name map...[", len(names), "]")
-	setControllingQueue := make(chan string, 1000)
-	initControllingWorkers(runtime.NumCPU()-1, setControllingQueue, &controlWg, atHeight)
-	for _, name := range names {
-		setControllingQueue <- name
-	}
-	close(setControllingQueue)
-	controlWg.Wait()
-	printDebug("ClaimTrieSync: controlling claim status update complete... ")
+func (s *claimTrieSyncStatus) recordSkipped() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NamesSkipped++
+}
 
-	return nil
+func (s *claimTrieSyncStatus) record(mismatches []ClaimTrieMismatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NamesChecked++
+	s.Mismatches += int64(len(mismatches))
+	for _, m := range mismatches {
+		logrus.WithFields(logrus.Fields{
+			"name":     m.Name,
+			"claim_id": m.ClaimID,
+			"field":    m.Field,
+			"height":   m.Height,
+			"lbrycrd":  m.Lbrycrd,
+			"local":    m.Local,
+		}).Warning("ClaimTrieSync: claimtrie mismatch")
+		metrics.ClaimTrieMismatches.WithLabelValues(m.Field).Inc()
+	}
+	s.RecentMismatches = append(s.RecentMismatches, mismatches...)
+	if len(s.RecentMismatches) > maxRecentClaimTrieMismatches {
+		s.RecentMismatches = s.RecentMismatches[len(s.RecentMismatches)-maxRecentClaimTrieMismatches:]
+	}
 }
 
-func setBidStateOfClaimsForName(name string, atHeight uint64) error {
-	claims, err := model.Claims(
-		qm.Where(model.ClaimColumns.Name+"=?", name),
-		qm.Where(model.ClaimColumns.ValidAtHeight+"<=?", atHeight),
-		qm.OrderBy(model.ClaimColumns.EffectiveAmount+" DESC")).AllG()
+// VerifyName compares the claimtrie state of a name computed by chainquery at height with the one of lbrycrd, which
+// must be at the same height. It returns the differences found.
+func VerifyName(name string, atHeight uint64) ([]ClaimTrieMismatch, error) {
+	claims, err := lbrycrd.GetClaimsForName(name)
 	if err != nil {
-		return errors.Err(err)
+		return nil, errors.Prefix("could not get claims for name "+name, err)
 	}
-	printDebug("ClaimTrieSync: found ", len(claims), " claims matching the name ", name)
-	foundControlling := false
-	for _, claim := range claims {
-		if !foundControlling && getClaimStatus(claim, atHeight) == "Active" {
-			if claim.BidState != "Controlling" {
-				claim.BidState = "Controlling"
-				unlockClaim := processing.LockClaim(claim.ClaimID)
-				err := datastore.PutClaim(claim)
-				unlockClaim()
-				if err != nil {
-					return errors.Err(err)
-				}
-			}
-			foundControlling = true
-		} else {
-			status := getClaimStatus(claim, atHeight)
-			if status != claim.BidState {
-				claim.BidState = status
-				unlockClaim := processing.LockClaim(claim.ClaimID)
-				err := datastore.PutClaim(claim)
-				unlockClaim()
-				if err != nil {
-					return errors.Err(err)
-				}
-			}
-		}
+	count, err := lbrycrd.GetBlockCount()
+	if err != nil {
+		return nil, errors.Err(err)
 	}
-	return nil
-}
-
-// SyncClaims syncs the claims' with these names effective amount and valid at height with the lbrycrd claimtrie.
-func SyncClaims(claims model.ClaimSlice) error {
-	printDebug("ClaimTrieSync: claim update started... ")
-	claimNameMap := make(map[string]bool)
-	for _, claim := range claims {
-		claimNameMap[claim.Name] = true
-	}
-	var names []string
-	for name := range claimNameMap {
-		names = append(names, name)
-	}
-	printDebug("ClaimTrieSync: ", len(names), " names to sync from lbrycrd...")
-	syncwg := sync.WaitGroup{}
-	processingQueue := make(chan lbrycrd.Claim, 1000)
-	initSyncWorkers(runtime.NumCPU()-1, processingQueue, &syncwg)
-	for i, name := range names {
-		if i%1000 == 0 {
-			printDebug("ClaimTrieSync: syncing ", i, " of ", len(names), " queued - queue size: ", len(processingQueue))
-		}
-		claims, err := lbrycrd.GetClaimsForName(name)
-		if err != nil {
-			printDebug("ClaimTrieSync: Could not get claims for name: ", name, " Error: ", err)
-		}
-		for _, claimJSON := range claims.Claims {
-			processingQueue <- claimJSON
-		}
+	if *count != atHeight {
+		return nil, errLbrycrdHeightChanged
 	}
-	close(processingQueue)
-	syncwg.Wait()
-
-	printDebug("ClaimTrieSync: claim update complete... ")
-
-	return nil
+	state, err := claimtrie.GetState(name, atHeight)
+	if err != nil {
+		return nil, err
+	}
+	return compareClaimTrieState(name, atHeight, claims, state), nil
 }
 
-func syncClaim(claimJSON *lbrycrd.Claim) {
-	hasChanges := false
-	c := model.ClaimColumns
-	claim, err := model.Claims(qm.Select(c.ID, c.ValidAtHeight, c.EffectiveAmount), model.ClaimWhere.ClaimID.EQ(claimJSON.ClaimID)).OneG()
-	if err == sql.ErrNoRows {
-		unknown, _ := model.AbnormalClaims(qm.Where(model.AbnormalClaimColumns.ClaimID+"=?", claimJSON.ClaimID)).OneG()
-		if unknown == nil {
-			printDebug("ClaimTrieSync: Missing Claim ", claimJSON.ClaimID, " ", claimJSON.TxID, " ", claimJSON.N)
-		}
-		return
+func compareClaimTrieState(name string, atHeight uint64, expected lbrycrd.ClaimsForNameResult, state *claimtrie.State) []ClaimTrieMismatch {
+	var mismatches []ClaimTrieMismatch
+	mismatch := func(claimID, field string, lbrycrdValue, localValue interface{}) {
+		mismatches = append(mismatches, ClaimTrieMismatch{Name: name, ClaimID: claimID, Field: field, Height: atHeight, Lbrycrd: lbrycrdValue, Local: localValue})
 	}
-	if err != nil {
-		logrus.Error("ClaimTrieSync: ", err)
-		return
+	local := make(map[string]claimtrie.ClaimState, len(state.Claims))
+	for _, c := range state.Claims {
+		local[c.ClaimID] = c
 	}
-	if claim.ValidAtHeight != uint(claimJSON.ValidAtHeight) {
-		claim.ValidAtHeight = uint(claimJSON.ValidAtHeight)
-		hasChanges = true
-	}
-	if claim.EffectiveAmount != claimJSON.EffectiveAmount {
-		if claimJSON.PendingAmount != 0 && claim.EffectiveAmount != claimJSON.PendingAmount {
-			claim.EffectiveAmount = claimJSON.PendingAmount
-		} else {
-			claim.EffectiveAmount = claimJSON.EffectiveAmount
+	for _, c := range expected.Claims {
+		l, ok := local[c.ClaimID]
+		if !ok {
+			mismatch(c.ClaimID, "claim", true, false)
+			continue
 		}
-		hasChanges = true
-	}
-	if hasChanges {
-		unlockClaim := processing.LockClaim(claim.ClaimID)
-		defer unlockClaim()
-		err := claim.UpdateG(boil.Whitelist(c.ValidAtHeight, c.EffectiveAmount))
-		if err != nil {
-			logrus.Error("ClaimTrieSync: unable to sync claim ", claim.ClaimID, ". JSON-", claimJSON)
-			printDebug("Error: ", err)
+		delete(local, c.ClaimID)
+		if int32(c.ValidAtHeight) != l.ActivationHeight {
+			mismatch(c.ClaimID, "valid_at_height", c.ValidAtHeight, l.ActivationHeight)
+		}
+		if c.EffectiveAmount != l.EffectiveAmount {
+			mismatch(c.ClaimID, "effective_amount", c.EffectiveAmount, l.EffectiveAmount)
+		}
+		if c.PendingAmount != 0 && c.PendingAmount != l.PendingAmount {
+			mismatch(c.ClaimID, "pending_amount", c.PendingAmount, l.PendingAmount)
 		}
 	}
-}
-
-func getClaimStatus(claim *model.Claim, atHeight uint64) string {
-	status := "Accepted"
-	var transaction *model.Transaction
-	var err error
-	t := model.TransactionColumns
-	if !claim.TransactionHashUpdate.IsZero() {
-		transaction, err = model.Transactions(qm.Select(t.ID), model.TransactionWhere.Hash.EQ(claim.TransactionHashUpdate.String)).OneG()
-	} else { //Transaction and output should never be missing if the claim exists.
-		transaction, err = claim.TransactionHash(qm.Select(t.ID)).OneG()
+	for claimID := range local {
+		mismatch(claimID, "claim", false, true)
 	}
-
-	if err != nil {
-		logrus.Errorf("could not find transaction %s for claim id %d at height %d: %s", claim.TransactionHashID.String, claim.ID, atHeight, err.Error())
-		return status
+	if len(expected.Claims) == 0 {
+		return mismatches
 	}
-	o := model.OutputColumns
-	output, err := transaction.Outputs(qm.Select(o.ID, o.IsSpent), qm.Where(model.OutputColumns.Vout+"=?", claim.VoutUpdate)).OneG()
-	if err != nil {
-		logrus.Errorf("could not find output %s - %d: %s", claim.TransactionHashID.String, claim.Vout, err)
-		return "ERROR"
-	}
-
-	if output.IsSpent {
-		status = "Spent"
+	if expected.LastTakeOverHeight != state.TakeoverHeight {
+		mismatch("", "takeover_height", expected.LastTakeOverHeight, state.TakeoverHeight)
 	}
-	height := claim.Height
-	if GetIsExpiredAtHeight(height, uint(atHeight)) {
-		status = "Expired"
+	controlling := ""
+	if best := expected.Claims[0]; uint64(best.ValidAtHeight) <= atHeight {
+		controlling = best.ClaimID
 	}
-
-	//Neither Spent or Expired = Active
-	if status == "Accepted" {
-		status = "Active"
+	if controlling != state.ControllingClaimID {
+		mismatch("", "controlling_claim", controlling, state.ControllingClaimID)
 	}
-
-	return status
+	return mismatches
 }
 
 // GetIsExpiredAtHeight checks the claim height compared to the current height to determine expiration.
@@ -497,115 +421,11 @@ func getNewValidClaims(lastHeight uint, claimsChan chan *model.Claim) error {
 	return nil
 }
 
-func getSpentClaimsToUpdate(hasUpdate bool, lastProcessed uint64) (model.ClaimSlice, uint64, error) {
-	w := model.OutputWhere
-	o := model.OutputColumns
-	outputMods := []qm.QueryMod{
-		qm.Select(o.ID, o.IsSpent, o.TransactionHash),
-		w.ModifiedAt.GTE(lastSync.PreviousSyncTime),
-		w.IsSpent.EQ(true),
-		w.ID.GT(lastProcessed),
-		qm.Limit(15000),
-	}
-	var outputs model.OutputSlice
-	var err error
-	outputs, err = model.Outputs(outputMods...).AllG()
-	if err != nil {
-		return nil, 0, errors.Err(err)
-	}
-	if len(outputs) == 0 {
-		return nil, lastProcessed, nil
-	}
-
-	var txHashList []interface{}
-	for _, o := range outputs {
-		txHashList = append(txHashList, o.TransactionHash)
-	}
-	txHashCol := model.ClaimColumns.TransactionHashID
-	if hasUpdate {
-		txHashCol = model.ClaimColumns.TransactionHashUpdate
-	}
-	c := model.ClaimColumns
-	claims, err := model.Claims(qm.Select(c.ID, c.ClaimID, txHashCol), qm.WhereIn(txHashCol+" IN ?", txHashList...)).AllG()
-	if err != nil {
-		return nil, 0, errors.Err(err)
-	}
-	if len(claims) > 0 {
-		logrus.Debugf("found %d outputs, %d claims - last claim id: %d", len(outputs), len(claims), claims[len(claims)-1].ID)
-	}
-	lastProcessed = outputs[len(outputs)-1].ID
-
-	return claims, lastProcessed, nil
-}
-
-func updateSpentClaims() error {
-	var lastProcessed uint64
-	for {
-		//Claims without updates
-		claims, newLastProcessed, err := getSpentClaimsToUpdate(false, lastProcessed)
-		if err != nil {
-			return err
-		}
-		for _, claim := range claims {
-			if !claim.TransactionHashUpdate.IsZero() {
-				continue
-			}
-			claim.BidState = "Spent"
-			claim.ModifiedAt = time.Now()
-			unlockClaim := processing.LockClaim(claim.ClaimID)
-			if err := claim.UpdateG(boil.Whitelist(model.ClaimColumns.BidState, model.ClaimColumns.ModifiedAt)); err != nil {
-				unlockClaim()
-				return err
-			}
-			unlockClaim()
-		}
-		if lastProcessed == newLastProcessed {
-			break
-		}
-		lastProcessed = newLastProcessed
-	}
-	lastProcessed = 0
-	for {
-		//Claims without updates
-		claims, newLastProcessed, err := getSpentClaimsToUpdate(true, lastProcessed)
-		if err != nil {
-			return err
-		}
-		for _, claim := range claims {
-			claim.BidState = "Spent"
-			claim.ModifiedAt = time.Now()
-		}
-
-		batch := 15000
-		for i := 0; i < len(claims); i += batch {
-			j := i + batch
-			if j > len(claims) {
-				j = len(claims)
-			}
-			args := []interface{}{time.Now()}
-			for _, c := range claims[i:j] {
-				args = append(args, c.ID)
-			}
-			updateQuery := fmt.Sprintf(`UPDATE claim use index(id) SET bid_state="Spent", modified_at = ? WHERE id IN (%s)`, query.Qs(len(claims[i:j])))
-			if _, err := boil.GetDB().Exec(updateQuery, args...); err != nil {
-				return err
-			}
-			logrus.Debugf("%d claims left to update", len(claims)-i)
-		}
-
-		if lastProcessed == newLastProcessed {
-			break
-		}
-		lastProcessed = newLastProcessed
-	}
-	return nil
-}
-
 func getClaimTrieSyncJobStatus() (*model.JobStatus, error) {
 	jobStatus, err := model.FindJobStatusG(claimTrieSyncJob)
 	if errors.Is(sql.ErrNoRows, err) {
 		syncState := claimTrieSyncStatus{PreviousSyncTime: time.Unix(458265600, 0), LastHeight: 0}
-		bytes, err := json.Marshal(syncState)
+		bytes, err := json.Marshal(&syncState)
 		if err != nil {
 			return nil, errors.Err(err)
 		}
@@ -628,6 +448,7 @@ func getClaimTrieSyncJobStatus() (*model.JobStatus, error) {
 func saveJobError(jobStatus *model.JobStatus, error error) {
 	jobStatus.ErrorMessage.SetValid(error.Error())
 	jobStatus.IsSuccess = false
+	jobStatus.Failures++
 	if err := jobStatus.UpsertG(boil.Infer(), boil.Infer()); err != nil {
 		logrus.Error(errors.Prefix("Saving Job Error Message "+error.Error(), err))
 	}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+	"github.com/lbryio/chainquery/lbrycrd"
+)
+
+func TestCompareClaimTrieStateMatches(t *testing.T) {
+	expected := lbrycrd.ClaimsForNameResult{
+		LastTakeOverHeight: 10,
+		Claims: []lbrycrd.Claim{
+			{ClaimID: "a", ValidAtHeight: 10, EffectiveAmount: 150, PendingAmount: 150},
+			{ClaimID: "b", ValidAtHeight: 400, EffectiveAmount: 0, PendingAmount: 100},
+		},
+	}
+	state := &claimtrie.State{
+		ControllingClaimID: "a",
+		TakeoverHeight:     10,
+		Claims: []claimtrie.ClaimState{
+			{ClaimID: "a", ActivationHeight: 10, EffectiveAmount: 150, PendingAmount: 150},
+			{ClaimID: "b", ActivationHeight: 400, PendingAmount: 100},
+		},
+	}
+
+	if mismatches := compareClaimTrieState("name", 300, expected, state); len(mismatches) != 0 {
+		t.Fatalf("expected no mismatches, got %+v", mismatches)
+	}
+}
+
+func TestCompareClaimTrieStateReportsDifferences(t *testing.T) {
+	expected := lbrycrd.ClaimsForNameResult{
+		LastTakeOverHeight: 20,
+		Claims: []lbrycrd.Claim{
+			{ClaimID: "a", ValidAtHeight: 10, EffectiveAmount: 150},
+			{ClaimID: "c", ValidAtHeight: 10, EffectiveAmount: 50},
+		},
+	}
+	state := &claimtrie.State{
+		ControllingClaimID: "b",
+		TakeoverHeight:     10,
+		Claims: []claimtrie.ClaimState{
+			{ClaimID: "a", ActivationHeight: 12, EffectiveAmount: 100},
+			{ClaimID: "b", ActivationHeight: 10, EffectiveAmount: 200},
+		},
+	}
+
+	fields := make(map[string]int)
+	for _, m := range compareClaimTrieState("name", 300, expected, state) {
+		fields[m.Field]++
+	}
+	for field, count := range map[string]int{"claim": 2, "valid_at_height": 1, "effective_amount": 1, "takeover_height": 1, "controlling_claim": 1} {
+		if fields[field] != count {
+			t.Fatalf("expected %d %s mismatches, got %d (%v)", count, field, fields[field], fields)
+		}
+	}
+}
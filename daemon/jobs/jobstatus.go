@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// getJobState returns the status of a job, unmarshalling its state into state. A job that never ran gets a status with
+// state as its initial state.
+func getJobState(jobName string, state interface{}) (*model.JobStatus, error) {
+	jobStatus, err := model.FindJobStatusG(jobName)
+	if errors.Is(err, sql.ErrNoRows) {
+		bytes, err := json.Marshal(state)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		jobStatus = &model.JobStatus{JobName: jobName, LastSync: time.Time{}, State: null.JSONFrom(bytes)}
+		if err := jobStatus.InsertG(boil.Infer()); err != nil {
+			return nil, errors.Prefix("could not create job status of "+jobName, err)
+		}
+		return jobStatus, nil
+	} else if err != nil {
+		return nil, errors.Err(err)
+	}
+	err = json.Unmarshal(jobStatus.State.JSON, state)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	return jobStatus, nil
+}
+
+// saveJobState marks a job run started at a time as successful and stores its state.
+func saveJobState(jobStatus *model.JobStatus, started time.Time, state interface{}) error {
+	bytes, err := json.Marshal(state)
+	if err != nil {
+		return errors.Err(err)
+	}
+	jobStatus.LastSync = started
+	jobStatus.IsSuccess = true
+	jobStatus.Failures = 0
+	jobStatus.ErrorMessage.Valid = false
+	jobStatus.State.SetValid(bytes)
+	return errors.Err(jobStatus.UpdateG(boil.Infer()))
+}
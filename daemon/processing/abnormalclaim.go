@@ -0,0 +1,110 @@
+package processing
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/global"
+	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	c "github.com/lbryio/lbry.go/v2/schema/stake"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// PromoteAbnormalClaim decodes an abnormal claim with the current claim decoder and stores it as a claim with its
+// metadata, tags and channel, then removes it from abnormal_claim. When the claim cannot be promoted the reason is
+// returned and the abnormal claim is left as is. No notifications are sent for promoted claims since they were mined
+// long before.
+func PromoteAbnormalClaim(abnormal *model.AbnormalClaim) (reason string, err error) {
+	value, err := hex.DecodeString(abnormal.ValueAsHex)
+	if err != nil {
+		return "value is not valid hex: " + err.Error(), nil
+	}
+	helper, err := c.DecodeClaimBytes(value, global.BlockChainName)
+	if err != nil {
+		return "could not decode claim: " + err.Error(), nil
+	}
+	if helper.Claim == nil {
+		return "decoded claim is empty", nil
+	}
+
+	output, err := model.FindOutputG(abnormal.OutputID)
+	if err != nil {
+		return "", errors.Prefix(fmt.Sprintf("could not find output %d of abnormal claim", abnormal.OutputID), err)
+	}
+	tx, err := model.Transactions(model.TransactionWhere.Hash.EQ(output.TransactionHash)).OneG()
+	if err != nil {
+		return "", errors.Prefix("could not find transaction of abnormal claim", err)
+	}
+	block, err := model.Blocks(model.BlockWhere.Hash.EQ(tx.BlockHashID.String)).OneG()
+	if err != nil {
+		return "", errors.Prefix("could not find block of abnormal claim", err)
+	}
+	script, err := hex.DecodeString(output.ScriptPubKeyHex.String)
+	if err != nil {
+		return "", errors.Err(err)
+	}
+	var pkscript []byte
+	if abnormal.IsUpdate {
+		_, _, _, pkscript, err = lbrycrd.ParseClaimUpdateScript(script)
+	} else {
+		_, _, pkscript, err = lbrycrd.ParseClaimNameScript(script)
+	}
+	if err != nil {
+		return "", errors.Prefix("could not parse script of abnormal claim", err)
+	}
+
+	unlockClaim := LockClaim(abnormal.ClaimID)
+	defer unlockClaim()
+	claim := datastore.GetClaim(abnormal.ClaimID)
+	if claim != nil && uint64(claim.Height) > block.Height {
+		return fmt.Sprintf("a later version of the claim at height %d is stored", claim.Height), nil
+	}
+	if claim == nil {
+		claim = &model.Claim{ClaimID: abnormal.ClaimID, TransactionHashID: null.NewString(tx.Hash, true), Vout: output.Vout}
+		err := datastore.PutClaim(claim)
+		if err != nil {
+			return "", err
+		}
+	}
+	claim.Name = abnormal.Name
+	claim.TransactionTime = tx.TransactionTime
+	claim.ClaimAddress = lbrycrd.GetAddressFromPublicKeyScript(pkscript)
+	claim.TransactionHashUpdate.SetValid(tx.Hash)
+	claim.VoutUpdate.SetValid(output.Vout)
+	claim.Height = uint(block.Height)
+	reference := claim.ClaimReference.String
+	claim, err = processClaim(helper, claim, value, *output, *tx)
+	if err != nil {
+		return "", err
+	}
+	err = datastore.PutClaim(claim)
+	if err != nil {
+		return "", err
+	}
+	err = saveClaimVersion(claim, *output, *tx, block.Height, abnormal.IsUpdate)
+	if err != nil {
+		return "", err
+	}
+	// The output was not linked to the claim while the claim did not exist.
+	output.ClaimID.SetValid(claim.ClaimID)
+	err = datastore.PutOutput(output, boil.Whitelist(model.OutputColumns.ClaimID))
+	if err != nil {
+		return "", err
+	}
+	err = resolvePendingPurchases(claim.ClaimID)
+	if err != nil {
+		return "", err
+	}
+	err = datastore.UpdateRepostCounts(claim.ClaimID, reference, claim.ClaimReference.String)
+	if err != nil {
+		return "", err
+	}
+	err = abnormal.DeleteG()
+	return "", errors.Err(err)
+}
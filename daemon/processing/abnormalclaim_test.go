@@ -0,0 +1,23 @@
+package processing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lbryio/chainquery/model"
+)
+
+func TestPromoteAbnormalClaimKeepsUndecodableClaims(t *testing.T) {
+	for value, expected := range map[string]string{
+		"zz":       "value is not valid hex",
+		"00010203": "could not decode claim",
+	} {
+		reason, err := PromoteAbnormalClaim(&model.AbnormalClaim{ClaimID: "a", ValueAsHex: value})
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %s", value, err)
+		}
+		if !strings.HasPrefix(reason, expected) {
+			t.Fatalf("expected reason %q for %s, got %q", expected, value, reason)
+		}
+	}
+}
@@ -7,11 +7,16 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/lbryio/chainquery/alerts"
+	"github.com/lbryio/chainquery/datastore"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/metrics"
 	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/notifications"
+	"github.com/lbryio/chainquery/publisher"
 	"github.com/lbryio/chainquery/sockety"
 	"github.com/lbryio/chainquery/util"
 
@@ -114,6 +119,18 @@ func ProcessBlock(height uint64, stopper *stop.Group, jsonBlock *lbrycrd.GetBloc
 	if err != nil {
 		return block, errors.Err(err)
 	}
+	names, err := updateClaimTrieAtHeight(block.Hash, block.Height)
+	if err != nil {
+		return block, errors.Err(err)
+	}
+	err = updateChannelStatsOfBlock(block.Hash, names)
+	if err != nil {
+		return block, errors.Err(err)
+	}
+	err = publisher.BlockCommitted(block)
+	if err != nil {
+		return block, errors.Prefix("could not stage the changes of the block", err)
+	}
 	err = markBlockProcessingState(block, BlockProcessingStateComplete)
 	if err != nil {
 		return block, errors.Err(err)
@@ -255,6 +272,141 @@ func MarkIncompleteBlockHeight(height uint64) error {
 	return errors.Err(markBlockProcessingState(block, BlockProcessingStateIncomplete))
 }
 
+// ErrRepairHeadBlock is returned by RepairBlock when the height is at or beyond the stored chain head. Those heights
+// belong to the chain sync and reorg handling, a repair must never race them.
+var ErrRepairHeadBlock = errors.Base("cannot repair the chain head or heights beyond it")
+
+// repairing is set while a block is repaired. The events of the block were sent when it was first processed, so the
+// transactions processed again send no notifications.
+var repairing atomic.Bool
+
+// RepairBlock processes again from lbrycrd the transactions of the block at the given height that are missing or lack
+// inputs or outputs, storing the block first if it is missing. The block is repaired in place: deleting it would cascade to the data of later blocks that
+// depends on it, like the claims signed by its channels, the supports of its claims and the spends of its outputs,
+// which would not be processed again. Consumers already got the events and changes of the block, so a repair neither
+// notifies nor publishes. If the repair fails the block is left marked incomplete so it is found again by the next
+// validation.
+func RepairBlock(height uint64) error {
+	jsonBlock, err := fetchBlockForRepair(&height)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	BlockLock.Lock()
+	defer BlockLock.Unlock()
+
+	head, err := chainHeadBlock()
+	if err != nil {
+		return errors.Err(err)
+	}
+	if height >= head.Height {
+		return ErrRepairHeadBlock
+	}
+
+	block, err := blockToRepair(height, jsonBlock)
+	if err != nil {
+		return err
+	}
+	missing, err := missingTransactionsOfBlock(block, jsonBlock.Tx)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		repairing.Store(true)
+		err = syncTransactionsOfBlock(nil, missing, block.BlockTime, block.Height)
+		repairing.Store(false)
+		if err != nil {
+			metrics.ProcessingFailures.WithLabelValues("block").Inc()
+			markErr := markBlockProcessingState(block, BlockProcessingStateIncomplete)
+			if markErr != nil {
+				logrus.Errorf("could not mark repaired block at height %d incomplete: %s", height, markErr.Error())
+			}
+			return errors.Err(err)
+		}
+	}
+
+	err = restoreSpentOutputsOfBlock(block.Hash)
+	if err != nil {
+		return errors.Err(err)
+	}
+	// The outputs processed again were stored unspent, so the names and channels of the block are computed again now
+	// that their spends are restored.
+	names, err := updateClaimTrieAtHeight(block.Hash, head.Height)
+	if err != nil {
+		return errors.Err(err)
+	}
+	err = updateChannelStatsOfBlock(block.Hash, names)
+	if err != nil {
+		return errors.Err(err)
+	}
+	return errors.Err(markBlockProcessingState(block, BlockProcessingStateComplete))
+}
+
+// blockToRepair returns the stored block of lbrycrd at a height, storing it if it is missing. Another block stored at the
+// height is left to the reorg handling.
+func blockToRepair(height uint64, jsonBlock *lbrycrd.GetBlockResponse) (*model.Block, error) {
+	stored, err := model.Blocks(
+		model.BlockWhere.Height.EQ(height),
+		model.BlockWhere.Hash.NEQ(MempoolBlockHash),
+	).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	for _, block := range stored {
+		if block.Hash != jsonBlock.Hash {
+			return nil, errors.Err("block %s stored at height %d is not the block %s of lbrycrd", block.Hash, height,
+				jsonBlock.Hash)
+		}
+	}
+	if len(stored) > 0 {
+		return stored[0], nil
+	}
+	block, err := parseBlockInfo(height, jsonBlock)
+	if err != nil {
+		return nil, err
+	}
+	return block, errors.Err(setPreviousBlockInfo(height, jsonBlock.Hash))
+}
+
+// missingTransactionsOfBlock returns, in block order, the transactions of a block that are not stored or lack some of
+// their inputs or outputs.
+func missingTransactionsOfBlock(block *model.Block, txIDs []string) ([]string, error) {
+	transactions, err := model.Transactions(model.TransactionWhere.BlockHashID.EQ(null.StringFrom(block.Hash))).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	stored := make(map[string]*model.Transaction, len(transactions))
+	for _, transaction := range transactions {
+		stored[transaction.Hash] = transaction
+	}
+	var missing []string
+	for _, txID := range txIDs {
+		transaction, ok := stored[txID]
+		if ok {
+			complete, err := transactionHasExpectedChildren(transaction)
+			if err != nil {
+				return nil, errors.Err(err)
+			}
+			if complete {
+				continue
+			}
+		}
+		missing = append(missing, txID)
+	}
+	return missing, nil
+}
+
+// restoreSpentOutputsOfBlock marks the outputs of a block as spent by the already stored inputs that reference them.
+func restoreSpentOutputsOfBlock(blockHash string) error {
+	_, err := boil.GetDB().Exec(`
+		UPDATE output o
+		INNER JOIN transaction t ON t.id = o.transaction_id
+		INNER JOIN input i ON i.prevout_hash = o.transaction_hash AND i.prevout_n = o.vout
+		SET o.is_spent = 1, o.spent_by_input_id = i.id
+		WHERE t.block_hash_id = ?`, blockHash)
+	return errors.Err(err)
+}
+
 func backfillLegacyBlockStates(limit int) (int, error) {
 	if limit <= 0 {
 		limit = LegacyBlockBackfillBatchSize
@@ -342,7 +494,10 @@ func markBlockProcessingState(block *model.Block, state string) error {
 }
 
 func deleteBlockWithRetry(block *model.Block) error {
-	var err error
+	err := datastore.ReleaseSupportSpends(block.Hash)
+	if err != nil {
+		return err
+	}
 	for attempt := 0; attempt < blockDeleteRetryAttempts; attempt++ {
 		err = block.DeleteG()
 		if err == nil {
@@ -610,6 +765,7 @@ func getBlockToProcess(height *uint64) (*lbrycrd.GetBlockResponse, error) {
 }
 
 var fetchBlockForReorg = getBlockToProcess
+var fetchBlockForRepair = getBlockToProcess
 
 func checkHandleReorg(height uint64, chainPrevHash string) (uint64, error) {
 	prevHeight := height - 1
@@ -623,6 +779,7 @@ func checkHandleReorg(height uint64, chainPrevHash string) (uint64, error) {
 			}
 			return height, errors.Prefix("error getting block@"+strconv.Itoa(int(prevHeight)), err)
 		}
+		var removedBlocks []string
 		//Recursively delete blocks until they match or a reorg of depth 100 == failure of logic.
 		for prevBlock.Hash != chainPrevHash && depth < 100 && prevHeight > 0 {
 			hashes := make([]string, len(prevBlock.R.BlockHashTransactions))
@@ -632,10 +789,31 @@ func checkHandleReorg(height uint64, chainPrevHash string) (uint64, error) {
 			fmt.Printf("block %s at height %d to be removed due to reorg. TX-> %s", prevBlock.Hash, prevBlock.Height, strings.Join(hashes, ","))
 			logrus.Printf("block %s at height %d to be removed due to reorg. TX-> %s", prevBlock.Hash, prevBlock.Height, strings.Join(hashes, ","))
 			// Delete because it needs to be reprocessed due to reorg
+			err = orphanNamesOfBlock(prevBlock.Hash)
+			if err != nil {
+				return height, errors.Prefix("error getting claim names of block@"+strconv.Itoa(int(prevHeight)), err)
+			}
+			err = orphanChannelsOfBlock(prevBlock.Hash)
+			if err != nil {
+				return height, errors.Prefix("error getting channels of block@"+strconv.Itoa(int(prevHeight)), err)
+			}
+			err = notifications.RevertEventsOfBlock(prevBlock.Hash)
+			if err != nil {
+				return height, errors.Prefix("error reverting notifications of block@"+strconv.Itoa(int(prevHeight)), err)
+			}
+			err = publisher.BlockReverted(prevBlock)
+			if err != nil {
+				return height, errors.Prefix("error staging the changes of block@"+strconv.Itoa(int(prevHeight)), err)
+			}
+			err = datastore.ReleaseSupportSpends(prevBlock.Hash)
+			if err != nil {
+				return height, errors.Prefix("error releasing support spends of block@"+strconv.Itoa(int(prevHeight)), err)
+			}
 			err = prevBlock.DeleteG()
 			if err != nil {
 				return height, errors.Prefix("error deleting block@"+strconv.Itoa(int(prevHeight)), err)
 			}
+			removedBlocks = append(removedBlocks, prevBlock.Hash)
 
 			depth++
 
@@ -667,6 +845,11 @@ func checkHandleReorg(height uint64, chainPrevHash string) (uint64, error) {
 				"height":               height,
 				"last_matching_height": prevHeight,
 			}).Warning(message)
+			alerts.Reorg(uint64(depth), height)
+			err = notifications.ReorgEvent(depth, height, prevHeight, removedBlocks)
+			if err != nil {
+				return height, errors.Prefix("error notifying reorg at height "+strconv.Itoa(int(height)), err)
+			}
 			return prevHeight, nil
 		}
 	}
@@ -61,6 +61,9 @@ func TestCleanupIncompleteHeadDeletesIncompleteHead(t *testing.T) {
 	testDB.mock.ExpectExec(updateBlockProcessingState()).
 		WithArgs(BlockProcessingStateIncomplete, sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -155,6 +158,9 @@ func TestDeleteBlockWithRetryReturnsDeleteFailure(t *testing.T) {
 	defer restoreBlockDeleteRetryDelay(oldDelay)
 
 	block := testBlock(5, 5, "bad", BlockProcessingStateIncomplete, 0)
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(block.Hash).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	for attempt := 0; attempt < blockDeleteRetryAttempts; attempt++ {
 		testDB.mock.ExpectExec(deleteBlock()).
 			WithArgs(sqlmock.AnyArg()).
@@ -184,6 +190,18 @@ func updateBlockProcessingState() string {
 	return regexp.QuoteMeta(query)
 }
 
+func releaseSupportSpends() string {
+	return regexp.QuoteMeta("UPDATE support s")
+}
+
+func claimNamesOfBlock() string {
+	return regexp.QuoteMeta("SELECT c.name FROM transaction t")
+}
+
+func channelClaimsOfBlock() string {
+	return regexp.QuoteMeta("SELECT o.claim_id FROM transaction t")
+}
+
 func deleteBlock() string {
 	query := "DELETE FROM `" + model.TableNames.Block + "` WHERE `" + model.BlockColumns.ID + "`=?"
 	return regexp.QuoteMeta(query)
@@ -3,11 +3,14 @@ package processing
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/publisher"
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -53,6 +56,82 @@ func TestCheckHandleReorgDeletesForkAndReturnsMatchingHeight(t *testing.T) {
 	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
 		WithArgs(staleParent.Hash).
 		WillReturnRows(transactionRows(transaction))
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	testDB.mock.ExpectExec(deleteBlock()).
+		WithArgs(staleParent.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(uint64(1)).
+		WillReturnRows(blockRows(canonicalGrandparent))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
+		WithArgs(canonicalGrandparent.Hash).
+		WillReturnRows(transactionRows())
+
+	height, err := checkHandleReorg(3, "canonical-parent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height != 1 {
+		t.Fatalf("expected reorg to resume at height 1, got %d", height)
+	}
+	fetcher.assertCalls(2)
+}
+
+func TestCheckHandleReorgStagesChangesOfRemovedBlock(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+	publisher.Set(publisher.NewMemory())
+	defer publisher.Set(nil)
+
+	staleParent := testBlock(2, 2, "stale-parent", BlockProcessingStateComplete, 0)
+	canonicalGrandparent := testBlock(1, 1, "canonical-grandparent", BlockProcessingStateComplete, 0)
+	transaction := testTransaction(9, staleParent.Hash, "stale-tx", 1, 1)
+	fetcher := newReorgFetchRecorder(t, map[uint64]string{
+		2: canonicalGrandparent.Hash,
+	})
+
+	restore := replaceReorgBlockFetcher(fetcher.fetch)
+	defer restore()
+
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(uint64(2)).
+		WillReturnRows(blockRows(staleParent))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
+		WithArgs(staleParent.Hash).
+		WillReturnRows(transactionRows(transaction))
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	// The changes of the block are staged before the spends it made are released and it is deleted.
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
+		WithArgs(staleParent.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{model.TransactionColumns.Hash}).AddRow(transaction.Hash))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Claim)).
+		WillReturnRows(sqlmock.NewRows([]string{model.ClaimColumns.ClaimID}))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Support)).
+		WillReturnRows(sqlmock.NewRows([]string{model.SupportColumns.TransactionHashID, model.SupportColumns.Vout,
+			model.SupportColumns.SpentTransactionHash}).AddRow("earlier-tx", 0, transaction.Hash))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Purchase)).
+		WillReturnRows(sqlmock.NewRows([]string{model.PurchaseColumns.Vout}))
+	testDB.mock.ExpectQuery(regexp.QuoteMeta("SELECT t.hash AS transaction_hash")).
+		WithArgs(staleParent.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_hash"}))
+	testDB.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `"+model.TableNames.ChangeOutbox+"`")).
+		WithArgs(staleParent.Hash, staleParent.Height, true, sqlmock.AnyArg(), publisher.OutboxPending, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.ChangeOutbox)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "attempts", "next_attempt_at", "created_at", "modified_at"}).
+			AddRow(1, 0, time.Unix(1, 0), time.Unix(1, 0), time.Unix(1, 0)))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(staleParent.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -97,6 +176,13 @@ func TestCheckHandleReorgDeletesMultipleForkBlocks(t *testing.T) {
 	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
 		WithArgs(staleParent.Hash).
 		WillReturnRows(transactionRows(parentTx))
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(staleParent.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -106,6 +192,13 @@ func TestCheckHandleReorgDeletesMultipleForkBlocks(t *testing.T) {
 	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
 		WithArgs(staleGrandparent.Hash).
 		WillReturnRows(transactionRows())
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(staleGrandparent.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -144,6 +237,13 @@ func TestCheckHandleReorgReturnsDeleteFailure(t *testing.T) {
 	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
 		WithArgs(staleParent.Hash).
 		WillReturnRows(transactionRows(transaction))
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(staleParent.ID).
 		WillReturnError(sql.ErrConnDone)
@@ -177,6 +277,13 @@ func TestCheckHandleReorgReturnsGapAfterDeletingFork(t *testing.T) {
 	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
 		WithArgs(staleParent.Hash).
 		WillReturnRows(transactionRows(transaction))
+	testDB.mock.ExpectQuery(claimNamesOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	testDB.mock.ExpectExec(releaseSupportSpends()).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	testDB.mock.ExpectExec(deleteBlock()).
 		WithArgs(staleParent.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -218,6 +325,13 @@ func TestCheckHandleReorgErrorsAfterDepthLimit(t *testing.T) {
 		}
 		fetchResponses[height] = "unmatched-chain-parent"
 		expectedCalls = append(expectedCalls, height)
+		testDB.mock.ExpectQuery(claimNamesOfBlock()).
+			WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		testDB.mock.ExpectQuery(channelClaimsOfBlock()).
+			WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+		testDB.mock.ExpectExec(releaseSupportSpends()).
+			WithArgs(sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
 		testDB.mock.ExpectExec(deleteBlock()).
 			WithArgs(block.ID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
@@ -0,0 +1,127 @@
+package processing
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
+)
+
+// TestRepairBlockKeepsLaterData repairs a block whose channel signed claims of later blocks and whose claims have
+// later supports. Only its missing transaction is processed again and nothing is deleted, so the later claims and
+// supports are left as they are: any delete would be an unexpected query.
+func TestRepairBlockKeepsLaterData(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+	disableSchedulerCleanup(t)
+
+	repaired := testBlock(5, 5, "repaired", BlockProcessingStateIncomplete, 2)
+	head := testBlock(10, 10, "head", BlockProcessingStateComplete, 1)
+	stored := testTransaction(1, repaired.Hash, "stored", 1, 1)
+
+	restoreFetchBlock, restoreFetchTx, restoreProcessTx := fetchBlockForRepair, fetchRawTransaction, processTx
+	restoreNamesOfBlock, restoreNamesPending, restoreUpdateNames, restoreShortURLs := namesOfBlock, namesPendingAtHeight,
+		updateClaimTrieNames, updateShortURLsOfNames
+	restoreChannelsOfBlock, restoreChannelsOfNames, restoreChannelStats := channelsOfBlock, channelsOfNames,
+		updateChannelStats
+	defer func() {
+		fetchBlockForRepair, fetchRawTransaction, processTx = restoreFetchBlock, restoreFetchTx, restoreProcessTx
+		namesOfBlock, namesPendingAtHeight, updateClaimTrieNames, updateShortURLsOfNames = restoreNamesOfBlock,
+			restoreNamesPending, restoreUpdateNames, restoreShortURLs
+		channelsOfBlock, channelsOfNames, updateChannelStats = restoreChannelsOfBlock, restoreChannelsOfNames,
+			restoreChannelStats
+	}()
+	fetchBlockForRepair = func(height *uint64) (*lbrycrd.GetBlockResponse, error) {
+		return &lbrycrd.GetBlockResponse{Hash: repaired.Hash, Height: int64(*height), Tx: []string{"stored", "lost"}}, nil
+	}
+	fetchRawTransaction = func(txID string) (*lbrycrd.TxRawResult, error) {
+		return &lbrycrd.TxRawResult{Txid: txID}, nil
+	}
+	var processed []string
+	processTx = func(tx *lbrycrd.TxRawResult, blockTime uint64, blockHeight uint64) error {
+		if !repairing.Load() {
+			t.Error("expected the notifications to be silenced while the block is repaired")
+		}
+		processed = append(processed, tx.Txid)
+		return nil
+	}
+	var trieHeight uint64
+	namesOfBlock = func(blockHash string) ([]string, error) { return []string{"name"}, nil }
+	namesPendingAtHeight = func(height uint64) ([]string, error) { return nil, nil }
+	updateClaimTrieNames = func(names []string, height uint64) error {
+		trieHeight = height
+		return nil
+	}
+	updateShortURLsOfNames = func(names []string) error { return nil }
+	channelsOfBlock = func(blockHash string) ([]string, error) { return []string{"channel"}, nil }
+	channelsOfNames = func(names []string) ([]string, error) { return nil, nil }
+	var channels []string
+	updateChannelStats = func(channelIDs ...string) error {
+		channels = append(channels, channelIDs...)
+		return nil
+	}
+
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(MempoolBlockHash).
+		WillReturnRows(blockRows(head))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(repaired.Height, MempoolBlockHash).
+		WillReturnRows(blockRows(repaired))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Transaction)).
+		WithArgs(repaired.Hash).
+		WillReturnRows(transactionRows(stored))
+	testDB.mock.ExpectQuery(countFrom(model.TableNames.Input)).
+		WithArgs(stored.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	testDB.mock.ExpectQuery(countFrom(model.TableNames.Output)).
+		WithArgs(stored.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	testDB.mock.ExpectExec(regexp.QuoteMeta("UPDATE output o")).
+		WithArgs(repaired.Hash).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(MempoolBlockHash).
+		WillReturnRows(blockRows(head))
+	testDB.mock.ExpectExec(updateBlockProcessingState()).
+		WithArgs(BlockProcessingStateComplete, repaired.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := RepairBlock(repaired.Height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(processed) != 1 || processed[0] != "lost" {
+		t.Fatalf("expected only the missing transaction to be processed, got %v", processed)
+	}
+	if repairing.Load() {
+		t.Fatal("expected the notifications to be back on after the repair")
+	}
+	if trieHeight != head.Height || len(channels) != 1 || channels[0] != "channel" {
+		t.Fatalf("expected the names and channels of the block to be computed at the head, got %d %v", trieHeight, channels)
+	}
+}
+
+func TestRepairBlockLeavesAStaleBlockToTheReorgHandling(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+
+	restoreFetchBlock := fetchBlockForRepair
+	defer func() { fetchBlockForRepair = restoreFetchBlock }()
+	fetchBlockForRepair = func(height *uint64) (*lbrycrd.GetBlockResponse, error) {
+		return &lbrycrd.GetBlockResponse{Hash: "canonical", Tx: []string{"tx"}}, nil
+	}
+
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(MempoolBlockHash).
+		WillReturnRows(blockRows(testBlock(10, 10, "head", BlockProcessingStateComplete, 1)))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.Block)).
+		WithArgs(uint64(5), MempoolBlockHash).
+		WillReturnRows(blockRows(testBlock(5, 5, "stale", BlockProcessingStateComplete, 1)))
+
+	err := RepairBlock(5)
+	if err == nil {
+		t.Fatal("expected an error for a stale block")
+	}
+}
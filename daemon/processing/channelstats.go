@@ -0,0 +1,61 @@
+package processing
+
+import (
+	"sync"
+
+	"github.com/lbryio/chainquery/datastore"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+// channelsOrphanedByReorg holds the channels touched by blocks removed in a reorg. Their rollup still counts the claims,
+// supports and purchases of those blocks, so it is recomputed along with the next processed block.
+var channelsOrphanedByReorg = struct {
+	sync.Mutex
+	channels map[string]bool
+}{channels: make(map[string]bool)}
+
+var channelsOfBlock = datastore.ChannelsOfBlock
+var channelsOfNames = datastore.ChannelsOfNames
+var updateChannelStats = datastore.UpdateChannelStats
+
+// orphanChannelsOfBlock remembers the channels touched by a block that is about to be removed due to a reorg.
+func orphanChannelsOfBlock(blockHash string) error {
+	channels, err := channelsOfBlock(blockHash)
+	if err != nil {
+		return err
+	}
+	channelsOrphanedByReorg.Lock()
+	defer channelsOrphanedByReorg.Unlock()
+	for _, channel := range channels {
+		channelsOrphanedByReorg.channels[channel] = true
+	}
+	return nil
+}
+
+// updateChannelStatsOfBlock recomputes the rollup of the channels touched by a block, by blocks orphaned since the last
+// processed block and of the claims of the names whose claimtrie state was recomputed with the block, since their claims
+// may have expired or been promoted from abnormal claims. It runs after the claimtrie state of the block is stored since
+// only active claims are counted.
+func updateChannelStatsOfBlock(blockHash string, names []string) error {
+	channels, err := channelsOfBlock(blockHash)
+	if err != nil {
+		return errors.Prefix("channel stats: could not get channels of block", err)
+	}
+	channelsOfClaims, err := channelsOfNames(names)
+	if err != nil {
+		return errors.Prefix("channel stats: could not get channels of names", err)
+	}
+	channels = append(channels, channelsOfClaims...)
+	channelsOrphanedByReorg.Lock()
+	defer channelsOrphanedByReorg.Unlock()
+	for channel := range channelsOrphanedByReorg.channels {
+		channels = append(channels, channel)
+	}
+	err = updateChannelStats(channels...)
+	if err != nil {
+		return errors.Prefix("channel stats: could not update channels of block", err)
+	}
+	channelsOrphanedByReorg.channels = make(map[string]bool)
+	return nil
+}
@@ -0,0 +1,76 @@
+package processing
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestUpdateChannelStatsOfBlockIncludesOrphanedChannels(t *testing.T) {
+	blockChannels := map[string][]string{
+		"orphaned": {"channel-a", "channel-b"},
+		"current":  {"channel-b", "channel-c"},
+	}
+	var updated []string
+	restoreChannelsOfBlock, restoreChannelsOfNames, restoreUpdate := channelsOfBlock, channelsOfNames, updateChannelStats
+	defer func() {
+		channelsOfBlock, channelsOfNames, updateChannelStats = restoreChannelsOfBlock, restoreChannelsOfNames, restoreUpdate
+	}()
+	channelsOfBlock = func(blockHash string) ([]string, error) { return blockChannels[blockHash], nil }
+	channelsOfNames = func(names []string) ([]string, error) { return nil, nil }
+	updateChannelStats = func(channelIDs ...string) error {
+		updated = append(updated, channelIDs...)
+		return nil
+	}
+
+	if err := orphanChannelsOfBlock("orphaned"); err != nil {
+		t.Fatal(err)
+	}
+	if err := updateChannelStatsOfBlock("current", nil); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(updated)
+	expected := []string{"channel-a", "channel-b", "channel-b", "channel-c"}
+	if len(updated) != len(expected) {
+		t.Fatalf("expected channels %v to be updated, got %v", expected, updated)
+	}
+	for i := range expected {
+		if updated[i] != expected[i] {
+			t.Fatalf("expected channels %v to be updated, got %v", expected, updated)
+		}
+	}
+
+	updated = nil
+	if err := updateChannelStatsOfBlock("none", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(updated) != 0 {
+		t.Fatalf("expected orphaned channels to be cleared, got %v", updated)
+	}
+}
+
+func TestUpdateChannelStatsOfBlockIncludesChannelsOfNames(t *testing.T) {
+	var updated []string
+	restoreChannelsOfBlock, restoreChannelsOfNames, restoreUpdate := channelsOfBlock, channelsOfNames, updateChannelStats
+	defer func() {
+		channelsOfBlock, channelsOfNames, updateChannelStats = restoreChannelsOfBlock, restoreChannelsOfNames, restoreUpdate
+	}()
+	channelsOfBlock = func(blockHash string) ([]string, error) { return []string{"channel-a"}, nil }
+	channelsOfNames = func(names []string) ([]string, error) {
+		if len(names) != 1 || names[0] != "expired" {
+			t.Fatalf("expected the names recomputed, got %v", names)
+		}
+		return []string{"channel-of-expired"}, nil
+	}
+	updateChannelStats = func(channelIDs ...string) error {
+		updated = append(updated, channelIDs...)
+		return nil
+	}
+
+	if err := updateChannelStatsOfBlock("current", []string{"expired"}); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(updated)
+	if len(updated) != 2 || updated[0] != "channel-a" || updated[1] != "channel-of-expired" {
+		t.Fatalf("expected the channels of the block and of the names to be updated, got %v", updated)
+	}
+}
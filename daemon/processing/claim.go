@@ -1,6 +1,7 @@
 package processing
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
 const claimLockStripeCount = 1024
@@ -58,7 +60,7 @@ func processAsClaim(script []byte, vout model.Output, tx model.Transaction, bloc
 			return nil, nil, err
 		}
 	} else if lbrycrd.IsClaimSupportScript(script) {
-		name, claimid, pubkeyscript, err = processClaimSupportScript(&script, vout, tx)
+		name, claimid, pubkeyscript, err = processClaimSupportScript(&script, vout, tx, blockHeight)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -118,31 +120,47 @@ func processClaimNameScript(script *[]byte, vout model.Output, tx model.Transact
 	} else {
 		logrus.Debug("ClaimNew: No blockheight!")
 	}
+	reference := claim.ClaimReference.String
 	claim, err = processClaim(helper, claim, value, vout, tx)
 	if err != nil {
 		return name, claimid, pkscript, err
 	}
 	err = datastore.PutClaim(claim)
+	if err == nil {
+		err = saveClaimVersion(claim, vout, tx, blockHeight, false)
+	}
+	if err == nil {
+		err = resolvePendingPurchases(claimid)
+	}
+	if err == nil {
+		// Reposts of the claim may have been mined before it.
+		err = datastore.UpdateRepostCounts(claimid, reference, claim.ClaimReference.String)
+	}
 	if err == nil {
 		IDs := []string{"claims", claim.Name, claimid}
 		if !claim.PublisherID.IsZero() {
 			IDs = append(IDs, "channel-"+claim.PublisherID.String)
 		}
-		sockety.SendNotification(socketyapi.SendNotificationArgs{
-			Service: socketyapi.BlockChain,
-			Type:    "new_claim",
-			IDs:     IDs,
-			Data:    map[string]interface{}{"claim": claim},
-		})
-		if claim.Height > 0 {
-			notifications.ClaimEvent(claim, tx, helper)
+		if !repairing.Load() {
+			sockety.SendNotification(socketyapi.SendNotificationArgs{
+				Service: socketyapi.BlockChain,
+				Type:    "new_claim",
+				IDs:     IDs,
+				Data:    map[string]interface{}{"claim": claim},
+			})
+		}
+		if claim.Height > 0 && !repairing.Load() {
+			err = notifications.ClaimEvent(claim, tx, vout.Value.Float64, helper)
+		}
+		if err == nil && claim.Height > 0 && !repairing.Load() && claim.Type.String == global.ChannelClaimType {
+			err = notifications.ChannelCreatedEvent(claim, tx, vout.Value.Float64)
 		}
 	}
 
 	return name, claimid, pkscript, err
 }
 
-func processClaimSupportScript(script *[]byte, vout model.Output, tx model.Transaction) (name string, claimid string, pubkeyscript []byte, err error) {
+func processClaimSupportScript(script *[]byte, vout model.Output, tx model.Transaction, blockHeight uint64) (name string, claimid string, pubkeyscript []byte, err error) {
 	var value []byte
 	name, claimid, value, pubkeyscript, err = lbrycrd.ParseClaimSupportScript(*script)
 	if err != nil {
@@ -150,19 +168,23 @@ func processClaimSupportScript(script *[]byte, vout model.Output, tx model.Trans
 		return name, claimid, pubkeyscript, err
 	}
 	support := datastore.GetSupport(tx.Hash, vout.Vout)
-	support, err = processSupport(claimid, value, support, vout, tx)
+	support, err = processSupport(claimid, value, support, vout, tx, blockHeight)
 	if err != nil {
 		return name, claimid, pubkeyscript, err
 	}
-	if err := datastore.PutSupport(support); err != nil {
-		logrus.Debugf("error while adding support for claim_id %s: %s", claimid, err.Error())
-	} else {
+	if putErr := datastore.PutSupport(support); putErr != nil {
+		logrus.Debugf("error while adding support for claim_id %s: %s", claimid, putErr.Error())
+	} else if !repairing.Load() {
 		sockety.SendNotification(socketyapi.SendNotificationArgs{
 			Service: socketyapi.BlockChain,
 			Type:    "support",
 			IDs:     []string{"supports", claimid, name},
 			Data:    map[string]interface{}{"support": support},
 		})
+		err = notifications.SupportEvent(support, claimid, name, tx)
+		if err != nil {
+			return name, claimid, pubkeyscript, err
+		}
 	}
 
 	return name, claimid, pubkeyscript, err
@@ -184,6 +206,10 @@ func processClaimUpdateScript(script *[]byte, vout model.Output, tx model.Transa
 		unlockClaim := LockClaim(claimID)
 		defer unlockClaim()
 		claim := datastore.GetClaim(claimID)
+		var reference string
+		if claim != nil {
+			reference = claim.ClaimReference.String
+		}
 		claim, err := processUpdateClaim(helper, claim, value)
 		if err != nil {
 			return name, claimID, pubkeyscript, err
@@ -213,12 +239,29 @@ func processClaimUpdateScript(script *[]byte, vout model.Output, tx model.Transa
 				logrus.WithError(err)
 			}
 		} else {
+			err := saveClaimVersion(claim, vout, tx, blockHeight, true)
+			if err != nil {
+				return name, claimID, pubkeyscript, err
+			}
+			err = datastore.UpdateRepostCounts(reference, claim.ClaimReference.String)
+			if err != nil {
+				return name, claimID, pubkeyscript, err
+			}
+			if repairing.Load() {
+				return name, claimID, pubkeyscript, nil
+			}
 			sockety.SendNotification(socketyapi.SendNotificationArgs{
 				Service: socketyapi.BlockChain,
 				Type:    "claim_update",
 				IDs:     []string{"claims", "claimupdates", claim.ClaimID, name},
 				Data:    map[string]interface{}{"claim": claim},
 			})
+			if claim.Height > 0 {
+				err = notifications.ClaimUpdateEvent(claim, tx, vout.Value.Float64, helper)
+				if err != nil {
+					return name, claimID, pubkeyscript, err
+				}
+			}
 		}
 	}
 	return name, claimID, pubkeyscript, err
@@ -257,7 +300,31 @@ func processClaim(helper *c.StakeHelper, claim *model.Claim, value []byte, outpu
 	return claim, nil
 }
 
-func processSupport(claimID string, value []byte, support *model.Support, output model.Output, tx model.Transaction) (*model.Support, error) {
+// saveClaimVersion records the state of the claim as of the claim or update output being processed.
+func saveClaimVersion(claim *model.Claim, vout model.Output, tx model.Transaction, blockHeight uint64, isUpdate bool) error {
+	version := &model.ClaimVersion{
+		ClaimID:         claim.ClaimID,
+		TransactionHash: tx.Hash,
+		Vout:            vout.Vout,
+		Height:          uint(blockHeight),
+		IsUpdate:        isUpdate,
+		Name:            claim.Name,
+		ClaimAddress:    claim.ClaimAddress,
+		PublisherID:     claim.PublisherID,
+		Type:            claim.Type,
+		Title:           claim.Title,
+		Description:     claim.Description,
+		ThumbnailURL:    claim.ThumbnailURL,
+		Fee:             claim.Fee,
+		FeeCurrency:     claim.FeeCurrency,
+		FeeAddress:      claim.FeeAddress,
+		ValueAsHex:      claim.ValueAsHex,
+		ValueAsJSON:     claim.ValueAsJSON,
+	}
+	return datastore.PutClaimVersion(version)
+}
+
+func processSupport(claimID string, value []byte, support *model.Support, output model.Output, tx model.Transaction, blockHeight uint64) (*model.Support, error) {
 	if support == nil {
 		support = &model.Support{}
 	}
@@ -265,6 +332,9 @@ func processSupport(claimID string, value []byte, support *model.Support, output
 	support.TransactionHashID.SetValid(tx.Hash)
 	support.Vout = output.Vout
 	support.SupportAmount = output.Value.Float64
+	support.Height = uint(blockHeight)
+	supporterAddress := datastore.GetTxInputAddress(tx.ID)
+	support.SupporterAddress = null.NewString(supporterAddress, supporterAddress != "")
 	if len(value) > 0 {
 		var s *c.StakeHelper
 		s, err = c.DecodeSupportBytes(value, global.BlockChainName)
@@ -275,6 +345,7 @@ func processSupport(claimID string, value []byte, support *model.Support, output
 
 	if claim := datastore.GetClaim(claimID); claim != nil {
 		support.SupportedClaimID = claimID
+		support.IsTip = isTip(supporterAddress, claim.ClaimAddress)
 		return support, err
 	}
 	logrus.Debug("Claim Support for claim ", claimID, " is a non-existent claim.")
@@ -282,6 +353,39 @@ func processSupport(claimID string, value []byte, support *model.Support, output
 
 }
 
+// isTip reports whether a support was sent by someone other than the owner of the claim it supports.
+func isTip(supporterAddress, claimAddress string) bool {
+	return supporterAddress != "" && claimAddress != "" && supporterAddress != claimAddress
+}
+
+// notifyAbandonedClaims notifies the claims abandoned by a transaction, those whose last outpoint it spent without
+// updating them. It runs once the outputs of the transaction are stored, so a claim it updates is no longer at the
+// outpoint spent.
+func notifyAbandonedClaims(tx *model.Transaction) error {
+	if repairing.Load() || !notifications.AbandonEventWanted() {
+		return nil
+	}
+	var claims []*model.Claim
+	err := queries.Raw(`
+		SELECT c.*
+		FROM input i
+		INNER JOIN output o ON o.spent_by_input_id = i.id
+		INNER JOIN claim c ON c.claim_id = o.claim_id
+		WHERE i.transaction_id = ?
+			AND o.transaction_hash = COALESCE(c.transaction_hash_update, c.transaction_hash_id)
+			AND o.vout = COALESCE(c.vout_update, c.vout)`, tx.ID).BindG(context.Background(), &claims)
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, claim := range claims {
+		err := notifications.AbandonEvent(claim, *tx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func processUpdateClaim(helper *c.StakeHelper, claim *model.Claim, value []byte) (*model.Claim, error) {
 	if claim == nil {
 		return nil, nil
@@ -389,11 +493,17 @@ func setMetaDataInfo(claim *model.Claim, helper *c.StakeHelper) error {
 	}
 	channel := helper.Claim.GetChannel()
 	if channel != nil {
-		setChannelMetadata(claim, *channel)
+		err := setChannelMetadata(claim, *channel)
+		if err != nil {
+			return err
+		}
 	}
 	list := helper.Claim.GetCollection()
 	if list != nil {
-		setCollectionMetadata(claim, *list)
+		err := setCollectionMetadata(claim, *list)
+		if err != nil {
+			return err
+		}
 	}
 	reference := helper.Claim.GetRepost()
 	if reference != nil {
@@ -512,7 +622,7 @@ func setStreamMetadata(claim *model.Claim, stream pb.Stream) {
 	}
 }
 
-func setChannelMetadata(claim *model.Claim, channel pb.Channel) {
+func setChannelMetadata(claim *model.Claim, channel pb.Channel) error {
 	claim.Type.SetValid(global.ChannelClaimType)
 	if channel.GetCover() != nil {
 		c := channel.GetCover()
@@ -552,25 +662,19 @@ func setChannelMetadata(claim *model.Claim, channel pb.Channel) {
 	}
 
 	if channel.GetFeatured() != nil {
-		claim.HasClaimList.SetValid(true)
-		claim.ListType.SetValid(int16(channel.GetFeatured().GetListType()))
-		claimList := make([]string, len(channel.GetFeatured().GetClaimReferences()))
-		for i, c := range channel.GetFeatured().GetClaimReferences() {
-			// No need to reverse bytes as lbrynet is fixed and should do this now
-			claimList[i] = hex.EncodeToString(c.ClaimHash)
-		}
-		jsonList, err := json.Marshal(claimList)
-		if err == nil {
-			claim.ClaimIDList.SetValid(jsonList)
-		} else {
-			logrus.Error("could not process claim list of channel [", claim.ClaimID, "]")
-		}
-		//ToDo - Create NM Table Entry for each
+		return setClaimList(claim, *channel.GetFeatured())
 	}
+	return nil
 }
 
-func setCollectionMetadata(claim *model.Claim, list pb.ClaimList) {
+func setCollectionMetadata(claim *model.Claim, list pb.ClaimList) error {
 	claim.Type.SetValid(global.ClaimListClaimType)
+	return setClaimList(claim, list)
+}
+
+// setClaimList stores the claim references of a list both as the claim_id_list JSON of the claim and as claim_in_list
+// rows with their position in the list. The rows of the previous version of the claim are removed by resetMetadata.
+func setClaimList(claim *model.Claim, list pb.ClaimList) error {
 	claim.HasClaimList.SetValid(true)
 	claim.ListType.SetValid(int16(list.GetListType()))
 	claimList := make([]string, len(list.GetClaimReferences()))
@@ -582,9 +686,25 @@ func setCollectionMetadata(claim *model.Claim, list pb.ClaimList) {
 	if err == nil {
 		claim.ClaimIDList.SetValid(jsonList)
 	} else {
-		logrus.Error("could not process claim list of channel [", claim.ClaimID, "]")
+		logrus.Error("could not process claim list of claim [", claim.ClaimID, "]")
+	}
+	return SetClaimsInList(claim.ClaimID, claimList)
+}
+
+// SetClaimsInList stores the claims of a list as claim_in_list rows in the order they appear in the list and removes
+// rows of positions the list no longer has.
+func SetClaimsInList(listClaimID string, claimIDs []string) error {
+	for i, claimID := range claimIDs {
+		claimInList := &model.ClaimInList{ListClaimID: listClaimID, ClaimID: null.StringFrom(claimID), Position: uint(i)}
+		err := datastore.PutClaimInList(claimInList)
+		if err != nil {
+			return err
+		}
 	}
-	//ToDo - Create NM Table Entry for each
+	err := model.ClaimInLists(
+		model.ClaimInListWhere.ListClaimID.EQ(listClaimID),
+		model.ClaimInListWhere.Position.GTE(uint(len(claimIDs)))).DeleteAllG()
+	return errors.Err(err)
 }
 
 func setSourceMetadata(claim *model.Claim, s *pb.Source) {
@@ -3,12 +3,17 @@ package processing
 import (
 	"encoding/hex"
 	"encoding/json"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	ds "github.com/lbryio/chainquery/datastore"
 	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
 	legacy_pb "github.com/lbryio/types/v1/go"
 	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
 )
 
 type claimIDMatch struct {
@@ -130,3 +135,85 @@ func waitForClaimLockSignal(ch <-chan struct{}, timeout time.Duration) bool {
 		return false
 	}
 }
+
+func insertClaimVersion() string {
+	return regexp.QuoteMeta("INSERT INTO claim_version")
+}
+
+func testVersionedClaim() *model.Claim {
+	return &model.Claim{
+		ClaimID:      "claim",
+		Name:         "name",
+		ClaimAddress: "address",
+		PublisherID:  null.StringFrom("channel"),
+		Type:         null.StringFrom("stream"),
+		Title:        null.StringFrom("title"),
+		Fee:          1.5,
+		FeeCurrency:  null.StringFrom("LBC"),
+		ValueAsHex:   "00",
+	}
+}
+
+func TestSaveClaimVersionOfClaim(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+
+	claim := testVersionedClaim()
+	testDB.mock.ExpectExec(insertClaimVersion()).
+		WithArgs("claim", "tx", uint(0), uint(100), false, "name", "address", "channel", "stream", "title", nil, nil,
+			1.5, "LBC", nil, "00", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := saveClaimVersion(claim, model.Output{Vout: 0}, model.Transaction{Hash: "tx"}, 100, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSaveClaimVersionOfUpdate(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+
+	claim := testVersionedClaim()
+	claim.Title = null.StringFrom("updated")
+	testDB.mock.ExpectExec(insertClaimVersion()).
+		WithArgs("claim", "update", uint(1), uint(120), true, "name", "address", "channel", "stream", "updated", nil,
+			nil, 1.5, "LBC", nil, "00", nil).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	err := saveClaimVersion(claim, model.Output{Vout: 1}, model.Transaction{Hash: "update"}, 120, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func claimVersionRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "claim_id", "transaction_hash", "vout", "height", "is_update", "title"})
+}
+
+func TestGetClaimVersionAtHeight(t *testing.T) {
+	testDB := newSQLBoilerTestDB(t)
+	defer testDB.close(t)
+
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.ClaimVersion)+".*"+regexp.QuoteMeta("ORDER BY height DESC, id DESC")).
+		WithArgs("claim", uint(110)).
+		WillReturnRows(claimVersionRows().AddRow(1, "claim", "tx", 0, 100, false, "title"))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.ClaimVersion)).
+		WithArgs("claim").
+		WillReturnRows(claimVersionRows().AddRow(2, "claim", "update", 1, 120, true, "updated"))
+	testDB.mock.ExpectQuery(selectFrom(model.TableNames.ClaimVersion)).
+		WithArgs("claim", uint(90)).
+		WillReturnRows(claimVersionRows())
+
+	version := ds.GetClaimVersionAtHeight("claim", 110)
+	if version == nil || version.TransactionHash != "tx" || version.Title.String != "title" {
+		t.Fatalf("expected the version of the claim at 110, got %+v", version)
+	}
+	latest := ds.GetClaimVersionAtHeight("claim", 0)
+	if latest == nil || latest.TransactionHash != "update" || !latest.IsUpdate {
+		t.Fatalf("expected the latest version of the claim, got %+v", latest)
+	}
+	if before := ds.GetClaimVersionAtHeight("claim", 90); before != nil {
+		t.Fatalf("expected no version of the claim before it was created, got %+v", before)
+	}
+}
@@ -0,0 +1,75 @@
+package processing
+
+import (
+	"sync"
+
+	"github.com/lbryio/chainquery/daemon/claimtrie"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+// namesOrphanedByReorg holds the names touched by blocks removed in a reorg. Their claims lost the outputs of those
+// blocks, so their state is recomputed along with the next processed block.
+var namesOrphanedByReorg = struct {
+	sync.Mutex
+	names map[string]bool
+}{names: make(map[string]bool)}
+
+var namesOfBlock = claimtrie.NamesOfBlock
+var namesPendingAtHeight = claimtrie.NamesPendingAtHeight
+var updateClaimTrieNames = claimtrie.UpdateNames
+var updateShortURLsOfNames = UpdateShortURLs
+
+// orphanNamesOfBlock remembers the names touched by a block that is about to be removed due to a reorg.
+func orphanNamesOfBlock(blockHash string) error {
+	names, err := namesOfBlock(blockHash)
+	if err != nil {
+		return err
+	}
+	namesOrphanedByReorg.Lock()
+	defer namesOrphanedByReorg.Unlock()
+	for _, name := range names {
+		namesOrphanedByReorg.names[name] = true
+	}
+	return nil
+}
+
+// updateClaimTrieAtHeight recomputes the claimtrie state of every name whose claims or supports changed at the height of
+// a block: names touched by the block, names with claims or supports that activate or expire at its height and names
+// touched by blocks orphaned since the last processed block. The state is always computed at the chain head, so a block
+// processed again below it does not store the state its names had back then. The short URLs of the claims of these
+// names are recomputed as well, since claims were added to or orphaned from them. It returns the names recomputed.
+func updateClaimTrieAtHeight(blockHash string, height uint64) ([]string, error) {
+	names, err := namesOfBlock(blockHash)
+	if err != nil {
+		return nil, errors.Prefix("claimtrie: could not get names of block", err)
+	}
+	pending, err := namesPendingAtHeight(height)
+	if err != nil {
+		return nil, errors.Prefix("claimtrie: could not get names pending at height", err)
+	}
+	names = append(names, pending...)
+	head, err := chainHeadBlock()
+	if err != nil {
+		return nil, errors.Prefix("claimtrie: could not get chain head", err)
+	}
+	if head.Height > height {
+		height = head.Height
+	}
+
+	namesOrphanedByReorg.Lock()
+	defer namesOrphanedByReorg.Unlock()
+	for name := range namesOrphanedByReorg.names {
+		names = append(names, name)
+	}
+	err = updateClaimTrieNames(names, height)
+	if err != nil {
+		return nil, err
+	}
+	err = updateShortURLsOfNames(names)
+	if err != nil {
+		return nil, errors.Prefix("claimtrie: could not update short urls", err)
+	}
+	namesOrphanedByReorg.names = make(map[string]bool)
+	return names, nil
+}
@@ -194,6 +194,12 @@ func ProcessVin(jsonVin *lbrycrd.Vin, tx *m.Transaction, txDC *txDebitCredits, n
 	if err != nil {
 		return err
 	}
+	if srcOutput.Type.String == lbrycrd.NonStandard {
+		err = ds.PutSupportSpend(srcOutput.TransactionHash, srcOutput.Vout, tx.Hash, spentHeight(tx))
+		if err != nil {
+			return err
+		}
+	}
 
 	//Make sure there is a transaction address
 
@@ -203,6 +209,18 @@ func ProcessVin(jsonVin *lbrycrd.Vin, tx *m.Transaction, txDC *txDebitCredits, n
 	return nil
 }
 
+// spentHeight returns the height of the block containing the spending transaction, null while it is in the mempool.
+func spentHeight(tx *m.Transaction) null.Uint {
+	if !tx.BlockHashID.Valid || tx.BlockHashID.String == MempoolBlockHash {
+		return null.Uint{}
+	}
+	block, err := m.Blocks(m.BlockWhere.Hash.EQ(tx.BlockHashID.String)).OneG()
+	if err != nil {
+		return null.Uint{}
+	}
+	return null.UintFrom(uint(block.Height))
+}
+
 // ProcessVout processes an ouput from lbrycrd
 func ProcessVout(jsonVout *lbrycrd.Vout, tx *m.Transaction, txDC *txDebitCredits, blockHeight uint64) error {
 	defer metrics.Processing(time.Now(), "vout")
@@ -265,7 +283,12 @@ func ProcessVout(jsonVout *lbrycrd.Vout, tx *m.Transaction, txDC *txDebitCredits
 		return errors.Base("Missing txAddress for Tx:%d- Addr:%d", tx.ID, address.ID)
 	}
 
-	notifications.PaymentEvent(vout.Value.Float64, address.Address, tx.Hash, vout.Vout)
+	if !repairing.Load() {
+		err = notifications.PaymentEvent(vout.Value.Float64, address.Address, tx.Hash, vout.Vout, tx.BlockHashID.String)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Process script for potential claims
 	claimid, err := processScriptForClaim(*vout, *tx, blockHeight)
@@ -337,24 +360,20 @@ func processPurchaseVout(script []byte, tx *m.Transaction, vout *lbrycrd.Vout, b
 	if err != nil {
 		return errors.Err(err)
 	}
-	pbPurchase.GetClaimHash()
 	bytes := util.ReverseBytes(pbPurchase.GetClaimHash())
 	claimID := hex.EncodeToString(bytes)
-	claim := ds.GetClaim(claimID)
-	if claim != nil {
-		purchase := ds.GetPurchase(tx.Hash, uint(vout.N), claim.ClaimID)
-		if purchase == nil {
-			purchase = &m.Purchase{}
-		}
-		purchase.ClaimID.SetValid(claim.ClaimID)
-		purchase.PublisherID = claim.PublisherID
-		purchase.TransactionByHashID.SetValid(tx.Hash)
-		purchase.Vout = uint(vout.N)
-		purchase.Height = uint(blockHeight)
-		err := ds.PutPurchase(purchase)
-		if err != nil {
-			return errors.Err(err)
-		}
+	purchase := ds.GetPurchase(tx.Hash, uint(vout.N), claimID)
+	if purchase == nil {
+		// Resolved and notified once the inputs and outputs of the transaction are stored, see resolvePurchasesOfTx.
+		purchase = &m.Purchase{}
+	}
+	purchase.ClaimID.SetValid(claimID)
+	purchase.TransactionByHashID.SetValid(tx.Hash)
+	purchase.Vout = uint(vout.N)
+	purchase.Height = uint(blockHeight)
+	err = ds.PutPurchase(purchase)
+	if err != nil {
+		return errors.Err(err)
 	}
 	return nil
 }
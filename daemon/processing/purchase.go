@@ -0,0 +1,145 @@
+package processing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math"
+
+	ds "github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/lbrycrd"
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/chainquery/notifications"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+)
+
+const lbcFeeCurrency = "LBC"
+
+// hasPurchase reports whether any output of the transaction is a purchase.
+func hasPurchase(jsonTx *lbrycrd.TxRawResult) bool {
+	for _, vout := range jsonTx.Vout {
+		if vout.ScriptPubKey.Type != lbrycrd.NullData {
+			continue
+		}
+		script, err := hex.DecodeString(vout.ScriptPubKey.Hex)
+		if err == nil && lbrycrd.IsPurchaseScript(script) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePurchasesOfTx resolves the purchases of a transaction once all its inputs and outputs are stored, and notifies
+// them.
+func resolvePurchasesOfTx(tx *model.Transaction) error {
+	purchases, err := model.Purchases(model.PurchaseWhere.TransactionByHashID.EQ(null.StringFrom(tx.Hash))).AllG()
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, purchase := range purchases {
+		err := ResolvePurchase(purchase)
+		if err != nil {
+			return err
+		}
+		if repairing.Load() {
+			continue
+		}
+		err = notifications.PurchaseEvent(purchase, *tx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvePendingPurchases resolves the purchases of a claim that were processed before the claim was.
+func resolvePendingPurchases(claimID string) error {
+	purchases, err := model.Purchases(
+		model.PurchaseWhere.ClaimID.EQ(null.StringFrom(claimID)),
+		model.PurchaseWhere.IsResolved.EQ(false)).AllG()
+	if err != nil {
+		return errors.Err(err)
+	}
+	for _, purchase := range purchases {
+		err := ResolvePurchase(purchase)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResolvePurchase links a purchase to its claim. It records the publisher, the fee the claim asked for at the height of
+// the purchase, the amount the transaction paid to the fee address, the buyer and whether the fee was satisfied. Fees
+// in other currencies than LBC cannot be checked on chain and are left unknown. A purchase of a claim that is not
+// stored yet stays unresolved until the claim is processed.
+func ResolvePurchase(purchase *model.Purchase) error {
+	claim := ds.GetClaim(purchase.ClaimID.String)
+	if claim == nil {
+		purchase.IsResolved = false
+		return errors.Err(ds.PutPurchase(purchase))
+	}
+	purchase.IsResolved = true
+	purchase.PublisherID = claim.PublisherID
+	purchase.Fee = claim.Fee
+	purchase.FeeCurrency = claim.FeeCurrency
+	purchase.FeeAddress = claim.FeeAddress
+	if version := ds.GetClaimVersionAtHeight(claim.ClaimID, purchase.Height); version != nil {
+		purchase.Fee = version.Fee
+		purchase.FeeCurrency = version.FeeCurrency
+		purchase.FeeAddress = version.FeeAddress
+	}
+
+	outputs, err := model.Outputs(model.OutputWhere.TransactionHash.EQ(purchase.TransactionByHashID.String)).AllG()
+	if err != nil {
+		return errors.Err(err)
+	}
+	purchase.AmountSatoshi = 0
+	purchase.PaymentVout = null.Uint{}
+	for _, output := range outputs {
+		if !purchase.FeeAddress.Valid || !paysTo(output, purchase.FeeAddress.String) {
+			continue
+		}
+		purchase.AmountSatoshi += int64(math.Round(output.Value.Float64 * 1e8))
+		if !purchase.PaymentVout.Valid {
+			purchase.PaymentVout.SetValid(output.Vout)
+		}
+	}
+	purchase.BuyerAddress = null.String{}
+	if len(outputs) > 0 {
+		if buyer := ds.GetTxInputAddress(outputs[0].TransactionID); buyer != "" {
+			purchase.BuyerAddress.SetValid(buyer)
+		}
+	}
+	purchase.FeeSatisfied = feeSatisfied(purchase)
+
+	return errors.Err(ds.PutPurchase(purchase))
+}
+
+// feeSatisfied reports whether the amount paid covers the fee. Fees are stored in the smallest unit of their currency,
+// dewies for LBC.
+func feeSatisfied(purchase *model.Purchase) null.Bool {
+	switch {
+	case purchase.Fee == 0:
+		return null.BoolFrom(true)
+	case purchase.FeeCurrency.String == lbcFeeCurrency:
+		return null.BoolFrom(float64(purchase.AmountSatoshi) >= purchase.Fee)
+	default:
+		return null.Bool{}
+	}
+}
+
+func paysTo(output *model.Output, address string) bool {
+	var addresses []string
+	if err := json.Unmarshal([]byte(output.AddressList.String), &addresses); err != nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package processing
+
+import (
+	"testing"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFeeSatisfied(t *testing.T) {
+	tests := []struct {
+		name     string
+		purchase model.Purchase
+		expected null.Bool
+	}{
+		{"free claim", model.Purchase{}, null.BoolFrom(true)},
+		{"lbc fee paid", model.Purchase{Fee: 100000000, FeeCurrency: null.StringFrom("LBC"), AmountSatoshi: 100000000}, null.BoolFrom(true)},
+		{"lbc fee underpaid", model.Purchase{Fee: 100000000, FeeCurrency: null.StringFrom("LBC"), AmountSatoshi: 99999999}, null.BoolFrom(false)},
+		{"usd fee", model.Purchase{Fee: 199, FeeCurrency: null.StringFrom("USD"), AmountSatoshi: 100000000}, null.Bool{}},
+	}
+	for _, test := range tests {
+		if satisfied := feeSatisfied(&test.purchase); satisfied != test.expected {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.expected, satisfied)
+		}
+	}
+}
+
+func TestPaysTo(t *testing.T) {
+	output := &model.Output{AddressList: null.StringFrom(`["bHW58d37s1hBjj3wPBkn5zpCX3F8ZW3F5o"]`)}
+	if !paysTo(output, "bHW58d37s1hBjj3wPBkn5zpCX3F8ZW3F5o") {
+		t.Fatalf("expected output to pay the fee address")
+	}
+	if paysTo(output, "bNpmtSfYsRgAdsfo9A7KVxTnPFGLgMxJFK") {
+		t.Fatalf("expected output not to pay another address")
+	}
+	if paysTo(&model.Output{}, "bHW58d37s1hBjj3wPBkn5zpCX3F8ZW3F5o") {
+		t.Fatalf("expected output without addresses not to pay anything")
+	}
+}
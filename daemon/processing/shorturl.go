@@ -0,0 +1,99 @@
+package processing
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+type shortURLClaim struct {
+	ID          uint64      `boil:"id"`
+	ClaimID     string      `boil:"claim_id"`
+	Name        string      `boil:"name"`
+	ShortURL    null.String `boil:"short_url"`
+	IsConfirmed bool        `boil:"is_confirmed"`
+}
+
+// UpdateShortURLs recomputes the short URLs of the claims of names. The short URL of a claim is its name with the
+// shortest prefix of its claim id that no claim made before it with the same name shares, which is the shortest
+// claim id that resolves to it. Claims whose transaction was removed by a reorg lose their short URL and no longer take
+// up prefixes.
+func UpdateShortURLs(names []string) error {
+	done := make(map[string]bool, len(names))
+	for _, name := range names {
+		if done[strings.ToLower(name)] {
+			continue
+		}
+		done[strings.ToLower(name)] = true
+		var claims []shortURLClaim
+		err := queries.Raw(`
+			SELECT c.id, c.claim_id, c.name, c.short_url, t.id IS NOT NULL AS is_confirmed
+			FROM claim c
+			LEFT JOIN transaction t ON t.hash = c.transaction_hash_id
+			WHERE c.name = ?
+			ORDER BY c.id`, name).BindG(context.Background(), &claims)
+		if err != nil {
+			return errors.Err(err)
+		}
+
+		var confirmed []string
+		for _, c := range claims {
+			if c.IsConfirmed {
+				confirmed = append(confirmed, c.ClaimID)
+			}
+		}
+		prefixes := shortClaimIDs(confirmed)
+		i := 0
+		for _, c := range claims {
+			shortURL := null.String{}
+			if c.IsConfirmed {
+				shortURL.SetValid("lbry://" + c.Name + "#" + prefixes[i])
+				i++
+			}
+			if shortURL == c.ShortURL {
+				continue
+			}
+			_, err := boil.GetDB().Exec(`UPDATE claim SET short_url = ? WHERE id = ?`, shortURL, c.ID)
+			if err != nil {
+				return errors.Err(err)
+			}
+		}
+	}
+	return nil
+}
+
+// shortClaimIDs returns for each claim id, given in the order the claims were made, the shortest prefix of it that no
+// claim id before it starts with.
+func shortClaimIDs(claimIDs []string) []string {
+	prefixes := make([]string, len(claimIDs))
+	sorted := make([]string, 0, len(claimIDs))
+	for i, claimID := range claimIDs {
+		pos := sort.SearchStrings(sorted, claimID)
+		length := 0
+		if pos > 0 {
+			length = max(length, commonPrefixLength(sorted[pos-1], claimID))
+		}
+		if pos < len(sorted) {
+			length = max(length, commonPrefixLength(sorted[pos], claimID))
+		}
+		prefixes[i] = claimID[:min(length+1, len(claimID))]
+		sorted = append(sorted, "")
+		copy(sorted[pos+1:], sorted[pos:])
+		sorted[pos] = claimID
+	}
+	return prefixes
+}
+
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,15 @@
+package processing
+
+import "testing"
+
+func TestShortClaimIDsOnlyConsiderEarlierClaims(t *testing.T) {
+	claimIDs := []string{"abc1", "abd2", "b123", "abc9", "a000"}
+	expected := []string{"a", "abd", "b", "abc9", "a0"}
+
+	prefixes := shortClaimIDs(claimIDs)
+	for i := range expected {
+		if prefixes[i] != expected[i] {
+			t.Fatalf("expected prefixes %v, got %v", expected, prefixes)
+		}
+	}
+}
@@ -171,18 +171,30 @@ func ProcessTx(jsonTx *lbrycrd.TxRawResult, blockTime uint64, blockHeight uint64
 	if err != nil {
 		return err
 	}
+	if hasPurchase(jsonTx) {
+		err = resolvePurchasesOfTx(transaction)
+		if err != nil {
+			return err
+		}
+	}
+	err = notifyAbandonedClaims(transaction)
+	if err != nil {
+		return err
+	}
 	//Set the send and receive values for the transaction
 	err = setSendReceive(transaction, txDbCrAddrMap)
 	if err != nil {
 		return err
 	}
 
-	sockety.SendNotification(socketyapi.SendNotificationArgs{
-		Service: socketyapi.BlockChain,
-		Type:    "new_tx",
-		IDs:     []string{"transactions", jsonTx.Txid},
-		Data:    map[string]interface{}{"transaction": jsonTx},
-	})
+	if !repairing.Load() {
+		sockety.SendNotification(socketyapi.SendNotificationArgs{
+			Service: socketyapi.BlockChain,
+			Type:    "new_tx",
+			IDs:     []string{"transactions", jsonTx.Txid},
+			Data:    map[string]interface{}{"transaction": jsonTx},
+		})
+	}
 
 	return nil
 }
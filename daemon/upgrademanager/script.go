@@ -3,12 +3,16 @@ package upgrademanager
 import (
 	"encoding/hex"
 
+	"github.com/lbryio/chainquery/daemon/claimtrie"
 	"github.com/lbryio/chainquery/daemon/processing"
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/global"
 	"github.com/lbryio/chainquery/lbrycrd"
 	"github.com/lbryio/chainquery/model"
 	"github.com/lbryio/chainquery/util"
 
 	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
@@ -166,3 +170,251 @@ func reProcessAllClaimsFromHeight(height uint) {
 		processClaimOut(i, len(outputs), output.TransactionHash)
 	}
 }
+
+// seedClaimVersions stores the current state of every claim as a version, since the history before the claim_version
+// table existed cannot be recovered without reprocessing.
+func seedClaimVersions() {
+	result, err := boil.GetDB().Exec(`
+		INSERT IGNORE INTO claim_version (claim_id, transaction_hash, vout, height, is_update, name, claim_address,
+			publisher_id, type, title, description, thumbnail_url, fee, fee_currency, fee_address, value_as_hex, value_as_json)
+		SELECT claim.claim_id, transaction.hash, COALESCE(claim.vout_update, claim.vout), claim.height,
+			claim.transaction_hash_update IS NOT NULL AND claim.transaction_hash_update != claim.transaction_hash_id,
+			claim.name, claim.claim_address, claim.publisher_id, claim.type, claim.title, claim.description,
+			claim.thumbnail_url, claim.fee, claim.fee_currency, claim.fee_address, claim.value_as_hex, claim.value_as_json
+		FROM claim
+		INNER JOIN transaction ON transaction.hash = COALESCE(claim.transaction_hash_update, claim.transaction_hash_id)`)
+	if err != nil {
+		logrus.Error("Error During Upgrade: ", err)
+		return
+	}
+	seeded, err := result.RowsAffected()
+	if err != nil {
+		logrus.Error("Error During Upgrade: ", err)
+		return
+	}
+	logrus.Info("Seeded ", seeded, " claim versions")
+}
+
+// backfillSupportHistory fills the support columns added for tip and withdrawal tracking from the stored inputs and
+// outputs. Supports processed from now on have them set during processing.
+func backfillSupportHistory() {
+	steps := []struct {
+		name  string
+		query string
+	}{
+		{"heights", `
+			UPDATE support s
+			INNER JOIN transaction t ON t.hash = s.transaction_hash_id
+			INNER JOIN block b ON b.hash = t.block_hash_id
+			SET s.height = b.height
+			WHERE b.hash != 'MEMPOOL'`},
+		{"supporters", `
+			UPDATE support s
+			INNER JOIN input i ON i.transaction_hash = s.transaction_hash_id AND i.vin = 0
+			INNER JOIN address a ON a.id = i.input_address_id
+			SET s.supporter_address = a.address`},
+		{"tips", `
+			UPDATE support s
+			INNER JOIN claim c ON c.claim_id = s.supported_claim_id
+			SET s.is_tip = s.supporter_address IS NOT NULL AND c.claim_address != '' AND s.supporter_address != c.claim_address`},
+		{"withdrawals", `
+			UPDATE support s
+			INNER JOIN output o ON o.transaction_hash = s.transaction_hash_id AND o.vout = s.vout
+			INNER JOIN input i ON i.id = o.spent_by_input_id
+			INNER JOIN transaction t ON t.id = i.transaction_id
+			LEFT JOIN block b ON b.hash = t.block_hash_id AND b.hash != 'MEMPOOL'
+			SET s.spent_transaction_hash = t.hash, s.spent_height = b.height
+			WHERE o.is_spent = 1`},
+	}
+	for _, step := range steps {
+		result, err := boil.GetDB().Exec(step.query)
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		logrus.Info("Backfilled support ", step.name, " for ", updated, " supports")
+	}
+}
+
+// recomputeClaimTrie computes the bid state, effective amount and activation height of the claims and supports of every
+// name from the stored outputs, replacing the values previously synced from lbrycrd. Names are recomputed in batches at
+// the chain height, holding the block lock so processing does not interleave.
+func recomputeClaimTrie() {
+	const batchSize = 5000
+	c := model.ClaimColumns
+	lastID := uint64(0)
+	recomputed := 0
+	for {
+		claims, err := model.Claims(qm.Select(c.ID, c.Name), qm.Where(c.ID+">?", lastID), qm.OrderBy(c.ID), qm.Limit(batchSize)).AllG()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		if len(claims) == 0 {
+			break
+		}
+		names := make([]string, len(claims))
+		for i, claim := range claims {
+			names[i] = claim.Name
+		}
+		lastID = claims[len(claims)-1].ID
+		processing.BlockLock.Lock()
+		height, err := datastore.GetChainHeight()
+		if err == nil {
+			err = claimtrie.UpdateNames(names, height)
+		}
+		processing.BlockLock.Unlock()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		recomputed += len(claims)
+		logrus.Info("Recomputed claimtrie state for ", recomputed, " claims")
+	}
+	logrus.Info("Finished recomputing the claimtrie state")
+}
+
+// resolveAllPurchases records the amount paid, the buyer and the fee compliance of every stored purchase.
+func resolveAllPurchases() {
+	const batchSize = 5000
+	lastID := uint64(0)
+	resolved := 0
+	for {
+		purchases, err := model.Purchases(model.PurchaseWhere.ID.GT(lastID), qm.OrderBy(model.PurchaseColumns.ID), qm.Limit(batchSize)).AllG()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		if len(purchases) == 0 {
+			break
+		}
+		for _, purchase := range purchases {
+			err := processing.ResolvePurchase(purchase)
+			if err != nil {
+				logrus.Error("Error During Upgrade: ", err)
+				return
+			}
+		}
+		lastID = purchases[len(purchases)-1].ID
+		resolved += len(purchases)
+		logrus.Info("Resolved ", resolved, " purchases")
+	}
+}
+
+// setClaimsInAllLists stores the claim_in_list rows of every collection and featured channel list from the claim_id_list
+// of its claim.
+func setClaimsInAllLists() {
+	const batchSize = 5000
+	c := model.ClaimColumns
+	lastID := uint64(0)
+	stored := 0
+	for {
+		claims, err := model.Claims(qm.Select(c.ID, c.ClaimID, c.ClaimIDList), model.ClaimWhere.HasClaimList.EQ(null.BoolFrom(true)),
+			qm.Where(c.ID+">?", lastID), qm.OrderBy(c.ID), qm.Limit(batchSize)).AllG()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		if len(claims) == 0 {
+			break
+		}
+		for _, claim := range claims {
+			var claimIDs []string
+			if err := claim.ClaimIDList.Unmarshal(&claimIDs); err != nil {
+				logrus.Error("could not parse claim list of claim [", claim.ClaimID, "]: ", err)
+				continue
+			}
+			err = processing.SetClaimsInList(claim.ClaimID, claimIDs)
+			if err != nil {
+				logrus.Error("Error During Upgrade: ", err)
+				return
+			}
+		}
+		lastID = claims[len(claims)-1].ID
+		stored += len(claims)
+		logrus.Info("Stored the members of ", stored, " claim lists")
+	}
+}
+
+// countAllReposts sets the repost count of every reposted claim. Reposts that were abandoned or expired are not
+// counted.
+func countAllReposts() {
+	_, err := boil.GetDB().Exec(
+		`UPDATE claim c INNER JOIN (` +
+			`SELECT claim_reference, COUNT(*) AS reposts FROM claim ` +
+			`WHERE claim_reference IS NOT NULL AND bid_state NOT IN ('Spent', 'Expired') ` +
+			`GROUP BY claim_reference) r ON r.claim_reference = c.claim_id ` +
+			`SET c.repost_count = r.reposts`)
+	if err != nil {
+		logrus.Error("Error During Upgrade: ", err)
+	}
+}
+
+// setAllShortURLs computes the short urls of the claims of every name. Names are taken from claims without a short url
+// yet, so a name is done once no matter how many claims it has.
+func setAllShortURLs() {
+	const batchSize = 5000
+	c := model.ClaimColumns
+	lastID := uint64(0)
+	for {
+		claims, err := model.Claims(qm.Select(c.ID, c.Name), model.ClaimWhere.ShortURL.IsNull(),
+			qm.Where(c.ID+">?", lastID), qm.OrderBy(c.ID), qm.Limit(batchSize)).AllG()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		if len(claims) == 0 {
+			break
+		}
+		names := make([]string, len(claims))
+		for i, claim := range claims {
+			names[i] = claim.Name
+		}
+		lastID = claims[len(claims)-1].ID
+		processing.BlockLock.Lock()
+		err = processing.UpdateShortURLs(names)
+		processing.BlockLock.Unlock()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		logrus.Info("Computed short urls up to claim ", lastID)
+	}
+	logrus.Info("Finished computing short urls")
+}
+
+func setAllChannelStats() {
+	const batchSize = 1000
+	c := model.ClaimColumns
+	lastID := uint64(0)
+	for {
+		channels, err := model.Claims(qm.Select(c.ID, c.ClaimID), model.ClaimWhere.Type.EQ(null.StringFrom(global.ChannelClaimType)),
+			qm.Where(c.ID+">?", lastID), qm.OrderBy(c.ID), qm.Limit(batchSize)).AllG()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		if len(channels) == 0 {
+			break
+		}
+		channelIDs := make([]string, len(channels))
+		for i, channel := range channels {
+			channelIDs[i] = channel.ClaimID
+		}
+		lastID = channels[len(channels)-1].ID
+		processing.BlockLock.Lock()
+		err = datastore.UpdateChannelStats(channelIDs...)
+		processing.BlockLock.Unlock()
+		if err != nil {
+			logrus.Error("Error During Upgrade: ", err)
+			return
+		}
+		logrus.Info("Computed channel stats up to claim ", lastID)
+	}
+	logrus.Info("Finished computing channel stats")
+}
@@ -13,9 +13,9 @@ import (
 )
 
 const (
-	appVersion  = 13
-	apiVersion  = 13
-	dataVersion = 13
+	appVersion  = 22
+	apiVersion  = 22
+	dataVersion = 22
 )
 
 // RunUpgradesForVersion - Migrations are for structure of the data. Upgrade Manager scripts are for the data itself.
@@ -51,6 +51,15 @@ func RunUpgradesForVersion() {
 		upgradeFrom10(appStatus.AppVersion)
 		upgradeFrom11(appStatus.AppVersion)
 		upgradeFrom12(appStatus.AppVersion)
+		upgradeFrom13(appStatus.AppVersion)
+		upgradeFrom14(appStatus.AppVersion)
+		upgradeFrom15(appStatus.AppVersion)
+		upgradeFrom16(appStatus.AppVersion)
+		upgradeFrom17(appStatus.AppVersion)
+		upgradeFrom18(appStatus.AppVersion)
+		upgradeFrom19(appStatus.AppVersion)
+		upgradeFrom20(appStatus.AppVersion)
+		upgradeFrom21(appStatus.AppVersion)
 		////Increment and save
 		//
 		logrus.Debug("Upgrading app status version to App-", appVersion, " Data-", dataVersion, " Api-", apiVersion)
@@ -206,3 +215,67 @@ func upgradeFrom12(version int) {
 		}
 	}
 }
+
+func upgradeFrom13(version int) {
+	if version < 14 {
+		logrus.Info("Seeding claim versions from the current claim state")
+		seedClaimVersions()
+	}
+}
+
+func upgradeFrom14(version int) {
+	if version < 15 {
+		logrus.Info("Backfilling support heights, supporters, tips and withdrawals")
+		backfillSupportHistory()
+	}
+}
+
+func upgradeFrom15(version int) {
+	if version < 16 {
+		logrus.Info("Recomputing the claimtrie state of all names")
+		go recomputeClaimTrie()
+	}
+}
+
+func upgradeFrom16(version int) {
+	// Coming from 15 the recompute of upgradeFrom15 already records the takeover history.
+	if version == 16 {
+		logrus.Info("Recording the takeover history of all names")
+		go recomputeClaimTrie()
+	}
+}
+
+func upgradeFrom17(version int) {
+	if version < 18 {
+		logrus.Info("Resolving payment, buyer and fee of all purchases")
+		resolveAllPurchases()
+	}
+}
+
+func upgradeFrom18(version int) {
+	if version < 19 {
+		logrus.Info("Storing the members of all claim lists")
+		setClaimsInAllLists()
+	}
+}
+
+func upgradeFrom19(version int) {
+	if version < 20 {
+		logrus.Info("Counting the reposts of all claims")
+		countAllReposts()
+	}
+}
+
+func upgradeFrom20(version int) {
+	if version < 21 {
+		logrus.Info("Computing the short urls of all claims")
+		go setAllShortURLs()
+	}
+}
+
+func upgradeFrom21(version int) {
+	if version < 22 {
+		logrus.Info("Computing the stats of all channels")
+		go setAllChannelStats()
+	}
+}
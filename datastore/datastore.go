@@ -1,16 +1,20 @@
 package datastore
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
 
+	"github.com/lbryio/chainquery/global"
 	"github.com/lbryio/chainquery/model"
 	"github.com/lbryio/chainquery/util"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/lbryio/lbry.go/v2/extras/query"
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -281,20 +285,78 @@ func PutSupport(support *model.Support) error {
 
 	defer util.TimeTrack(time.Now(), "PutSupport", "mysqlprofile")
 	//using UpsertG fails because sqlboiler doesn't consider multi column unique keys as valid. hence the manual "upsert" logic here.
-	query := fmt.Sprintf(`INSERT INTO support (%s, %s, %s, %s, %s, %s) VALUES(?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE id=id`,
+	query := fmt.Sprintf(`INSERT INTO support (%s, %s, %s, %s, %s, %s, %s, %s, %s) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE %s=VALUES(%s), %s=VALUES(%s), %s=VALUES(%s)`,
 		model.SupportColumns.SupportedClaimID,
 		model.SupportColumns.SupportAmount,
 		model.SupportColumns.BidState,
 		model.SupportColumns.TransactionHashID,
 		model.SupportColumns.Vout,
-		model.SupportColumns.SupportedByClaimID)
-	_, err := boil.GetDB().Exec(query, support.SupportedClaimID, support.SupportAmount, support.BidState, support.TransactionHashID, support.Vout, support.SupportedByClaimID)
+		model.SupportColumns.SupportedByClaimID,
+		model.SupportColumns.Height,
+		model.SupportColumns.SupporterAddress,
+		model.SupportColumns.IsTip,
+		model.SupportColumns.Height, model.SupportColumns.Height,
+		model.SupportColumns.SupporterAddress, model.SupportColumns.SupporterAddress,
+		model.SupportColumns.IsTip, model.SupportColumns.IsTip)
+	_, err := boil.GetDB().Exec(query, support.SupportedClaimID, support.SupportAmount, support.BidState, support.TransactionHashID, support.Vout, support.SupportedByClaimID,
+		support.Height, support.SupporterAddress, support.IsTip)
 	if err != nil {
 		return errors.Prefix("Datastore(PUTSUPPORT)", err)
 	}
 	return nil
 }
 
+// PutSupportSpend records the transaction, and its height if confirmed, that spent the support at the outpoint.
+func PutSupportSpend(txHash string, vout uint, spentTxHash string, spentHeight null.Uint) error {
+	defer util.TimeTrack(time.Now(), "PutSupportSpend", "mysqlprofile")
+	query := fmt.Sprintf(`UPDATE support SET %s=?, %s=? WHERE %s=? AND %s=?`,
+		model.SupportColumns.SpentTransactionHash,
+		model.SupportColumns.SpentHeight,
+		model.SupportColumns.TransactionHashID,
+		model.SupportColumns.Vout)
+	_, err := boil.GetDB().Exec(query, spentTxHash, spentHeight, txHash, vout)
+	if err != nil {
+		return errors.Prefix("Datastore(PUTSUPPORTSPEND)", err)
+	}
+	return nil
+}
+
+// ReleaseSupportSpends marks the supports spent by transactions of a block as unspent again. Used before a block is
+// removed so a reorg does not leave supports withdrawn by transactions that are no longer in the chain.
+func ReleaseSupportSpends(blockHash string) error {
+	defer util.TimeTrack(time.Now(), "ReleaseSupportSpends", "mysqlprofile")
+	_, err := boil.GetDB().Exec(`
+		UPDATE support s
+		INNER JOIN transaction t ON t.hash = s.spent_transaction_hash
+		SET s.spent_transaction_hash = NULL, s.spent_height = NULL
+		WHERE t.block_hash_id = ?`, blockHash)
+	if err != nil {
+		return errors.Prefix("Datastore(RELEASESUPPORTSPENDS)", err)
+	}
+	return nil
+}
+
+// GetTxInputAddress returns the address funding the first input of a transaction, or an empty string for coinbase
+// transactions or inputs that are not stored yet.
+func GetTxInputAddress(txID uint64) string {
+	defer util.TimeTrack(time.Now(), "GetTxInputAddress", "mysqlprofile")
+	var result struct {
+		Address string `boil:"address"`
+	}
+	err := queries.Raw(`
+		SELECT a.address FROM input i
+		INNER JOIN address a ON a.id = i.input_address_id
+		WHERE i.transaction_id = ?
+		ORDER BY i.vin LIMIT 1`, txID).BindG(context.Background(), &result)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			logrus.Warning("Datastore(GETTXINPUTADDRESS): ", err)
+		}
+		return ""
+	}
+	return result.Address
+}
+
 // GetTag makes creating,retrieving,updating the model type simplified.
 func GetTag(tagName string) *model.Tag {
 	defer util.TimeTrack(time.Now(), "GetTag", "mysqlprofile")
@@ -348,6 +410,19 @@ func PutClaimTag(claimTag *model.ClaimTag) error {
 	return nil
 }
 
+// PutClaimInList makes creating,retrieving,updating the model type simplified.
+func PutClaimInList(claimInList *model.ClaimInList) error {
+	defer util.TimeTrack(time.Now(), "PutClaimInList", "mysqlprofile")
+	//using UpsertG fails because sqlboiler doesn't consider multi column unique keys as valid. hence the manual "upsert" logic here.
+	c := model.ClaimInListColumns
+	query := fmt.Sprintf(`INSERT INTO claim_in_list (%s, %s, %s) VALUES(?, ?, ?) ON DUPLICATE KEY UPDATE %s=VALUES(%s)`, c.ListClaimID, c.ClaimID, c.Position, c.ClaimID, c.ClaimID)
+	_, err := boil.GetDB().Exec(query, claimInList.ListClaimID, claimInList.ClaimID, claimInList.Position)
+	if err != nil {
+		return errors.Prefix("Datastore(PUTCLAIMINLIST)", err)
+	}
+	return nil
+}
+
 // GetPurchase makes creating,retrieving,updating the model type simplified.
 func GetPurchase(txHash string, vout uint, claimID string) *model.Purchase {
 	defer util.TimeTrack(time.Now(), "GetPurchase", "mysqlprofile")
@@ -386,7 +461,7 @@ func PutPurchase(purchase *model.Purchase) error {
 			purchase.Modified = time.Now()
 			err = purchase.UpdateG(boil.Infer())
 		} else {
-			err = purchase.InsertG(boil.Infer())
+			err = purchase.InsertG(boil.Greylist(model.PurchaseColumns.IsResolved))
 		}
 		if err != nil {
 			err = errors.Prefix("Datastore(PUTPURCHASE)", err)
@@ -395,3 +470,271 @@ func PutPurchase(purchase *model.Purchase) error {
 	}
 	return nil
 }
+
+// PutClaimVersion stores the version of a claim created by a claim or update output. Reprocessing the same output, for
+// example when a mempool transaction is confirmed, refreshes the stored version.
+func PutClaimVersion(version *model.ClaimVersion) error {
+	defer util.TimeTrack(time.Now(), "PutClaimVersion", "mysqlprofile")
+	//using UpsertG fails because sqlboiler doesn't consider multi column unique keys as valid. hence the manual "upsert" logic here.
+	query := `INSERT INTO claim_version (claim_id, transaction_hash, vout, height, is_update, name, claim_address,
+		publisher_id, type, title, description, thumbnail_url, fee, fee_currency, fee_address, value_as_hex, value_as_json)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE height=VALUES(height), name=VALUES(name), claim_address=VALUES(claim_address),
+		publisher_id=VALUES(publisher_id), type=VALUES(type), title=VALUES(title), description=VALUES(description),
+		thumbnail_url=VALUES(thumbnail_url), fee=VALUES(fee), fee_currency=VALUES(fee_currency),
+		fee_address=VALUES(fee_address), value_as_hex=VALUES(value_as_hex), value_as_json=VALUES(value_as_json)`
+	_, err := boil.GetDB().Exec(query, version.ClaimID, version.TransactionHash, version.Vout, version.Height,
+		version.IsUpdate, version.Name, version.ClaimAddress, version.PublisherID, version.Type, version.Title,
+		version.Description, version.ThumbnailURL, version.Fee, version.FeeCurrency, version.FeeAddress,
+		version.ValueAsHex, version.ValueAsJSON)
+	if err != nil {
+		return errors.Prefix("Datastore(PUTCLAIMVERSION)", err)
+	}
+	return nil
+}
+
+// GetClaimVersionAtHeight returns the version of a claim that was current at a height, the latest version for height 0.
+func GetClaimVersionAtHeight(claimID string, height uint) *model.ClaimVersion {
+	defer util.TimeTrack(time.Now(), "GetClaimVersionAtHeight", "mysqlprofile")
+	mods := []qm.QueryMod{
+		model.ClaimVersionWhere.ClaimID.EQ(claimID),
+		qm.OrderBy(model.ClaimVersionColumns.Height + " DESC, " + model.ClaimVersionColumns.ID + " DESC"),
+	}
+	if height > 0 {
+		mods = append(mods, model.ClaimVersionWhere.Height.LTE(height))
+	}
+	version, err := model.ClaimVersions(mods...).OneG()
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			logrus.Warning("Datastore(GETCLAIMVERSIONATHEIGHT): ", err)
+		}
+		return nil
+	}
+	return version
+}
+
+// GetChainHeight returns the height of the highest stored block, or 0 if no block is stored yet.
+func GetChainHeight() (uint64, error) {
+	defer util.TimeTrack(time.Now(), "GetChainHeight", "mysqlprofile")
+	head, err := model.Blocks(qm.Select(model.BlockColumns.Height), qm.OrderBy(model.BlockColumns.Height+" DESC"), qm.Limit(1)).OneG()
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Prefix("Datastore(GETCHAINHEIGHT)", err)
+	}
+	return head.Height, nil
+}
+
+// UpdateRepostCounts recounts the reposts of claims. Reposts that were abandoned or expired are not counted.
+func UpdateRepostCounts(claimIDs ...string) error {
+	defer util.TimeTrack(time.Now(), "UpdateRepostCounts", "mysqlprofile")
+	var ids []interface{}
+	for _, claimID := range claimIDs {
+		if claimID != "" {
+			ids = append(ids, claimID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	args := append(append([]interface{}{}, ids...), ids...)
+	_, err := boil.GetDB().Exec(
+		`UPDATE claim c LEFT JOIN (`+
+			`SELECT claim_reference, COUNT(*) AS reposts FROM claim `+
+			`WHERE claim_reference IN (`+query.Qs(len(ids))+`) AND bid_state NOT IN ('Spent', 'Expired') `+
+			`GROUP BY claim_reference) r ON r.claim_reference = c.claim_id `+
+			`SET c.repost_count = COALESCE(r.reposts, 0) `+
+			`WHERE c.claim_id IN (`+query.Qs(len(ids))+`)`, args...)
+	if err != nil {
+		return errors.Prefix("Datastore(UPDATEREPOSTCOUNTS)", err)
+	}
+	return nil
+}
+
+// ChannelsOfBlock returns the channels whose rollup is affected by a block: channels of the claims created, updated,
+// supported, spent or purchased in it, including the channels these claims were signed by in earlier versions, and
+// channels that were themselves claimed, supported or spent in it.
+func ChannelsOfBlock(blockHash string) ([]string, error) {
+	defer util.TimeTrack(time.Now(), "ChannelsOfBlock", "mysqlprofile")
+	var claims []struct {
+		ClaimID string `boil:"claim_id"`
+	}
+	err := queries.Raw(`
+		SELECT o.claim_id FROM transaction t
+		INNER JOIN output o ON o.transaction_id = t.id
+		WHERE t.block_hash_id = ? AND o.claim_id IS NOT NULL
+		UNION
+		SELECT o.claim_id FROM transaction t
+		INNER JOIN input i ON i.transaction_id = t.id
+		INNER JOIN output o ON o.spent_by_input_id = i.id
+		WHERE t.block_hash_id = ? AND o.claim_id IS NOT NULL
+		UNION
+		SELECT p.claim_id FROM transaction t
+		INNER JOIN purchase p ON p.transaction_by_hash_id = t.hash
+		WHERE t.block_hash_id = ? AND p.claim_id IS NOT NULL`, blockHash, blockHash, blockHash).BindG(context.Background(), &claims)
+	if err != nil {
+		return nil, errors.Prefix("Datastore(CHANNELSOFBLOCK)", err)
+	}
+	if len(claims) == 0 {
+		return nil, nil
+	}
+	ids := make([]interface{}, len(claims))
+	for i, claim := range claims {
+		ids[i] = claim.ClaimID
+	}
+	args := append([]interface{}{global.ChannelClaimType}, ids...)
+	args = append(append(args, ids...), ids...)
+	var channels []struct {
+		ChannelClaimID string `boil:"channel_claim_id"`
+	}
+	err = queries.Raw(
+		`SELECT claim_id AS channel_claim_id FROM claim WHERE type = ? AND claim_id IN (`+query.Qs(len(ids))+`) `+
+			`UNION SELECT publisher_id FROM claim WHERE claim_id IN (`+query.Qs(len(ids))+`) AND publisher_id IS NOT NULL `+
+			`UNION SELECT publisher_id FROM claim_version WHERE claim_id IN (`+query.Qs(len(ids))+`) AND publisher_id IS NOT NULL`,
+		args...).BindG(context.Background(), &channels)
+	if err != nil {
+		return nil, errors.Prefix("Datastore(CHANNELSOFBLOCK)", err)
+	}
+	channelIDs := make([]string, len(channels))
+	for i, channel := range channels {
+		channelIDs[i] = channel.ChannelClaimID
+	}
+	return channelIDs, nil
+}
+
+// ChannelsOfNames returns the channels with claims of names, and the channels that are claims of them, whose rollup is
+// affected when the claimtrie state of the names changes: claims expiring or promoted from abnormal claims.
+func ChannelsOfNames(names []string) ([]string, error) {
+	defer util.TimeTrack(time.Now(), "ChannelsOfNames", "mysqlprofile")
+	if len(names) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, 2*len(names)+1)
+	for _, name := range names {
+		args = append(args, name)
+	}
+	args = append(append(args, global.ChannelClaimType), args...)
+	var channels []struct {
+		ChannelClaimID string `boil:"channel_claim_id"`
+	}
+	err := queries.Raw(
+		`SELECT publisher_id AS channel_claim_id FROM claim WHERE name IN (`+query.Qs(len(names))+`) AND publisher_id IS NOT NULL `+
+			`UNION SELECT claim_id FROM claim WHERE type = ? AND name IN (`+query.Qs(len(names))+`)`,
+		args...).BindG(context.Background(), &channels)
+	if err != nil {
+		return nil, errors.Prefix("Datastore(CHANNELSOFNAMES)", err)
+	}
+	channelIDs := make([]string, len(channels))
+	for i, channel := range channels {
+		channelIDs[i] = channel.ChannelClaimID
+	}
+	return channelIDs, nil
+}
+
+// UpdateChannelStats recomputes the rollup of channels from their claims, supports and purchases. Only claims that
+// were not abandoned or expired are counted, while tips and purchases count whether or not they were withdrawn since.
+// The rollup is recomputed as a whole rather than maintained incrementally, so that a reorg or a repair of a block
+// leaves it right without undoing the changes of the block. The queries are indexed by publisher_id and
+// supported_claim_id, which keeps it cheap for the channels a block touched.
+func UpdateChannelStats(channelIDs ...string) error {
+	defer util.TimeTrack(time.Now(), "UpdateChannelStats", "mysqlprofile")
+	for _, channelID := range channelIDs {
+		if channelID == "" {
+			continue
+		}
+		stats := &model.ChannelStat{ChannelClaimID: channelID}
+		err := queries.Raw(`
+			SELECT
+				COALESCE(SUM(type = ?), 0) AS stream_count,
+				COALESCE(SUM(type = ?), 0) AS collection_count,
+				COALESCE(SUM(type = ?), 0) AS repost_count
+			FROM claim
+			WHERE publisher_id = ? AND bid_state NOT IN ('Spent', 'Expired')`,
+			global.StreamClaimType, global.ClaimListClaimType, global.ClaimReferenceClaimType, channelID).BindG(context.Background(), stats)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		err = queries.Raw(`
+			SELECT
+				COALESCE(SUM(IF(s.spent_transaction_hash IS NULL, s.support_amount, 0)), 0) AS support_amount,
+				COALESCE(SUM(IF(s.is_tip = 1, s.support_amount, 0)), 0) AS tip_amount
+			FROM (
+				SELECT support_amount, spent_transaction_hash, is_tip FROM support WHERE supported_claim_id = ?
+				UNION ALL
+				SELECT s.support_amount, s.spent_transaction_hash, s.is_tip
+				FROM claim c
+				INNER JOIN support s ON s.supported_claim_id = c.claim_id
+				WHERE c.publisher_id = ?
+			) s`,
+			channelID, channelID).BindG(context.Background(), stats)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		err = queries.Raw(`
+			SELECT COUNT(*) AS purchase_count, COALESCE(SUM(p.amount_satoshi), 0) / 100000000 AS purchase_revenue
+			FROM purchase p
+			INNER JOIN claim c ON c.claim_id = p.claim_id
+			WHERE c.publisher_id = ?`, channelID).BindG(context.Background(), stats)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		err = queries.Raw(`
+			SELECT MIN(v.height) AS first_publish_height, MAX(v.height) AS last_publish_height
+			FROM claim c
+			INNER JOIN claim_version v ON v.claim_id = c.claim_id
+			WHERE c.publisher_id = ? AND v.publisher_id = c.publisher_id`, channelID).BindG(context.Background(), stats)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		var tags []struct {
+			Tag string `boil:"tag"`
+		}
+		err = queries.Raw(`
+			SELECT DISTINCT t.tag
+			FROM claim c
+			INNER JOIN claim_tag ct ON ct.claim_id = c.claim_id
+			INNER JOIN tag t ON t.id = ct.tag_id
+			WHERE c.publisher_id = ? AND c.bid_state NOT IN ('Spent', 'Expired')
+			ORDER BY t.tag`, channelID).BindG(context.Background(), &tags)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		tagNames := make([]string, len(tags))
+		for i, tag := range tags {
+			tagNames[i] = tag.Tag
+		}
+		err = stats.Tags.Marshal(tagNames)
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+		c := model.ChannelStatColumns
+		err = stats.UpsertG(boil.Whitelist(c.StreamCount, c.CollectionCount, c.RepostCount, c.SupportAmount, c.TipAmount,
+			c.PurchaseCount, c.PurchaseRevenue, c.FirstPublishHeight, c.LastPublishHeight, c.Tags), boil.Infer())
+		if err != nil {
+			return errors.Prefix("Datastore(UPDATECHANNELSTATS)", err)
+		}
+	}
+	return nil
+}
+
+// PutAddressLabel stores the label of an address, replacing the label it had.
+func PutAddressLabel(label *model.AddressLabel) error {
+	defer util.TimeTrack(time.Now(), "PutAddressLabel", "mysqlprofile")
+	c := model.AddressLabelColumns
+	err := label.UpsertG(boil.Whitelist(c.Label, c.Category, c.Source), boil.Infer())
+	if err != nil {
+		return errors.Prefix("Datastore(PUTADDRESSLABEL)", err)
+	}
+	return nil
+}
+
+// DeleteAddressLabel removes the label of an address, if it has one.
+func DeleteAddressLabel(address string) error {
+	defer util.TimeTrack(time.Now(), "DeleteAddressLabel", "mysqlprofile")
+	err := model.AddressLabels(model.AddressLabelWhere.Address.EQ(address)).DeleteAllG()
+	if err != nil {
+		return errors.Prefix("Datastore(DELETEADDRESSLABEL)", err)
+	}
+	return nil
+}
@@ -2,22 +2,35 @@ package db
 
 import (
 	"context"
+	"math"
+	"strings"
 
 	g "github.com/lbryio/chainquery/swagger/clients/goclient"
 	"github.com/lbryio/chainquery/util"
 
 	"github.com/lbryio/chainquery/meta"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
 // AddressSummary summarizes information for an address from chainquery database
 type AddressSummary struct {
-	ID            uint64  `boil:"id"`
-	Address       string  `boil:"address"`
-	TotalReceived float64 `boil:"total_received"`
-	TotalSent     float64 `boil:"total_sent"`
-	Balance       float64 `boil:"balance"`
+	ID            uint64         `boil:"id"`
+	Address       string         `boil:"address"`
+	TotalReceived float64        `boil:"total_received"`
+	TotalSent     float64        `boil:"total_sent"`
+	Balance       float64        `boil:"balance"`
+	ClusterID     null.Uint64    `boil:"cluster_id"`
+	Labels        []AddressLabel `boil:"-"`
+}
+
+// AddressLabel is a label of an address or of another address of the wallet it was clustered into.
+type AddressLabel struct {
+	Address  string      `boil:"address" json:"address"`
+	Label    string      `boil:"label" json:"label"`
+	Category string      `boil:"category" json:"category"`
+	Source   null.String `boil:"source" json:"source"`
 }
 
 // GetTableStatus provides size information for the tables in the chainquery database
@@ -58,22 +71,215 @@ func GetAddressSummary(address string) (*AddressSummary, error) {
 	var context context.Context
 	addressSummary := AddressSummary{}
 	err := queries.Raw(
-		`SELECT address.address, `+
+		`SELECT address.id, address.address, `+
 			`SUM(ta.credit_amount) AS total_received, `+
 			`SUM(ta.debit_amount) AS total_sent,`+
-			`(SUM(ta.credit_amount) - SUM(ta.debit_amount)) AS balance `+
+			`(SUM(ta.credit_amount) - SUM(ta.debit_amount)) AS balance, `+
+			`ac.cluster_id `+
 			`FROM address LEFT JOIN transaction_address as ta ON ta.address_id = address.id `+
+			`LEFT JOIN address_cluster ac ON ac.address_id = address.id `+
 			`WHERE address.address=? `+
-			`GROUP BY address.address `, address).BindG(context, &addressSummary)
+			`GROUP BY address.id, address.address, ac.cluster_id `, address).BindG(context, &addressSummary)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// The label of the address comes first, followed by the labels of the other addresses of its wallet.
+	err = queries.Raw(
+		`SELECT l.address, l.label, l.category, l.source FROM address_label l WHERE l.address = ? `+
+			`UNION ALL `+
+			`SELECT l.address, l.label, l.category, l.source FROM address_cluster ac `+
+			`INNER JOIN address a ON a.id = ac.address_id `+
+			`INNER JOIN address_label l ON l.address = a.address `+
+			`WHERE ac.cluster_id = ? AND a.id != ?`,
+		addressSummary.Address, addressSummary.ClusterID, addressSummary.ID).BindG(context, &addressSummary.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	return &addressSummary, nil
 
 }
 
+// TipPeriod is the total of tips received during a period of time.
+type TipPeriod struct {
+	Period          string  `boil:"period" json:"period"`
+	Tips            uint64  `boil:"tips" json:"tips"`
+	Amount          float64 `boil:"amount" json:"amount"`
+	WithdrawnAmount float64 `boil:"withdrawn_amount" json:"withdrawn_amount"`
+}
+
+// Supporter is the total an address has put behind a claim.
+type Supporter struct {
+	Address      string  `boil:"address" json:"address"`
+	Supports     uint64  `boil:"supports" json:"supports"`
+	TotalAmount  float64 `boil:"total_amount" json:"total_amount"`
+	ActiveAmount float64 `boil:"active_amount" json:"active_amount"`
+	IsTip        bool    `boil:"is_tip" json:"is_tip"`
+	FirstHeight  uint64  `boil:"first_height" json:"first_height"`
+	LastHeight   uint64  `boil:"last_height" json:"last_height"`
+}
+
+// GetChannelTips returns the confirmed tips received by a channel and its claims, grouped by periodFormat (a MySQL
+// DATE_FORMAT format) of the transaction time, between the unix times from and to. A to of 0 means no upper bound.
+func GetChannelTips(channelID string, periodFormat string, from, to uint64) ([]TipPeriod, error) {
+	var context context.Context
+	if to == 0 {
+		to = math.MaxInt64
+	}
+	var tips []TipPeriod
+	err := queries.Raw(
+		`SELECT DATE_FORMAT(FROM_UNIXTIME(t.transaction_time), ?) AS period, `+
+			`COUNT(*) AS tips, `+
+			`SUM(s.support_amount) AS amount, `+
+			`SUM(IF(s.spent_transaction_hash IS NULL, 0, s.support_amount)) AS withdrawn_amount `+
+			`FROM support s `+
+			`INNER JOIN claim c ON c.claim_id = s.supported_claim_id `+
+			`INNER JOIN transaction t ON t.hash = s.transaction_hash_id `+
+			`WHERE s.is_tip = 1 AND s.height > 0 AND (c.claim_id = ? OR c.publisher_id = ?) `+
+			`AND t.transaction_time BETWEEN ? AND ? `+
+			`GROUP BY period ORDER BY period`, periodFormat, channelID, channelID, from, to).BindG(context, &tips)
+	if err != nil {
+		return nil, err
+	}
+	return tips, nil
+}
+
+// GetTopSupporters returns the addresses that put the most LBC behind a claim, ordered by the amount still active.
+func GetTopSupporters(claimID string, tipsOnly bool, limit, offset int) ([]Supporter, error) {
+	var context context.Context
+	var supporters []Supporter
+	err := queries.Raw(
+		`SELECT s.supporter_address AS address, `+
+			`COUNT(*) AS supports, `+
+			`SUM(s.support_amount) AS total_amount, `+
+			`SUM(IF(s.spent_transaction_hash IS NULL, s.support_amount, 0)) AS active_amount, `+
+			`MAX(s.is_tip) AS is_tip, `+
+			`COALESCE(MIN(NULLIF(s.height, 0)), 0) AS first_height, `+
+			`MAX(s.height) AS last_height `+
+			`FROM support s `+
+			`WHERE s.supported_claim_id = ? AND s.supporter_address IS NOT NULL AND s.is_tip >= ? `+
+			`GROUP BY s.supporter_address `+
+			`ORDER BY active_amount DESC, total_amount DESC `+
+			`LIMIT ? OFFSET ?`, claimID, tipsOnly, limit, offset).BindG(context, &supporters)
+	if err != nil {
+		return nil, err
+	}
+	return supporters, nil
+}
+
+// CollectionMember is a claim referenced by a list, at its position in the list. The claim fields are null when the
+// referenced claim is not known.
+type CollectionMember struct {
+	Position    uint        `boil:"position" json:"position"`
+	ClaimID     string      `boil:"claim_id" json:"claim_id"`
+	Name        null.String `boil:"name" json:"name"`
+	Title       null.String `boil:"title" json:"title"`
+	Type        null.String `boil:"type" json:"type"`
+	BidState    null.String `boil:"bid_state" json:"bid_state"`
+	PublisherID null.String `boil:"publisher_id" json:"publisher_id"`
+	Height      null.Uint   `boil:"height" json:"height"`
+}
+
+// GetCollectionMembers returns the claims referenced by a list claim in the order of the list.
+func GetCollectionMembers(listClaimID string) ([]CollectionMember, error) {
+	var context context.Context
+	var members []CollectionMember
+	err := queries.Raw(
+		`SELECT l.position, l.claim_id, c.name, c.title, c.type, c.bid_state, c.publisher_id, c.height `+
+			`FROM claim_in_list l `+
+			`LEFT JOIN claim c ON c.claim_id = l.claim_id `+
+			`WHERE l.list_claim_id = ? `+
+			`ORDER BY l.position`, listClaimID).BindG(context, &members)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// ClaimSearch holds the text and filters of a claim search. Empty filters are not applied.
+type ClaimSearch struct {
+	Query       string
+	ClaimType   string
+	ContentType string
+	Tags        []string
+	ChannelID   string
+	NSFW        *bool
+	Language    string
+}
+
+// ClaimSearchResult is a claim matching a search along with its score.
+type ClaimSearchResult struct {
+	ClaimID         string      `boil:"claim_id" json:"claim_id"`
+	Name            string      `boil:"name" json:"name"`
+	Title           null.String `boil:"title" json:"title"`
+	Author          null.String `boil:"author" json:"author"`
+	Type            null.String `boil:"type" json:"type"`
+	ContentType     null.String `boil:"content_type" json:"content_type"`
+	PublisherID     null.String `boil:"publisher_id" json:"publisher_id"`
+	ThumbnailURL    null.String `boil:"thumbnail_url" json:"thumbnail_url"`
+	Language        null.String `boil:"language" json:"language"`
+	IsNSFW          bool        `boil:"is_nsfw" json:"is_nsfw"`
+	BidState        string      `boil:"bid_state" json:"bid_state"`
+	EffectiveAmount uint64      `boil:"effective_amount" json:"effective_amount"`
+	Height          uint        `boil:"height" json:"height"`
+	Relevance       float64     `boil:"relevance" json:"relevance"`
+	Score           float64     `boil:"score" json:"score"`
+}
+
+// SearchClaims searches the title, description and author of the claims neither spent nor expired with the FULLTEXT
+// index of the claim table. Results are ranked by relevance weighted by the logarithm of the effective amount in LBC,
+// so among similarly relevant claims the ones with more LBC behind them come first.
+func SearchClaims(search ClaimSearch, limit, offset int) ([]ClaimSearchResult, error) {
+	var context context.Context
+	var results []ClaimSearchResult
+	const match = `MATCH(c.title, c.description, c.author) AGAINST (? IN NATURAL LANGUAGE MODE)`
+	where := []string{match, `c.bid_state NOT IN ('Spent', 'Expired')`}
+	args := []interface{}{search.Query, search.Query, search.Query}
+	if search.ClaimType != "" {
+		where = append(where, `c.type = ?`)
+		args = append(args, search.ClaimType)
+	}
+	if search.ContentType != "" {
+		where = append(where, `c.content_type = ?`)
+		args = append(args, search.ContentType)
+	}
+	if search.ChannelID != "" {
+		where = append(where, `c.publisher_id = ?`)
+		args = append(args, search.ChannelID)
+	}
+	if search.NSFW != nil {
+		where = append(where, `c.is_nsfw = ?`)
+		args = append(args, *search.NSFW)
+	}
+	if search.Language != "" {
+		where = append(where, `c.language = ?`)
+		args = append(args, search.Language)
+	}
+	if len(search.Tags) > 0 {
+		where = append(where, `c.claim_id IN (SELECT ct.claim_id FROM claim_tag ct INNER JOIN tag t ON t.id = ct.tag_id `+
+			`WHERE t.tag IN (`+strings.TrimSuffix(strings.Repeat("?,", len(search.Tags)), ",")+`))`)
+		for _, tag := range search.Tags {
+			args = append(args, tag)
+		}
+	}
+	args = append(args, limit, offset)
+	err := queries.Raw(
+		`SELECT c.claim_id, c.name, c.title, c.author, c.type, c.content_type, c.publisher_id, c.thumbnail_url, `+
+			`c.language, c.is_nsfw, c.bid_state, c.effective_amount, c.height, `+
+			match+` AS relevance, `+
+			match+` * (1 + LOG10(1 + c.effective_amount / 100000000)) AS score `+
+			`FROM claim c `+
+			`WHERE `+strings.Join(where, " AND ")+` `+
+			`ORDER BY score DESC, c.effective_amount DESC `+
+			`LIMIT ? OFFSET ?`, args...).BindG(context, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // APIQuery is the entry point from the API to chainquery. The results are turned into json.
 func APIQuery(query string, args ...interface{}) (interface{}, error) {
 	rows, err := apiQuery(query, args...)
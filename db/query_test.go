@@ -2,11 +2,14 @@ package db
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/lbryio/chainquery/model"
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -52,3 +55,34 @@ func TestQueryGetTableStatus(t *testing.T) {
 		println(stat.TableName, ":", stat.NrRows)
 	}
 }
+
+func TestSearchClaims(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := boil.GetDB()
+	boil.SetDB(db)
+	defer boil.SetDB(old)
+
+	nsfw := false
+	search := ClaimSearch{Query: "cats", ClaimType: "stream", ChannelID: "channel", NSFW: &nsfw, Tags: []string{"pets", "funny"}}
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE MATCH(c.title, c.description, c.author) AGAINST (? IN NATURAL LANGUAGE MODE) `+
+		`AND c.bid_state NOT IN ('Spent', 'Expired') AND c.type = ? AND c.publisher_id = ? AND c.is_nsfw = ? `+
+		`AND c.claim_id IN (SELECT ct.claim_id FROM claim_tag ct INNER JOIN tag t ON t.id = ct.tag_id WHERE t.tag IN (?,?)) `+
+		`ORDER BY score DESC, c.effective_amount DESC LIMIT ? OFFSET ?`)).
+		WithArgs("cats", "cats", "cats", "stream", "channel", false, "pets", "funny", 10, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id", "name", "bid_state", "effective_amount", "score"}).
+			AddRow("claim", "cats", "Controlling", 100000000, 2.5))
+
+	results, err := SearchClaims(search, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ClaimID != "claim" || results[0].Score != 2.5 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -78,6 +78,22 @@ var (
 		Help:      "The durations of lbrycrd JSON-RPC calls by method and result",
 	}, []string{"method", "result"})
 
+	// ClaimTrieMismatches counts differences between the locally computed claimtrie and lbrycrd by field.
+	ClaimTrieMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "claimtrie",
+		Name:      "mismatches",
+		Help:      "differences between the locally computed claimtrie and lbrycrd by field",
+	}, []string{"field"})
+
+	// AbnormalClaims counts the outcomes of retrying to decode abnormal claims.
+	AbnormalClaims = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "abnormal_claims",
+		Name:      "reprocessed",
+		Help:      "abnormal claims retried by outcome",
+	}, []string{"result"})
+
 	// SocketyNotifications metric for processing failure count by type
 	SocketyNotifications = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "chainquery",
@@ -85,6 +101,88 @@ var (
 		Name:      "notifications",
 		Help:      "counter for sending sockety notifications as the blockchain sourcex",
 	}, []string{"type", "category", "subcategory"})
+
+	// NotificationDeliveries metric for webhook deliveries by subscriber and outcome: success, failure or dead when
+	// moved to the dead letters
+	NotificationDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "notifications",
+		Name:      "deliveries",
+		Help:      "webhook deliveries by subscriber and result",
+	}, []string{"subscriber", "result"})
+
+	// NotificationDeliveryLatency metric for the duration of webhook deliveries by subscriber
+	NotificationDeliveryLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "chainquery",
+		Subsystem: "notifications",
+		Name:      "delivery_seconds",
+		Help:      "duration of webhook deliveries by subscriber",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"subscriber"})
+
+	// NotificationBacklog metric for the notifications pending in the outbox by subscriber
+	NotificationBacklog = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "chainquery",
+		Subsystem: "notifications",
+		Name:      "backlog",
+		Help:      "notifications pending in the outbox by subscriber",
+	}, []string{"subscriber"})
+
+	// SocketyDeliveries metric for sockety notifications by outcome: success, failure or dropped when the queue is full
+	SocketyDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "sockety",
+		Name:      "deliveries",
+		Help:      "sockety notifications by result",
+	}, []string{"result"})
+
+	// SocketyQueueDepth metric for the sockety notifications waiting to be sent
+	SocketyQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "chainquery",
+		Subsystem: "sockety",
+		Name:      "queue_depth",
+		Help:      "sockety notifications waiting to be sent",
+	})
+
+	// PublishedChanges metric for the changes sent by the change publisher by entity and operation
+	PublishedChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "publisher",
+		Name:      "changes",
+		Help:      "changes published by entity and operation",
+	}, []string{"entity", "op"})
+
+	// StreamClients metric for the clients connected to the API stream
+	StreamClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "chainquery",
+		Subsystem: "stream",
+		Name:      "clients",
+		Help:      "clients connected to the api stream",
+	})
+
+	// Alerts metric for the alert messages sent by rule and state: firing or resolved
+	Alerts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "alerts",
+		Name:      "sent",
+		Help:      "alert messages sent by rule and state",
+	}, []string{"rule", "state"})
+
+	// AlertsFiring metric for the alerts firing by rule
+	AlertsFiring = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "chainquery",
+		Subsystem: "alerts",
+		Name:      "firing",
+		Help:      "alerts firing by rule",
+	}, []string{"rule"})
+
+	// AlertSinkFailures metric for the alert messages a sink failed to send by sink kind
+	AlertSinkFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "chainquery",
+		Subsystem: "alerts",
+		Name:      "sink_failures",
+		Help:      "alert messages a sink failed to send by sink kind",
+	}, []string{"sink"})
 )
 
 // Job helper function to make tracking metric one line deferral
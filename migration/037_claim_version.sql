@@ -0,0 +1,35 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE claim_version
+(
+    id SERIAL,
+    claim_id VARCHAR(40) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci' NOT NULL,
+    transaction_hash VARCHAR(70) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    vout INTEGER UNSIGNED NOT NULL,
+    height INTEGER UNSIGNED NOT NULL,
+    is_update TINYINT(1) NOT NULL DEFAULT 0,
+    name VARCHAR(1024) NOT NULL,
+    claim_address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL DEFAULT '',
+    publisher_id CHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci,
+    type VARCHAR(100),
+    title TEXT,
+    description MEDIUMTEXT,
+    thumbnail_url TEXT,
+    fee DOUBLE NOT NULL DEFAULT 0,
+    fee_currency CHAR(30),
+    fee_address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci,
+    value_as_hex MEDIUMTEXT NOT NULL,
+    value_as_json JSON,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_ClaimVersion (id),
+    FOREIGN KEY FK_ClaimVersionTransaction (transaction_hash) REFERENCES transaction (hash) ON DELETE CASCADE ON UPDATE NO ACTION,
+    UNIQUE KEY Idx_ClaimVersionOutpoint (transaction_hash, vout),
+    INDEX Idx_ClaimVersionClaim (claim_id, height),
+    INDEX Idx_ClaimVersionCreated (created_at),
+    INDEX Idx_ClaimVersionModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
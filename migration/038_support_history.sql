@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE support
+    ADD COLUMN height INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    ADD COLUMN supporter_address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci DEFAULT NULL,
+    ADD COLUMN is_tip TINYINT(1) NOT NULL DEFAULT 0,
+    ADD COLUMN spent_transaction_hash VARCHAR(70) CHARACTER SET latin1 COLLATE latin1_general_ci DEFAULT NULL,
+    ADD COLUMN spent_height INTEGER UNSIGNED DEFAULT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE support
+    ADD INDEX Idx_SupportClaimTip (supported_claim_id, is_tip),
+    ADD INDEX Idx_SupportSupporterAddress (supporter_address),
+    ADD INDEX Idx_SupportHeight (height),
+    ADD CONSTRAINT FK_SupportSpentTransaction
+        FOREIGN KEY (spent_transaction_hash)
+        REFERENCES transaction (hash)
+            ON DELETE SET NULL
+            ON UPDATE NO ACTION;
+-- +migrate StatementEnd
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE support
+    ADD COLUMN valid_at_height INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    ADD INDEX Idx_SupportValidAtHeight (valid_at_height);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE abnormal_claim ADD INDEX Idx_AbnormalClaimName (name(255));
+-- +migrate StatementEnd
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE takeover
+(
+    id SERIAL,
+    name VARCHAR(1024) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_bin' NOT NULL,
+    claim_id VARCHAR(40) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci',
+    height INTEGER UNSIGNED NOT NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_Takeover (id),
+    INDEX Idx_TakeoverName (name(255), height),
+    INDEX Idx_TakeoverClaim (claim_id),
+    INDEX Idx_TakeoverHeight (height)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
@@ -0,0 +1,14 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE purchase
+    ADD COLUMN payment_vout INTEGER UNSIGNED NULL AFTER amount_satoshi,
+    ADD COLUMN buyer_address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci NULL AFTER payment_vout,
+    ADD COLUMN fee DOUBLE NOT NULL DEFAULT 0 AFTER buyer_address,
+    ADD COLUMN fee_currency CHAR(30) NULL AFTER fee,
+    ADD COLUMN fee_address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci NULL AFTER fee_currency,
+    ADD COLUMN fee_satisfied TINYINT(1) NULL AFTER fee_address,
+    ADD COLUMN is_resolved TINYINT(1) NOT NULL DEFAULT 1 AFTER fee_satisfied,
+    ADD INDEX Idx_PurchaseBuyer (buyer_address),
+    ADD INDEX Idx_PurchaseUnresolved (is_resolved, claim_id);
+-- +migrate StatementEnd
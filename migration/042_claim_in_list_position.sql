@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE claim_in_list
+    ADD COLUMN position INTEGER UNSIGNED NOT NULL DEFAULT 0 AFTER claim_id,
+    ADD UNIQUE INDEX Idx_ClaimInListPosition (list_claim_id, position),
+    ADD INDEX Idx_ClaimInListClaim (claim_id),
+    DROP INDEX Idx_claim_tag;
+-- +migrate StatementEnd
@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE abnormal_claim
+    ADD COLUMN failure_reason VARCHAR(1024) NULL AFTER value_as_json;
+-- +migrate StatementEnd
@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE claim
+    ADD FULLTEXT INDEX Idx_ClaimSearch (title, description, author);
+-- +migrate StatementEnd
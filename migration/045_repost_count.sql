@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE claim
+    ADD COLUMN repost_count INTEGER UNSIGNED NOT NULL DEFAULT 0 AFTER claim_reference;
+-- +migrate StatementEnd
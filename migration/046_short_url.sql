@@ -0,0 +1,6 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE claim
+    ADD COLUMN short_url VARCHAR(1072) NULL AFTER `name`;
+-- +migrate StatementEnd
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE channel_stats
+(
+    id SERIAL,
+    channel_claim_id VARCHAR(40) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci' NOT NULL,
+    stream_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    collection_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    repost_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    support_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    tip_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    purchase_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    purchase_revenue DOUBLE(58,8) NOT NULL DEFAULT 0,
+    first_publish_height INTEGER UNSIGNED NULL,
+    last_publish_height INTEGER UNSIGNED NULL,
+    tags JSON NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_ChannelStats (id),
+    UNIQUE KEY Idx_ChannelStatsChannel (channel_claim_id),
+    INDEX Idx_ChannelStatsModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
@@ -0,0 +1,34 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE chain_stats
+(
+    id SERIAL,
+    period VARCHAR(10) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    period_start BIGINT UNSIGNED NOT NULL,
+    first_height INTEGER UNSIGNED NOT NULL,
+    last_height INTEGER UNSIGNED NOT NULL,
+    block_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    transaction_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    fee_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    new_address_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    new_stream_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    new_channel_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    new_collection_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    new_repost_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    support_added_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    support_added_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    support_withdrawn_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    support_withdrawn_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    purchase_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    purchase_amount DOUBLE(58,8) NOT NULL DEFAULT 0,
+    active_claim_count INTEGER UNSIGNED NOT NULL DEFAULT 0,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_ChainStats (id),
+    UNIQUE KEY Idx_ChainStatsPeriod (period, period_start),
+    INDEX Idx_ChainStatsModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
@@ -0,0 +1,35 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE address_label
+(
+    id SERIAL,
+    address VARCHAR(40) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    label VARCHAR(255) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci' NOT NULL,
+    category VARCHAR(40) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci' NOT NULL,
+    source VARCHAR(255) CHARACTER SET 'utf8mb4' COLLATE 'utf8mb4_unicode_ci' NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_AddressLabel (id),
+    UNIQUE KEY Idx_AddressLabelAddress (address),
+    INDEX Idx_AddressLabelCategory (category),
+    INDEX Idx_AddressLabelModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE address_cluster
+(
+    address_id BIGINT UNSIGNED NOT NULL,
+    cluster_id BIGINT UNSIGNED NOT NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_AddressCluster (address_id),
+    FOREIGN KEY FK_AddressClusterAddress (address_id) REFERENCES address (id) ON DELETE CASCADE ON UPDATE NO ACTION,
+    INDEX Idx_AddressClusterCluster (cluster_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=4;
+-- +migrate StatementEnd
@@ -0,0 +1,27 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE notification_outbox
+(
+    id SERIAL,
+    subscriber VARCHAR(512) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    payload MEDIUMTEXT NOT NULL,
+    transaction_hash VARCHAR(70) CHARACTER SET latin1 COLLATE latin1_general_ci,
+    status VARCHAR(20) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL DEFAULT 'pending',
+    attempts INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    delivered_at DATETIME,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_NotificationOutbox (id),
+    FOREIGN KEY FK_NotificationOutboxTransaction (transaction_hash) REFERENCES transaction (hash) ON DELETE CASCADE ON UPDATE NO ACTION,
+    INDEX Idx_NotificationOutboxSubscriber (status, subscriber, id),
+    INDEX Idx_NotificationOutboxDelivered (status, delivered_at),
+    INDEX Idx_NotificationOutboxCreated (created_at),
+    INDEX Idx_NotificationOutboxModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE notification_outbox
+    ADD COLUMN event_id CHAR(64) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL DEFAULT '' AFTER id,
+    ADD INDEX Idx_NotificationOutboxEvent (event_id);
+-- +migrate StatementEnd
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE notification_outbox
+    ADD COLUMN depth INTEGER UNSIGNED NOT NULL DEFAULT 0 AFTER transaction_hash,
+    ADD COLUMN reverted TINYINT(1) NOT NULL DEFAULT 0 AFTER depth,
+    ADD COLUMN reverted_block_hash VARCHAR(70) CHARACTER SET latin1 COLLATE latin1_general_ci NULL AFTER reverted,
+    ADD COLUMN reverted_height INTEGER UNSIGNED NULL AFTER reverted_block_hash,
+    ADD INDEX Idx_NotificationOutboxLane (status, subscriber, depth, id),
+    DROP INDEX Idx_NotificationOutboxSubscriber;
+-- +migrate StatementEnd
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE notification_subscriber
+(
+    id SERIAL,
+    url VARCHAR(512) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    params TEXT NOT NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_NotificationSubscriber (id),
+    UNIQUE KEY Idx_NotificationSubscriberURLType (url, type),
+    INDEX Idx_NotificationSubscriberCreated (created_at),
+    INDEX Idx_NotificationSubscriberModified (modified_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
@@ -0,0 +1,5 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE job_status ADD COLUMN failures INTEGER UNSIGNED NOT NULL DEFAULT 0 AFTER error_message;
+-- +migrate StatementEnd
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE change_outbox
+(
+    id SERIAL,
+    block_hash VARCHAR(70) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL,
+    height BIGINT UNSIGNED NOT NULL,
+    reverted TINYINT(1) NOT NULL DEFAULT 0,
+    payload LONGTEXT NOT NULL,
+    status VARCHAR(20) CHARACTER SET latin1 COLLATE latin1_general_ci NOT NULL DEFAULT 'pending',
+    attempts INTEGER UNSIGNED NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    published_at DATETIME,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY PK_ChangeOutbox (id),
+    INDEX Idx_ChangeOutboxStatus (status, id),
+    INDEX Idx_ChangeOutboxBlock (block_hash),
+    INDEX Idx_ChangeOutboxPublished (status, published_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE utf8mb4_unicode_ci;
+-- +migrate StatementEnd
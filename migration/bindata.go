@@ -38,6 +38,25 @@
 // migration/034_support_uniq_index.sql (234B)
 // migration/035_add_tx_count.sql (129B)
 // migration/036_add_block_processing_state.sql (140B)
+// migration/037_claim_version.sql (1.547kB)
+// migration/038_support_history.sql (911B)
+// migration/039_claimtrie_state.sql (339B)
+// migration/040_takeover.sql (721B)
+// migration/041_purchase_payment.sql (757B)
+// migration/042_claim_in_list_position.sql (319B)
+// migration/043_abnormal_claim_failure.sql (165B)
+// migration/044_claim_search.sql (155B)
+// migration/045_repost_count.sql (173B)
+// migration/046_short_url.sql (144B)
+// migration/047_channel_stats.sql (1.103kB)
+// migration/048_chain_stats.sql (1.592kB)
+// migration/049_address_label.sql (1.53kB)
+// migration/050_notification_outbox.sql (1.253kB)
+// migration/051_notification_event_id.sql (264B)
+// migration/052_notification_confirmations.sql (566B)
+// migration/053_notification_subscriber.sql (703B)
+// migration/054_job_status_failures.sql (168B)
+// migration/055_change_outbox.sql (978B)
 
 package migration
 
@@ -865,6 +884,386 @@ func migration036_add_block_processing_stateSql() (*asset, error) {
 	return a, nil
 }
 
+var _migration037_claim_versionSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5d\x6f\x9b\x4a\x10\x7d\xe7\x57\xcc\x9b\xb1\xae\x23\x39\xf7\x5a\xba\x95\x2a\x3f\x60\x18\x3b\xdb\xc0\xe2\x2e\x4b\x9a\xf4\x65\x45\x60\x6d\x6f\x85\x17\x0b\x96\x28\xf9\xf7\x15\x06\xdb\xc4\x4e\xd3\xaf\x37\x86\x39\x33\x7b\xe6\xcc\xc7\xd5\x15\xfc\xb3\x55\xeb\x32\x31\x12\xe2\x9d\x65\xf5\xed\xc8\x24\x46\x6e\xa5\x36\x33\xb9\x56\xda\x72\x19\x3a\x1c\x81\x3b\x33\x1f\x21\xcd\x13\xb5\x15\x4f\xb2\xac\x54\xa1\x2d\xdb\x02\x00\x50\x19\x44\xc8\x88\xe3\x8f\xf6\x66\x0b\x51\x19\xdc\x39\xcc\xbd\x71\x98\x3d\x19\x0f\xa1\xf9\x70\x5c\x8e\x0c\x22\xe4\x30\xa8\xcd\xea\xc3\xf6\x71\x32\x00\x37\xf4\xfd\x26\xfb\xe1\x8f\xa8\xb5\x4a\x8b\x4c\x8a\x54\x0d\x80\x86\x1c\x68\xec\x77\x79\x4d\x99\xe8\x2a\x49\x8d\x2a\xb4\xd8\x24\xd5\xe6\x98\xff\xff\x8b\xfc\x79\x62\x94\xbe\x3e\x26\x6f\x4d\xb1\x96\x5a\x96\x49\x2e\x52\x75\x96\xf9\xa9\xa8\x0d\x10\xca\x71\x81\x0c\x62\x1a\x91\x05\x45\xef\x0c\xb3\x91\x6a\xbd\xf9\x29\x4a\x55\xa2\xde\x65\x8d\x88\x9c\xd0\x07\x42\xb9\x7d\x3d\x3c\x42\xc0\xc3\xb9\x13\xfb\x1c\xc6\x6d\x41\x3a\xd9\xca\x63\x11\xd7\xe3\x7f\x27\x27\x68\x5f\xc9\x24\xcb\x4a\x59\x55\xef\xc8\xf9\xcb\xe5\x1e\x19\x0c\x06\xed\x0b\xbb\xfa\x31\x57\xd5\x46\x96\x42\x65\xf0\x87\xd9\xbb\xee\xbc\xec\xfa\xc5\x8c\x87\xdd\x6f\x65\x72\x09\x1c\xef\x79\x6b\x67\xb2\x4a\x4b\xb5\x6b\x9a\x08\x01\x7a\x24\x0e\x4e\x3e\xb3\xa9\xb7\x8f\x3a\x51\xb9\xa8\xcb\xbc\x17\xb3\x92\x12\xbc\x30\x6e\xc6\xef\x47\x52\xae\xa4\x14\x69\x5d\x96\x52\xa7\x2f\x6d\x1d\xff\x1d\x18\x34\xae\xbf\x97\xb0\x1b\x94\x24\xaf\xa5\x48\x2a\xb1\x91\xcf\x3d\xfa\xe7\xe3\x74\x40\x7d\xab\x0a\x0d\x9f\xa2\x90\x8e\xac\xbd\x23\x2d\x65\x62\x64\x26\x12\x03\x9e\xc3\x91\x93\xe0\x8d\x8a\xdc\x98\x31\xa4\x5c\x34\xde\x88\x3b\xc1\xb2\x7d\x7a\x5b\x64\x6a\xa5\x7e\x3b\x18\x42\x0a\xf1\xb2\x09\xb8\xf4\x75\xac\x96\x8c\x04\x0e\x7b\x80\x5b\x7c\x80\xe5\xad\x70\x9b\xf5\xbd\x6b\x17\x1c\x6c\x95\x75\x32\xce\x43\x86\x64\x41\xf7\xa8\xf9\x6b\x14\x3f\x2d\x26\xd8\xe7\x5b\x3a\x04\x86\x73\x64\x48\x5d\x8c\xa0\xe7\x04\xbb\xf5\x86\x14\x3c\xf4\xb1\xe1\xe7\x44\xae\xe3\x61\x8f\x31\x0d\xc1\x71\x39\x69\xf4\x6b\x24\x88\x29\xf9\x1c\xe3\x9e\x01\xc9\x9e\x5f\x51\x08\x6b\xb3\x2b\x94\x36\x97\xef\x8f\xf6\xdb\xdd\x15\x41\xa8\x87\xf7\x17\xc1\xfb\x6f\xb0\x0f\x77\x6b\xd4\xed\xfa\xfb\x31\x6d\x2b\xc1\x3e\xf5\xf4\x5d\x7c\xd0\xb5\x0f\xec\x5e\x23\x87\xd6\x10\x90\x2e\x08\xc5\x29\xd1\xba\xf0\x66\xa7\x3e\xde\x38\x2c\x42\x3e\xed\xae\xe2\x71\x2e\x2f\xaf\x24\xb0\xf0\x8b\x98\x87\x2c\x70\xf8\xd4\x0d\x83\x25\xc3\x28\x42\xaf\x11\x49\xcc\xfc\xd0\xbd\x15\x11\xf9\x8a\xd3\xc9\xc7\xb7\x2f\x3c\xea\xcc\xfa\x3e\x00\x3a\x82\x2a\x44\x0b\x06\x00\x00")
+
+func migration037_claim_versionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration037_claim_versionSql,
+		"migration/037_claim_version.sql",
+	)
+}
+
+func migration037_claim_versionSql() (*asset, error) {
+	bytes, err := migration037_claim_versionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/037_claim_version.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb5, 0xe2, 0xa9, 0xf3, 0x3a, 0x8, 0x77, 0xcf, 0xfe, 0xd, 0x2d, 0x10, 0xb9, 0x71, 0x7b, 0x58, 0x7d, 0x2b, 0xa6, 0xd4, 0x16, 0xd2, 0x47, 0x1e, 0x33, 0x58, 0x73, 0xb4, 0x15, 0x6c, 0x36, 0xc0}}
+	return a, nil
+}
+
+var _migration038_support_historySql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x51\x8b\x9c\x30\x14\x85\xdf\xfd\x15\xf7\x51\xe9\x2c\xec\x42\xa1\x0f\x7d\xca\xea\x75\x36\x6c\x7a\x2d\x31\x96\xee\x53\x08\x63\xd0\xc0\x4c\x56\x34\x85\xfe\xfc\x92\xd5\x5a\xbb\x3b\x33\x94\xb2\x28\x68\xcc\x39\x9e\xcb\xf9\x72\x73\x03\x1f\x4e\xae\x1b\x4d\xb0\xd0\x0c\x49\xb2\x5d\xd7\xc1\x04\x7b\xb2\x3e\xdc\xdb\xce\xf9\x84\x09\x85\x12\x14\xbb\x17\x08\xd3\x8f\x61\x78\x1e\x43\x02\x00\xc0\x8a\x02\xf2\x4a\x34\x5f\x08\x7a\xeb\xba\x3e\x00\x27\x85\x7b\x94\xd0\x50\xcd\xf7\x84\x05\x50\xa5\x80\x1a\x21\xa0\xc0\x92\x35\x42\xc1\xed\xee\xb5\x75\xf9\xa3\x1d\xb5\x69\xdb\xd1\x4e\x13\x7c\x63\x32\x7f\x60\x32\xfd\x78\x9b\x41\x7c\x61\x79\xcc\xaf\x51\xc1\xd1\x04\xe7\xef\xa2\x53\x30\x85\xcb\x52\x77\xd6\xdb\xd1\x1c\xf5\xc1\xad\x31\x31\xf3\x4d\x92\x9b\x74\x70\x03\x28\x4e\x4f\x9c\x54\x7a\x97\xfd\xd3\x78\x83\xf5\x41\x87\xd1\xf8\xc9\x1c\x82\x7b\xf6\xba\x37\x53\xbf\xce\xf8\xe9\x9d\x67\x9c\xe3\x2e\xd5\xb9\xb5\x7e\x3e\x8f\x0c\x7d\xfb\xdf\x30\x39\x15\xf8\x1d\x78\xfb\x53\xd7\xf3\x4e\x7e\x34\xee\xa4\xdc\x00\xe9\x6f\x4a\xad\x3e\xc4\x6f\xda\xb5\xbb\xa5\xcf\x6c\x77\xd9\xbf\x3c\xec\xc8\x16\xb4\xe9\x1b\xda\xd7\xec\x0f\x73\x0d\xe9\x5c\xc7\x46\x99\x57\x54\x2b\xc9\x38\x29\x28\x1f\xd7\xb0\x58\x9d\xfa\x03\xea\x45\x1d\xef\xb2\x92\xc8\xf7\x04\x8f\xf8\x04\xe9\x79\x9e\xd9\x2a\x96\x58\xa2\x44\xca\xb1\x86\x8d\x08\xd2\xbf\x55\xf1\xaa\x08\x0a\x14\xa8\xf0\x05\x7b\x44\xf2\x7a\xbb\xf9\x5a\xc4\x23\x40\x15\xb0\x5c\xf1\x8a\xae\x20\xfb\x35\x00\xe3\xe8\x86\x84\x8f\x03\x00\x00")
+
+func migration038_support_historySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration038_support_historySql,
+		"migration/038_support_history.sql",
+	)
+}
+
+func migration038_support_historySql() (*asset, error) {
+	bytes, err := migration038_support_historySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/038_support_history.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9d, 0x58, 0x43, 0xcb, 0x7, 0x91, 0x60, 0x25, 0x68, 0x2e, 0x9c, 0x9f, 0x74, 0x8a, 0xdb, 0xfe, 0x7d, 0xf8, 0x7, 0xce, 0x13, 0x7d, 0x96, 0xfb, 0xa0, 0xdd, 0x10, 0xf9, 0x43, 0xb0, 0xdb, 0x66}}
+	return a, nil
+}
+
+var _migration039_claimtrie_stateSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\x41\x4b\x03\x31\x10\x05\xe0\xfb\xfe\x8a\x77\xdc\xa2\x05\x11\x7a\xf2\x94\x36\xb1\x2e\xc4\x29\x74\x13\xf1\x16\x46\x37\x6c\x03\x9b\x74\x59\x47\xf1\xe7\x4b\xa9\x88\x2c\x22\x3d\x0e\x0f\xbe\x79\x6f\xb9\xc4\x55\x4e\xfd\xc4\x12\xe1\xc7\xaa\xfa\x7d\xb7\xc2\x12\x73\x2c\xb2\x8e\x7d\x2a\x95\xb2\xce\xec\xe1\xd4\xda\x1a\xbc\xbd\x8f\xe3\x71\x92\x0a\x00\x94\xd6\xd8\xec\xac\x7f\x24\x7c\xf0\x90\xba\xc0\x12\x0e\x31\xf5\x07\x41\x43\xce\x6c\xcd\x1e\x9e\xda\x66\x4b\x46\x83\x76\x0e\xe4\xad\x85\x36\xf7\xca\x5b\x87\x9b\xeb\x1f\xa3\x21\x6d\x9e\xd1\x74\x9f\xa1\x3d\xeb\x4f\x27\x4d\xc9\xc3\xd9\xaa\x67\xf8\xe2\xee\xef\xb2\xa6\x74\x17\xcf\xe0\x97\x72\x9c\x32\x0f\xe1\x75\xe0\x94\x67\x2d\xd4\x77\xb8\x39\x65\xc4\x39\xa2\x2e\x9c\x63\x7d\xbb\x5a\x2d\xfe\x7b\xfe\x35\x00\x50\xff\x27\x1f\x53\x01\x00\x00")
+
+func migration039_claimtrie_stateSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration039_claimtrie_stateSql,
+		"migration/039_claimtrie_state.sql",
+	)
+}
+
+func migration039_claimtrie_stateSql() (*asset, error) {
+	bytes, err := migration039_claimtrie_stateSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/039_claimtrie_state.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd2, 0x97, 0xfd, 0xb1, 0x43, 0x9d, 0x3, 0x52, 0x8, 0x11, 0xaf, 0xf3, 0x6f, 0xff, 0xc7, 0xd7, 0xdd, 0x15, 0x56, 0x46, 0x93, 0x4, 0x2a, 0x72, 0x64, 0xa5, 0x1e, 0x7e, 0x6c, 0x1c, 0xab, 0x9a}}
+	return a, nil
+}
+
+var _migration040_takeoverSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x41\x6f\x9c\x30\x10\x85\xef\xfc\x8a\xb9\x2d\xab\x26\x52\x1a\x11\xa9\x52\xc5\xc1\x98\xc9\xae\xb5\x60\x90\x6d\xda\xa6\x17\x8b\x60\x67\x63\xb5\x98\x6a\xe5\x54\xfd\xf9\x95\x59\x42\x23\x35\x39\xe4\xc6\x30\x6f\xbe\xd1\xbc\xe7\xcb\x4b\xf8\x30\xba\xe3\xa9\x0f\x16\xba\x5f\x49\xf2\xb2\x96\xa1\x0f\x76\xb4\x3e\x14\xf6\xe8\x7c\x42\x05\x12\x85\xa0\x48\x51\x21\x84\xfe\x87\x9d\x7e\xdb\x53\x92\x26\x00\x00\xce\x80\x44\xc1\x48\x75\x31\x97\xbe\x1f\x2d\x7c\x21\x82\xee\x89\x48\x3f\x5e\x5d\x67\x5b\x88\x9f\x84\x2a\x14\x20\x51\xc1\xe6\x29\x3c\x7c\x1a\xef\xb3\x0d\xd0\xa6\xaa\x22\xf6\xf9\x8f\xbe\x77\x7e\x03\xbc\x51\xc0\xbb\x6a\xc1\x0d\x3f\x7b\x37\x6a\x67\x56\x64\x76\xf5\x0e\xe0\x93\x77\xc3\x64\xac\x1e\xdc\xe6\x8c\x7b\xb4\xee\xf8\x18\x80\x71\x85\x3b\x14\xd0\x71\xc9\x76\x1c\xcb\x17\x4b\x67\xd9\x70\xb2\x7d\xb0\x46\xf7\x01\x4a\xa2\x50\xb1\x1a\x57\x09\x94\x78\x4b\xba\x4a\x01\xed\x84\x40\xae\x74\xec\x4a\x45\xea\xf6\xbc\x62\x9c\x8c\x7b\x70\xef\x1e\x86\x86\x43\xd7\xc6\x81\xd7\xc0\x33\xb9\x15\xac\x26\xe2\x0e\x0e\x78\x07\xed\x41\xab\x25\x07\x48\x9d\xd9\x9e\x77\x33\x5e\xe2\x37\x60\xe6\xcf\xda\xe4\x31\x8e\x34\x86\x92\x5e\xdf\xdc\x6c\x2f\x16\x07\xde\xd4\xd3\xe8\x37\xa4\xcf\xb6\xbf\xa9\xdb\xcf\x18\x48\x17\x5c\xb2\x05\xe4\x3b\xc6\x31\x67\xde\x4f\x65\xf1\xef\xce\x3d\x11\x12\x55\xbe\x24\xb2\x46\xf4\x7f\x42\x20\x9a\xaf\xfa\xb6\x11\x35\x51\x39\x6d\xea\x56\xa0\x94\x58\xc6\x63\x75\x51\x35\xf4\xa0\x25\xfb\x8e\x79\xf6\xf9\xf5\x77\x8a\xde\x24\x7f\x07\x00\x4f\xd5\x38\x4c\xd1\x02\x00\x00")
+
+func migration040_takeoverSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration040_takeoverSql,
+		"migration/040_takeover.sql",
+	)
+}
+
+func migration040_takeoverSql() (*asset, error) {
+	bytes, err := migration040_takeoverSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/040_takeover.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x95, 0xa9, 0x49, 0x81, 0x1, 0x4a, 0xfe, 0x33, 0xcb, 0xd2, 0xf1, 0x38, 0x38, 0xdb, 0xcf, 0xee, 0x90, 0x7, 0xbd, 0xb8, 0x72, 0x8a, 0x0, 0x60, 0x6, 0x5e, 0xc4, 0x29, 0xd5, 0xb7, 0x2c, 0xa4}}
+	return a, nil
+}
+
+var _migration041_purchase_paymentSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xc1\x6e\xe2\x30\x10\x86\xef\x79\x8a\x39\x26\x5a\x90\x40\xbb\xb7\x3d\x19\x62\x68\xa4\xd4\x54\xc1\xa9\xda\x93\xe5\x26\x03\x58\x4a\x1c\x64\x3b\xa8\xbc\x7d\xe5\x16\x51\x93\xa6\x3d\xf5\x66\x1f\xfe\xef\xff\x66\x34\xd3\x29\xfc\x69\xd5\xde\x48\x87\x50\x1e\xa3\x28\xfc\x6f\x9d\x74\xd8\xa2\x76\x0b\xdc\x2b\x1d\x91\x9c\xd3\x02\x38\x59\xe4\x14\x8e\xbd\xa9\x0e\xd2\x62\x04\x00\x40\xd2\x14\x96\x9b\xbc\xbc\x67\x70\x94\x67\x1f\x10\xa7\xae\x77\x90\x31\x4e\xd7\xb4\x80\x92\x6d\xb3\x35\xa3\x29\xb0\x32\xcf\x81\xac\x3c\x46\xb6\x5d\xaf\x9d\xb0\xd2\x75\xf6\xa0\x26\x43\xce\x4b\x7f\x46\x23\x64\x5d\x1b\xb4\x16\x1e\x49\xb1\xbc\x23\x45\xfc\x6f\x96\x80\x7f\x90\xa5\x47\x6c\x29\x87\x46\x3a\xa5\xe7\x3e\x95\x13\x4e\x2f\x5f\xb1\x47\x8d\x46\x36\xa2\x52\x61\x65\xe8\xf6\xa5\x70\x87\x08\xe9\xa6\xf4\xb3\xb1\x0d\xff\x88\xa5\x74\x45\xca\x9c\xc3\xec\x02\xb8\x91\x1a\x23\x88\xaa\x37\x06\x75\x75\x7e\xb7\x8c\xff\xce\x92\xb0\x7f\x87\x38\x1a\xfa\xdd\x29\x43\x8d\xd1\x3a\x2b\x9d\xb2\x3b\x85\x35\xf0\x8c\x3d\x67\x8c\xc7\xf3\xa1\xe6\xb7\x33\x2a\x2b\x0c\xda\xae\x39\x0d\xd2\xc3\x8d\xcd\x03\xd6\xb5\xef\x93\x96\xb1\x94\x3e\x41\x56\xbf\x8a\x87\xcb\x1d\x2d\xfc\x6a\x21\xbe\xd9\x70\xf2\x53\xa0\xd4\x57\x91\x38\xb0\x9a\x40\xd5\x48\xd5\x0a\x55\x27\xff\xc7\x6f\x99\xea\x3a\x7a\x1b\x00\x16\x64\xd1\x80\xf5\x02\x00\x00")
+
+func migration041_purchase_paymentSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration041_purchase_paymentSql,
+		"migration/041_purchase_payment.sql",
+	)
+}
+
+func migration041_purchase_paymentSql() (*asset, error) {
+	bytes, err := migration041_purchase_paymentSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/041_purchase_payment.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6c, 0x9c, 0x52, 0x37, 0x8f, 0x9e, 0x4, 0x53, 0x1d, 0xa, 0x7e, 0x88, 0xab, 0xc5, 0xd8, 0x0, 0x39, 0xa, 0xc0, 0xa7, 0x62, 0xb9, 0xd4, 0x7c, 0xd8, 0x69, 0x79, 0x43, 0x48, 0xf9, 0x95, 0x63}}
+	return a, nil
+}
+
+var _migration042_claim_in_list_positionSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x31\x6b\xc3\x30\x10\x85\x77\xfd\x8a\x37\xa6\xb4\x81\xee\x9d\x94\xe8\x12\x04\xea\x39\x75\x24\xe8\x66\x4c\x2d\xcc\x41\xac\x84\xfa\x86\xfe\xfc\xe2\x36\x71\x32\x78\xbb\xe3\xde\xfb\x3e\xb8\xf5\x1a\xcf\x83\xf4\xdf\xad\x66\xa4\x8b\x31\x8f\xfb\x51\x5b\xcd\x43\x2e\xba\xc9\xbd\x14\x63\x43\xa4\x1a\xd1\x6e\x02\xe1\xeb\xd4\xca\xd0\x48\x69\x4e\x32\xaa\x01\x00\xeb\x1c\xb6\x55\x48\xef\x8c\xcb\x79\x14\x95\x73\x81\xe7\x48\x7b\xaa\x91\xf8\xe8\xf7\x4c\x0e\x5c\x45\x70\x0a\x01\x8e\x76\x36\x85\x88\x57\xd8\xdd\x44\xbd\xf2\xba\x97\x99\x95\xd8\x7f\x24\x82\x67\x47\x9f\xf0\xdd\x4f\xb3\x9d\x22\xbe\x04\x19\xf5\x70\x13\xac\x26\x7d\x33\x97\x67\xf3\xd3\x9d\xb3\x08\xf8\x1b\xb1\xba\x15\xaf\x71\x57\x57\x87\x87\xfc\xff\x55\xdb\xfe\x6d\xf9\x2b\x54\x3a\xf3\x3b\x00\x76\xf9\x52\x89\x3f\x01\x00\x00")
+
+func migration042_claim_in_list_positionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration042_claim_in_list_positionSql,
+		"migration/042_claim_in_list_position.sql",
+	)
+}
+
+func migration042_claim_in_list_positionSql() (*asset, error) {
+	bytes, err := migration042_claim_in_list_positionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/042_claim_in_list_position.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1e, 0x90, 0x87, 0x30, 0xb2, 0xb4, 0x33, 0x47, 0x34, 0x81, 0x7b, 0x62, 0x31, 0x4d, 0x6f, 0x2, 0xd9, 0xd6, 0xb2, 0x9c, 0x68, 0xf5, 0xc, 0x26, 0xf4, 0xe3, 0x88, 0xb0, 0x22, 0x71, 0x70, 0x63}}
+	return a, nil
+}
+
+var _migration043_abnormal_claim_failureSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\xbd\xca\xc2\x30\x14\x87\xf1\x3d\x57\xf1\x1f\xdf\x17\x29\xa8\xb8\x39\xa5\x1f\xe2\x10\x2b\xd4\xd6\x35\x1c\xf5\x58\x22\xc9\xa9\x24\xa9\xd7\x2f\x6e\x0e\x8e\xcf\xf2\xfc\x8a\x02\x8b\xe0\xc6\x48\x99\x31\x3c\x95\xfa\xee\x53\xa6\xcc\x81\x25\x97\x3c\x3a\x51\xda\xf4\x4d\x87\x5e\x97\xa6\x01\x5d\x64\x8a\x81\xbc\xbd\x7a\x72\x41\x01\x80\xae\x6b\x54\x47\x33\x1c\x5a\xdc\xc9\xf9\x39\xb2\x8d\x4c\x69\x12\x9c\x75\x57\xed\x75\xf7\xb7\x5a\xae\x37\xff\x68\x07\x63\xa0\x77\x9f\xd7\x8b\xfc\xcc\x96\x92\x7d\xa4\x49\xb6\xbf\xed\x46\x6e\xea\x3d\x00\x46\x9f\xf1\x8e\xa5\x00\x00\x00")
+
+func migration043_abnormal_claim_failureSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration043_abnormal_claim_failureSql,
+		"migration/043_abnormal_claim_failure.sql",
+	)
+}
+
+func migration043_abnormal_claim_failureSql() (*asset, error) {
+	bytes, err := migration043_abnormal_claim_failureSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/043_abnormal_claim_failure.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd2, 0x0, 0x4b, 0x1f, 0xb9, 0xaf, 0x9e, 0xe2, 0xe9, 0x27, 0xb4, 0x31, 0x98, 0x91, 0x33, 0x64, 0x3e, 0x4, 0xbb, 0x8e, 0xc4, 0xdf, 0x3b, 0xfb, 0x7b, 0x61, 0x6b, 0x1a, 0x4a, 0xde, 0x9, 0x71}}
+	return a, nil
+}
+
+var _migration044_claim_searchSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\xb1\x0a\xc2\x30\x14\x46\xe1\x3d\x4f\xf1\x8f\x8a\xed\x13\x38\xa5\x26\x42\x21\x38\xd8\x14\xba\x49\x48\x2e\xed\x85\x26\x2d\xf1\x0a\x3e\xbe\xb8\x39\x38\x1e\x38\xf0\xb5\x2d\x4e\x99\xe7\x1a\x84\x30\xee\x4a\xfd\xf6\x20\x41\x28\x53\x91\x8e\x66\x2e\x4a\x3b\x6f\xef\xf0\xba\x73\x16\x71\x0d\x9c\x15\x00\x68\x63\x70\x1d\x9d\xf3\x76\xf2\xe8\x6f\xc6\x4e\xe8\xd3\xfb\x71\xf9\x0e\x03\x85\x1a\x17\x1c\x84\x65\xa5\x06\x89\x9e\xb1\xf2\x2e\xbc\x95\x06\xe1\x25\xcb\x56\x8f\xe7\xff\xa2\x2d\x49\x7d\x06\x00\x6e\xc3\xec\x3f\x9b\x00\x00\x00")
+
+func migration044_claim_searchSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration044_claim_searchSql,
+		"migration/044_claim_search.sql",
+	)
+}
+
+func migration044_claim_searchSql() (*asset, error) {
+	bytes, err := migration044_claim_searchSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/044_claim_search.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf9, 0xf9, 0xc2, 0x4f, 0xd4, 0xf0, 0xea, 0x4f, 0x1e, 0xe5, 0xfc, 0xb6, 0x33, 0xc9, 0x35, 0x1c, 0x58, 0xbd, 0xc7, 0xa5, 0x98, 0x6a, 0xf2, 0x74, 0x1b, 0x78, 0x12, 0xf4, 0x79, 0x76, 0x1, 0xbd}}
+	return a, nil
+}
+
+var _migration045_repost_countSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\xcb\x83\x30\x10\x87\xf1\x3d\x9f\xe2\xbf\xbf\x08\xef\xde\x29\x36\xa7\x08\xe9\x09\x9a\xcc\x22\xf6\x2a\x42\x13\x25\xbd\x7e\xff\xd2\x4e\x1d\x3a\x3e\xcb\x8f\xa7\xaa\xf0\x97\xb6\xb5\xcc\x2a\x88\x87\x31\xdf\x3d\xea\xac\x92\x24\x6b\x2d\xeb\x96\x8d\xf5\x81\x06\x04\x5b\x7b\xc2\x72\x9f\xb7\x64\x00\xc0\x3a\x87\x73\xef\xe3\x85\x51\xe4\xd8\x1f\x3a\x2d\xfb\x33\x2b\x3a\x0e\xd4\xd2\x80\xc8\x63\xd7\x32\x39\x70\x1f\xc0\xd1\x7b\x38\x6a\x6c\xf4\x01\xff\xb0\xcd\x5b\xfc\x58\x53\x91\x9b\x14\xc9\x8b\x9c\x7e\x3f\x50\xbe\x9a\xd7\x00\x91\x44\xaf\x88\xad\x00\x00\x00")
+
+func migration045_repost_countSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration045_repost_countSql,
+		"migration/045_repost_count.sql",
+	)
+}
+
+func migration045_repost_countSql() (*asset, error) {
+	bytes, err := migration045_repost_countSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/045_repost_count.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0x85, 0x29, 0x3a, 0xaf, 0x1a, 0x21, 0x22, 0x4e, 0x96, 0x9a, 0x33, 0xc4, 0x52, 0xda, 0x72, 0xef, 0x94, 0x87, 0xec, 0x65, 0xc2, 0x8b, 0x8, 0x3d, 0xce, 0xa3, 0x8e, 0x3f, 0x1d, 0xbf, 0xac}}
+	return a, nil
+}
+
+var _migration046_short_urlSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd2\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\xe2\x42\xe6\x07\x97\x24\x96\xa4\xe6\xa6\xe6\x95\x38\xa5\xa6\x67\xe6\x71\x39\xfa\x84\xb8\x06\x29\x84\x38\x3a\xf9\xb8\x2a\x24\xe7\x24\x66\xe6\x72\x29\x28\x28\x28\x38\xba\xb8\x28\x38\xfb\xfb\x84\xfa\xfa\x29\x14\x67\xe4\x17\x95\xc4\x97\x16\xe5\x28\x84\x39\x06\x39\x7b\x38\x06\x69\x18\x1a\x98\x1b\x69\x2a\xf8\x85\xfa\xf8\x28\x38\xba\x81\x74\x27\xe4\x25\xe6\xa6\x26\x58\x63\xb7\xc6\x35\x2f\x85\x0b\x30\x00\xa6\xc4\xbc\x20\x90\x00\x00\x00")
+
+func migration046_short_urlSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration046_short_urlSql,
+		"migration/046_short_url.sql",
+	)
+}
+
+func migration046_short_urlSql() (*asset, error) {
+	bytes, err := migration046_short_urlSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/046_short_url.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x12, 0x8b, 0x75, 0x89, 0x88, 0xb1, 0x8b, 0x16, 0xa7, 0xcc, 0xef, 0x24, 0x47, 0x88, 0x45, 0x4a, 0x4c, 0x33, 0x84, 0x84, 0x75, 0x4d, 0x17, 0xa5, 0xa0, 0x1a, 0x66, 0x48, 0x12, 0x84, 0xcb, 0x4c}}
+	return a, nil
+}
+
+var _migration047_channel_statsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x41\x8f\x9b\x30\x10\x85\xef\xfc\x8a\xb9\x85\xa8\xbb\xd2\x1e\x52\x29\x52\x95\x83\x03\xb3\x59\x37\x60\x52\x63\xda\x6e\x2f\x96\x17\x9c\xc4\x12\x18\x04\xa6\xea\xcf\xaf\x48\xd8\x74\xdb\xa4\xd2\x72\xb3\x87\xf7\xcd\xbc\x11\x3c\xee\xef\xe1\x43\x65\x0e\xad\x72\x1a\xb2\xc6\xf3\xde\xde\x53\xa7\x9c\xae\xb4\x75\x6b\x7d\x30\xd6\x0b\x38\x12\x81\x20\xc8\x3a\x42\xc8\x8f\xca\x5a\x5d\xca\xce\x29\xd7\x79\xbe\x07\x00\x60\x0a\x48\x91\x53\x12\xdd\x9d\xae\xaf\x92\xbc\x54\xa6\x92\xa6\x80\xaf\x84\x07\x4f\x84\xfb\x8b\x87\x39\x0c\x07\x12\x08\xe4\x90\xa2\x80\x59\xef\xf6\xcb\xea\x65\x31\x83\x20\x89\xa2\x61\xca\x6b\x45\xf6\xd6\xe4\x75\xa1\x65\x6e\x66\xc0\x12\x01\x2c\x8b\xc6\xfe\x9d\x6b\xb5\xaa\x64\x5e\xf7\xd6\x01\x65\x02\x37\xc8\x21\x63\x29\xdd\x30\x0c\x2f\x5a\x08\xf1\x91\x64\x91\x80\x87\xd1\x55\x5d\x96\x3a\x77\xa6\xb6\x53\xc9\x56\x37\x75\xe7\xa6\x52\x5d\xdf\x34\x75\xeb\xa4\xaa\x4e\x5c\x98\x64\xeb\x08\xfd\x8f\xcb\xbb\xe5\xfc\xbf\x8c\x33\xcd\x24\x7d\xd3\xb7\xf9\x51\x75\x7a\xaa\xb7\x0b\xd7\xea\x9f\xda\xf6\xfa\x7d\xd3\xf6\xa6\xed\x9c\x6c\xfa\x97\xd2\x74\x47\x79\xd4\xe6\x70\xbc\x35\xf3\xf2\x9e\x4a\x35\x49\xee\xd4\xa1\x83\xcf\x69\xc2\xc6\xda\xa9\x98\xb7\x5a\x39\x5d\x48\xe5\x20\x24\x02\x05\x8d\xf1\xda\x5f\x90\x71\x8e\x4c\xc8\xe1\x69\x2a\x48\xbc\x3b\xfb\xad\xea\xc2\xec\xcd\x64\x18\x12\x06\xd9\x6e\x00\x6e\x35\x3e\x75\xde\x71\x1a\x13\xfe\x0c\x5b\x7c\x86\xdd\x56\x06\xe7\x0f\x7e\x88\x4d\x07\xbe\x29\xe6\xe7\xf9\x19\xa3\x5f\x32\x3c\x89\x68\xf1\xeb\x2f\xd5\x78\x06\xff\xdf\xac\x8c\x28\x65\x21\x7e\xbf\xa2\xe2\x71\x21\xf0\xdf\xac\x36\xf7\xe6\x80\x6c\x43\x19\xae\xa8\xb5\x75\xb8\xfe\xb3\xd9\x13\xe1\x29\x8a\xd5\x98\xa8\x4b\xc4\xae\x13\x06\x3c\xf9\x26\x1f\x13\x1e\x13\xb1\x0a\x92\x78\xc7\x31\x4d\x31\x1c\x9c\xcb\x75\x94\x04\x5b\x99\xd2\x1f\xb8\x5a\x7c\xba\xfd\x97\x40\x5b\x78\xbf\x07\x00\x17\x9e\xb1\xba\x4f\x04\x00\x00")
+
+func migration047_channel_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration047_channel_statsSql,
+		"migration/047_channel_stats.sql",
+	)
+}
+
+func migration047_channel_statsSql() (*asset, error) {
+	bytes, err := migration047_channel_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/047_channel_stats.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xab, 0x6e, 0x17, 0x95, 0x19, 0xdd, 0x66, 0x3f, 0x69, 0x6d, 0x64, 0x3c, 0x64, 0x60, 0x54, 0x71, 0x46, 0xf6, 0x60, 0xde, 0x71, 0x29, 0xd6, 0xed, 0x64, 0xd6, 0x17, 0xee, 0x43, 0xdd, 0x73, 0x72}}
+	return a, nil
+}
+
+var _migration048_chain_statsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x95\xcf\x6e\xdb\x3c\x10\xc4\xef\x7a\x8a\x3d\xda\xf8\x12\x20\x01\xf2\x01\x01\x0a\x1f\x68\x69\xe3\x10\x91\x29\x97\xa2\xda\xa6\x17\x82\x11\x69\x9b\xa8\x4c\x09\x14\xdd\xf4\xf1\x0b\xca\x69\xfe\x35\x29\x2a\xf7\xe6\x85\xe7\x37\xa3\xa5\x88\xd1\xe9\x29\xfc\xb7\xb3\x1b\xaf\x82\x81\xaa\x4b\x92\xe7\x73\x19\x54\x30\x3b\xe3\xc2\xdc\x6c\xac\x4b\x52\x8e\x44\x20\x08\x32\xcf\x11\xea\xad\xb2\x4e\xf6\x41\x85\x3e\x99\x24\x00\x00\x56\x43\x89\x9c\x92\xfc\x64\x18\x3b\xe3\x6d\xab\xe1\x13\xe1\xe9\x35\xe1\x93\xf3\xb3\x29\xc4\x1f\x24\x15\xc8\xa1\x44\x01\x8d\x0a\xd6\x9d\x43\x5a\xe4\x79\xb4\x3d\x8c\x72\x63\x9c\xf1\xaa\x91\xb5\x05\x56\x08\x60\x55\xfe\xc2\x2f\x26\xfa\x00\x73\xba\xa0\x4c\x40\xc5\x4a\xba\x60\x98\xbd\x92\xae\xad\xef\x83\xdc\x1a\xbb\xd9\x06\xa0\x4c\xe0\x02\xf9\x7b\xda\x46\xfd\xb5\xf4\xae\x69\xeb\x6f\xb2\x6e\xf7\xee\x0f\x52\xc8\xf0\x8a\x54\xb9\x80\xb3\x83\x7f\xf0\xca\xf5\xaa\x0e\xb6\x75\x63\xd1\xb5\x31\x52\xed\x06\x26\x2b\xaa\x79\x8e\x93\xff\x2f\x4f\x2e\xa7\xef\xea\x9d\xb9\x97\x4a\x6b\x6f\xfa\x7e\x6c\x54\x44\xfb\xe0\x8d\xda\x1d\x43\xd6\x5b\xe5\x9c\x69\x8e\x42\xdb\xa6\x31\x47\x9d\x4e\xa4\xbd\xe9\xda\x3e\x8c\x25\xfb\x7d\xd7\xb5\x3e\xc4\xb3\x32\xfa\xdf\xe0\x31\xaf\xe7\x17\x79\x6f\xc3\x56\x7b\x75\xef\x8e\x8d\x7e\x32\x18\x13\xdf\xed\x7d\xbd\x55\xbd\x19\x9b\xfa\xc8\x8d\x09\x8b\x17\xfe\xbb\x91\x75\xa3\xec\xa8\x1b\x35\xec\x59\x7b\xa3\x82\xd1\x52\x05\xc8\x88\x40\x41\x97\xf8\xbb\x38\xad\x38\x47\x26\x64\xfc\xb7\x14\x64\xb9\x3a\x04\xef\x5a\x6d\xd7\x76\x34\x0c\x05\x83\x6a\x15\x81\xb7\x8c\x07\xe7\x15\xa7\x4b\xc2\x6f\xe1\x06\x6f\x61\x75\x23\xd3\xd8\x7f\xb1\x20\x7b\x98\x58\x3d\x3d\xa4\x57\x8c\x7e\xac\x70\x90\x50\xfd\xe3\x99\x66\x35\x94\x17\x4c\x0e\xa5\x78\xf2\xa2\xcc\x1e\x58\xca\x32\xfc\xf2\x0a\x5b\x3e\x6c\x03\x93\x67\x7b\x4d\x93\x29\x20\x5b\x50\x86\x33\xea\x5c\x9b\xcd\x9f\xd6\xba\x26\xbc\x44\x31\xdb\x87\xf5\xe5\xee\xee\xe2\xb1\x5a\x1f\x66\xb9\x77\xb6\x6e\xb5\x89\xdd\xca\x8b\xcf\xf2\xaa\xe0\x4b\x22\x66\x69\xb1\x5c\x71\x2c\x4b\xcc\xe2\x83\xcb\x79\x5e\xa4\x37\xb2\xa4\x5f\x71\x76\xf1\xe1\xed\xcf\x01\x3a\x9d\xfc\x1c\x00\x0c\xcb\xc3\xe9\x38\x06\x00\x00")
+
+func migration048_chain_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration048_chain_statsSql,
+		"migration/048_chain_stats.sql",
+	)
+}
+
+func migration048_chain_statsSql() (*asset, error) {
+	bytes, err := migration048_chain_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/048_chain_stats.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5f, 0xc, 0xae, 0x7, 0x75, 0x4d, 0xbd, 0x5b, 0x6e, 0x31, 0xdc, 0xc8, 0x45, 0xb7, 0xf4, 0xd6, 0x34, 0x77, 0x46, 0x39, 0xeb, 0x83, 0x6a, 0xa8, 0x6, 0xed, 0xd5, 0x5a, 0x52, 0x3f, 0x95, 0xda}}
+	return a, nil
+}
+
+var _migration049_address_labelSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x53\x5d\x6f\xd3\x30\x14\x7d\xcf\xaf\xb8\x6f\x4b\x05\x93\x00\x75\x12\x12\xea\x83\xeb\xdc\x76\x56\x13\xa7\xd8\x0e\x30\x5e\xac\x2c\xf6\x2a\x4b\x6d\x8a\x52\x57\x82\x7f\x8f\x92\xb8\x59\xc9\x46\xa5\x21\x1e\xe0\x29\xb6\x73\xee\xb9\x1f\xe7\xdc\xeb\x6b\x78\xb5\x73\x9b\xa6\xf4\x16\x8a\x6f\x51\x74\x7e\x97\xbe\xf4\x76\x67\x6b\x3f\xb7\x1b\x57\x47\x54\x20\x51\x08\x8a\xcc\x53\x84\xd2\x98\xc6\x1e\x0e\x7a\x5b\xde\xdb\x6d\x14\x47\x00\x00\xce\x80\x44\xc1\x48\xfa\xba\xbb\x06\x08\x7c\x22\x82\xde\x12\x11\x4f\xdf\x4c\xa0\x3d\x10\xaa\x50\x80\x44\x05\xdb\xd2\xbb\xfa\x2d\xd0\x3c\x4d\x5b\xe6\xfe\xaa\x37\xb6\xb6\x4d\xb9\xd5\x95\x03\x9e\x2b\xe0\x45\x1a\x08\xbb\x5c\x03\xdd\xbb\x9b\x9b\x31\xdf\xd5\xd1\x3f\xbc\xdf\xdd\x4f\xaf\x06\xca\xd3\x8b\x3e\xd6\xae\xda\x1b\xab\x2b\x77\x35\x62\xad\x4a\x6f\x37\xfb\xe6\xc7\x85\x3a\xff\x88\xf7\xb0\x3f\x36\x95\xfd\x1b\xe5\x76\x94\x1d\x67\xd5\xd8\xd2\x5b\xa3\x4b\x0f\x09\x51\xa8\x58\x86\x43\x56\x48\x70\x41\x8a\x54\x01\x2d\x84\x40\xae\x74\xfb\x57\x2a\x92\xad\xfb\xe9\xed\xf6\xc6\x3d\xb8\x17\x07\x43\xce\xa1\x58\xb7\x01\xcf\x11\x77\xcc\x6b\xc1\x32\x22\xee\x60\x85\x77\xb0\x5e\x69\xd2\xeb\x9e\x76\x6a\xc5\xce\x4c\xfa\xfc\x05\x67\x1f\x0b\xec\x40\xcc\x7c\xff\x05\x15\xce\x10\x07\xcb\x84\x08\xc6\x13\xfc\xf2\x04\x4c\x4f\x7a\xc5\x27\xe5\x2e\xc2\xb3\xd0\x36\xc4\x67\x03\x98\x44\x13\x40\xbe\x64\x1c\x67\xac\xae\xf7\xc9\xfc\xb1\xff\x5b\x22\x24\xaa\x59\x10\x62\x50\xe6\xa9\x30\x20\xf2\xcf\x7a\x91\x8b\x8c\xa8\x19\xcd\xb3\xb5\x40\x29\x31\x69\xfb\xd3\xf3\x34\xa7\x2b\x2d\xd9\x57\x9c\x4d\x3f\x3c\xbf\x52\x58\x9b\x97\x2f\x5b\xb5\x3d\x1e\xbc\x6d\xc2\xba\x9d\x5e\x9d\x81\x39\x5b\x32\xae\xa0\xe0\x92\x2d\x39\x26\x63\x87\xf7\x61\x97\x81\xff\x9d\xbf\x68\xdf\xd5\x60\x1a\x3d\x38\x6d\x91\x0b\x64\x4b\xde\xe1\x17\x63\xfc\xd8\x6b\x6d\x18\x08\x5c\xa0\x40\x4e\x51\x9e\x66\xdd\x19\xb7\xad\x2d\xc1\x14\xdb\xda\x88\xa4\x24\xc1\xb3\x6a\x79\x0e\x84\x2a\x96\xf3\xdf\xb8\x2f\xe4\x0b\x1f\x88\x1f\x55\xf8\x37\xdc\xf7\x73\x00\x95\x01\x61\x3a\xfa\x05\x00\x00")
+
+func migration049_address_labelSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration049_address_labelSql,
+		"migration/049_address_label.sql",
+	)
+}
+
+func migration049_address_labelSql() (*asset, error) {
+	bytes, err := migration049_address_labelSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/049_address_label.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb1, 0xcb, 0xbc, 0xd0, 0xc6, 0x29, 0x40, 0x60, 0x75, 0xc3, 0x7a, 0x6d, 0x94, 0x4f, 0x5a, 0xd, 0x7e, 0xc5, 0xc3, 0x81, 0xb2, 0xf9, 0x1c, 0xfa, 0x3, 0x7e, 0x42, 0x2, 0xf4, 0xdf, 0xd5, 0x5e}}
+	return a, nil
+}
+
+var _migration050_notification_outboxSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\x51\x6f\x9b\x30\x14\x85\xdf\xf9\x15\xf7\xad\xa0\xa5\x52\x5b\x6d\xda\xa4\xa9\x0f\x0e\xdc\xa4\x56\xc1\x44\xc6\x4c\xed\x13\x72\xb0\x93\x5a\x4a\x4c\x04\xce\x94\xfe\xfb\x09\xc8\x02\x4a\xa3\xa9\xd9\x1b\x97\xeb\xf3\xf9\x98\x73\xcd\xed\x2d\x7c\xd9\x9a\x75\x2d\x9d\x86\x7c\xe7\x79\xe3\x3a\x73\xd2\xe9\xad\xb6\x6e\xaa\xd7\xc6\x7a\x21\x47\x22\x10\x04\x99\xc6\x08\xb6\x72\x66\x65\x4a\xe9\x4c\x65\x8b\x6a\xef\x96\xd5\xc1\xf3\x3d\x00\x00\xa3\x20\x43\x4e\x49\x3c\xe9\xca\x66\xbf\x6c\xca\xda\x2c\x75\x0d\xbf\x08\x0f\x9f\x08\xf7\xbf\xdd\x3f\x04\xd0\x3e\x91\x50\x20\x87\x0c\x05\x6c\xa4\x33\xf6\x1e\xc2\x34\x8e\xdb\x3d\xfa\xb2\x58\x6b\xab\x6b\xb9\x29\x4a\x03\x2c\x15\xc0\xf2\xf8\x08\x75\xef\x3b\x3d\xe0\xee\x82\xb3\xf6\x4e\xbe\x6f\x2a\xa9\x20\xc1\x88\xe6\x89\xc0\x17\x71\xae\xaf\xa5\x6d\x64\xd9\x99\x7f\x93\xcd\xdb\x89\xf5\xfd\xee\x5a\x67\xc7\x53\x3a\xe9\xf6\xcd\x09\xf3\x70\x35\xe6\x64\x10\x22\x9c\x91\x3c\x16\x70\xb3\xd3\x56\x19\xbb\xbe\xe9\x77\x90\xce\xe9\xed\xce\x35\x40\x99\xc0\x39\x72\xc8\x59\x46\xe7\x0c\xa3\x8f\xca\xbb\x5e\x61\xf5\xc1\x15\x47\x59\x21\x1d\x44\x44\xa0\xa0\x09\x7e\x14\x84\x39\xe7\xc8\x44\xd1\x76\x33\x41\x92\x45\x0f\xd8\xc8\xc6\x15\xba\xae\xab\x1a\xda\x6f\xd8\xbf\x54\x7a\x63\x7e\xeb\x5a\xab\x31\x72\xe2\x75\xbd\xb2\xd6\xd2\x69\xf5\x5f\x9b\x6d\x2b\x65\x56\xe6\x6a\x31\xa4\x0c\xf2\x45\x2b\xb8\x04\xee\xc8\x0b\x4e\x13\xc2\x5f\xe1\x19\x5f\x61\xf1\x5c\xb0\xd1\xe4\xa6\xdd\xe0\x82\x6f\x54\xd0\x9f\x6e\x96\x72\xa4\x73\xd6\xad\x9d\x5d\x5a\x2b\x86\xc9\x01\xff\x7c\x8c\x02\xe0\x38\x43\x8e\x2c\xc4\x6c\x3c\x63\xe0\xf7\xdd\x94\x41\x84\x31\xb6\x5e\x49\x16\x92\x08\x47\xee\x59\x0a\x24\x14\x34\x65\xbd\x11\xca\x22\x7c\x01\xaa\x0e\x17\x3c\x64\xc3\x95\xf2\xfb\xc1\x9b\x8c\xae\xd9\x04\x4e\xa7\xf9\x17\x24\xfa\x9b\xe3\xc0\x18\x47\xfb\x09\x42\xd8\xa7\x0d\xfe\x10\xfb\x27\x54\xc9\x31\x67\xf0\x47\x89\x07\x5e\x00\xc8\xe6\x94\xe1\x23\xb5\xb6\x8a\xa6\x43\xe0\x4f\x84\x67\x28\x1e\xf7\x6e\xf5\x63\xbb\xfc\x7a\xba\x40\xc7\xba\xd8\x5b\x53\x56\x4a\x17\xa5\xf9\x79\xf9\xdf\x85\x56\x79\x7f\x06\x00\xc4\xed\x48\xa3\xe5\x04\x00\x00")
+
+func migration050_notification_outboxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration050_notification_outboxSql,
+		"migration/050_notification_outbox.sql",
+	)
+}
+
+func migration050_notification_outboxSql() (*asset, error) {
+	bytes, err := migration050_notification_outboxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/050_notification_outbox.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x1e, 0xe6, 0x6a, 0x21, 0x35, 0x73, 0x6a, 0xa8, 0x95, 0x31, 0xb6, 0x57, 0x96, 0xf5, 0xb, 0xde, 0x32, 0x7, 0x57, 0x6c, 0x77, 0x56, 0xcc, 0x6e, 0x31, 0xc7, 0xb5, 0x53, 0xee, 0xb0, 0x59}}
+	return a, nil
+}
+
+var _migration051_notification_event_idSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcf\xbf\x4a\x03\x41\x10\x06\xf0\x7e\x9f\xe2\xeb\x92\xa0\x29\x04\xb1\xb1\xda\xdc\x4d\x30\xb0\xee\x41\xb2\x07\x76\xcb\x9a\x1b\x8f\x81\x64\x4e\xc2\x28\x79\x7c\xd9\xa0\x92\x22\xdd\x7c\x30\x7f\x7e\xb3\x5c\xe2\xee\x28\xe3\xa9\x18\xa3\xff\x74\xee\x3a\xef\xac\x18\x1f\x59\x6d\xc5\xa3\xa8\xf3\x21\xd1\x16\xc9\xaf\x02\x41\x27\x93\x0f\xd9\x17\x93\x49\xf3\xf4\x65\xef\xd3\xd9\x01\x80\x6f\x5b\x34\x5d\xe8\x5f\x23\xf8\x9b\xd5\xb2\x0c\x68\x5e\xfc\x76\xfe\xf4\xb8\xb8\x14\xbe\xa9\x4b\x76\x94\x70\x28\x26\xfa\x50\xbb\x83\x4f\xf4\x1b\xf3\xc8\xca\xa7\x72\xc8\x7b\x41\xec\x12\x62\x1f\x02\x5a\x5a\xfb\x3e\x24\xcc\x66\xf0\xeb\x3a\x2e\xc3\xfd\xff\xb5\x4d\x6c\xe9\x0d\x9b\xe1\x9c\xe3\x95\xa9\xbb\x90\xa8\x12\x30\xff\x93\x2c\x9e\x6f\xbf\x47\x3a\xb8\x9f\x01\x00\xa4\x68\x63\xbf\x08\x01\x00\x00")
+
+func migration051_notification_event_idSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration051_notification_event_idSql,
+		"migration/051_notification_event_id.sql",
+	)
+}
+
+func migration051_notification_event_idSql() (*asset, error) {
+	bytes, err := migration051_notification_event_idSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/051_notification_event_id.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0xd7, 0x39, 0x55, 0x7d, 0xf4, 0xb8, 0xe8, 0xcc, 0x63, 0xa7, 0x44, 0x32, 0xe2, 0xad, 0xe4, 0x76, 0xd4, 0x5a, 0x46, 0xc3, 0xe3, 0xd3, 0xc1, 0xac, 0xd0, 0x96, 0xd1, 0x7f, 0x5, 0xb8, 0xdc}}
+	return a, nil
+}
+
+var _migration052_notification_confirmationsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd2\xb1\x6e\xf2\x30\x14\x05\xe0\x3d\x4f\x71\x46\xd0\x0f\x12\x4c\xff\xd0\xc9\x10\x43\x23\xb9\x4e\x95\x38\x55\x3b\x45\x4e\xe2\x26\x56\xc1\x41\xce\xa5\xe2\xf1\xab\x04\xda\xa2\x96\x74\xf3\x1d\xee\x77\xac\xa3\x3b\x9f\xe3\xdf\xde\xd6\x5e\x93\x41\x76\x08\x82\xeb\x39\x25\x4d\x66\x6f\x1c\xad\x4c\x6d\x5d\xc0\x84\xe2\x09\x14\x5b\x09\x0e\xd7\x92\x7d\xb5\xa5\x26\xdb\xba\xbc\x3d\x52\xd1\x9e\x02\x00\x60\x61\x88\x75\x2c\xb2\x07\x89\xca\x1c\xa8\x41\x24\x15\xdf\xf2\x04\x99\x4c\xa3\xad\xe4\x21\x64\xac\x20\x33\x21\x10\xf2\x0d\xcb\x84\xc2\x02\x6c\xd3\xc3\xe4\xb5\xeb\x74\x39\x88\x8d\xee\x9a\xd9\x4f\xd0\x9b\x77\xe3\xc9\x54\x50\x91\x7c\x89\xa4\x9a\x2c\xa7\xe3\xda\x90\x3e\x4a\xe4\xc5\xae\x2d\xdf\x86\x18\x3c\xb1\x64\x7d\xcf\x92\xc9\xff\xc5\x14\xfd\x83\xad\xfb\xfd\x94\x2b\xec\x34\x59\xb7\xec\xe3\x05\x53\xfc\x32\xe6\xb5\x71\xc6\xeb\x5d\x5e\xda\x73\xf2\x39\xef\x53\x1e\x8f\x6c\x8c\xad\x1b\xba\x51\xc8\x6f\xe4\xea\x7b\xdf\x5e\x24\x43\xfe\x8c\xa8\x3a\xe5\xf2\xaa\xfc\x78\xe8\x5e\x68\x67\x30\xe9\x48\xd3\xb1\x9b\xa1\x3b\x16\x5d\xe9\x6d\x61\xfc\xec\xd2\x03\x6c\x35\x3d\x4b\x61\x12\x3f\xfe\x49\xa5\x5f\xcb\x77\xb7\x8f\x81\xbb\x2a\xf8\x18\x00\x0d\x58\x79\x44\x36\x02\x00\x00")
+
+func migration052_notification_confirmationsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration052_notification_confirmationsSql,
+		"migration/052_notification_confirmations.sql",
+	)
+}
+
+func migration052_notification_confirmationsSql() (*asset, error) {
+	bytes, err := migration052_notification_confirmationsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/052_notification_confirmations.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0xd5, 0xbb, 0xc6, 0xd3, 0xa3, 0x11, 0x5f, 0xd8, 0xb6, 0xe0, 0xb9, 0xce, 0x95, 0x8f, 0x70, 0xdd, 0xca, 0x47, 0x41, 0x92, 0x9d, 0x40, 0xc0, 0xc5, 0x99, 0x20, 0x21, 0x74, 0xd8, 0x1e, 0xd9}}
+	return a, nil
+}
+
+var _migration053_notification_subscriberSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x4f\x8b\xdb\x30\x10\xc5\xef\xfe\x14\x73\xb4\xe9\x2e\x74\x4b\x0b\x85\xb2\x07\xc5\x9e\x76\xc5\xda\x8a\x2b\x4b\x25\x39\x09\xc5\x56\x82\xc0\x96\x83\x22\x43\xfb\xed\x8b\xff\x90\x98\x92\x96\xee\x4d\x8f\x37\xef\x8d\xf8\x49\x8f\x8f\xf0\xae\xb3\x27\xaf\x83\x01\x79\x8e\xa2\xb5\xae\x82\x0e\xa6\x33\x2e\x6c\xcc\xc9\xba\x28\xe5\x48\x04\x82\x20\x9b\x1c\xc1\xf5\xc1\x1e\x6d\xad\x83\xed\x9d\xba\x0c\x87\x4b\xed\xed\xc1\xf8\x28\x8e\x00\x00\x6c\x03\x15\x72\x4a\xf2\x87\x49\x0e\xbe\x85\x1f\x84\xa7\x2f\x84\xc7\x9f\x9e\x3e\x24\x30\x9e\x48\x2a\x90\x43\x85\x02\x5a\x1d\xac\x7b\x82\x74\x9b\xe7\xe3\x82\x59\xaa\x93\x71\xc6\xeb\x56\xd5\x16\xd8\x56\x00\x93\xf9\xd2\x16\x7e\x9d\xcd\xad\xee\x7d\xf2\x87\x7d\xd6\x5e\x77\x17\x10\xb8\x13\x2b\x67\xb2\x6a\x6f\x74\x30\x8d\xd2\x01\x32\x22\x50\xd0\x02\xaf\x23\x90\xe1\x57\x22\x73\x01\xa9\xe4\x1c\x99\x50\xa3\x5b\x09\x52\x94\xf3\xd6\xae\x6f\xec\xd1\xbe\x39\x0c\x5b\x06\xb2\x1c\x03\xf7\x8a\xa7\xe6\x92\xd3\x82\xf0\x3d\xbc\xe2\x1e\xca\x57\xc5\x56\x64\xab\x2b\x58\x88\x6d\x93\xcc\x37\x91\x8c\x7e\x97\x38\x8d\xd3\xe6\xe7\x5f\xe6\x25\xcf\xc5\xc8\x29\x1e\x7c\xfb\x30\x21\x5b\xd2\x94\x65\xb8\xfb\x47\x30\x9d\x19\x41\x7c\x83\xf5\x9f\xc9\x62\x21\x04\xf1\x8a\x55\x12\x25\x80\xec\x1b\x65\xf8\x4c\x9d\xeb\xb3\xcd\x0d\xd5\x0b\xe1\x15\x8a\xe7\x21\x1c\x3f\x77\x87\x8f\xd7\xe7\x5f\xb4\x1a\x9c\xad\xfb\xc6\xa8\xda\x7e\xb9\xff\x2b\xd1\x35\xd1\xef\x01\x00\xc8\x69\xd4\xb2\xbf\x02\x00\x00")
+
+func migration053_notification_subscriberSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration053_notification_subscriberSql,
+		"migration/053_notification_subscriber.sql",
+	)
+}
+
+func migration053_notification_subscriberSql() (*asset, error) {
+	bytes, err := migration053_notification_subscriberSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/053_notification_subscriber.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x49, 0xf2, 0xab, 0x7f, 0xc0, 0x17, 0x16, 0x4, 0x6f, 0xd4, 0xb9, 0xa, 0x7f, 0x30, 0x85, 0xcd, 0xff, 0x45, 0x0, 0x36, 0x11, 0x63, 0xfa, 0x65, 0xdf, 0xaf, 0xde, 0xd0, 0xea, 0xed, 0x2c, 0xb1}}
+	return a, nil
+}
+
+var _migration054_job_status_failuresSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\x31\xcb\x83\x30\x10\x87\xf1\x3d\x9f\xe2\xbf\xbf\x08\xef\xde\x29\x36\xa7\x08\xe9\x09\x9a\xcc\x92\xd2\xab\x58\xaa\x96\xe4\xfc\xfe\xa5\x5b\x87\x8e\xcf\xf2\xfc\xaa\x0a\x7f\xeb\x32\xe7\xa4\x82\xf8\x32\xe6\xbb\x47\x4d\x2a\xab\x6c\x5a\xcb\xbc\x6c\xc6\xfa\x40\x03\x82\xad\x3d\xe1\xb1\x5f\xa7\xa2\x49\x8f\x02\xeb\x1c\xce\xbd\x8f\x17\xc6\x3d\x2d\xcf\x23\x4b\x41\xc7\x81\x5a\x1a\x10\x79\xec\x5a\x26\x07\xee\x03\x38\x7a\x0f\x47\x8d\x8d\x3e\xe0\x1f\xb6\xf9\xec\x24\xe7\x3d\x4f\xab\x94\x92\x66\x39\xfd\xe6\x69\xbb\x99\xf7\x00\x56\x81\x47\x84\xa8\x00\x00\x00")
+
+func migration054_job_status_failuresSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration054_job_status_failuresSql,
+		"migration/054_job_status_failures.sql",
+	)
+}
+
+func migration054_job_status_failuresSql() (*asset, error) {
+	bytes, err := migration054_job_status_failuresSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/054_job_status_failures.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x92, 0xa6, 0xa3, 0x7, 0x4b, 0x2f, 0xa, 0x67, 0xcd, 0x3d, 0xa6, 0x44, 0xb3, 0xc1, 0x2a, 0x33, 0x82, 0x58, 0xdc, 0xcb, 0x4b, 0xce, 0xd1, 0xe6, 0x67, 0x9a, 0x30, 0x2c, 0x9, 0x2, 0x9d, 0xa2}}
+	return a, nil
+}
+
+var _migration055_change_outboxSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x51\x6f\x9b\x3e\x14\xc5\xdf\xf9\x14\xf7\xad\x44\xff\x54\x6a\xff\x9a\xb4\x49\x53\x1f\x1c\x72\x97\x5a\x25\x0e\x32\x66\x6a\x9e\x2c\x07\xbb\x60\x0d\x4c\x04\x66\xca\xbe\xfd\x04\x64\x49\x96\x56\x9b\xd6\x37\xae\x7c\xee\xcf\xf7\x1e\x8e\x6f\x6f\xe1\xbf\xda\x16\xad\xf2\x06\xb2\x7d\x10\x5c\xd6\xa9\x57\xde\xd4\xc6\xf9\x85\x29\xac\x0b\x22\x8e\x44\x20\x08\xb2\x88\x11\xf2\x52\xb9\xc2\xc8\xa6\xf7\xbb\xe6\x10\x84\x01\x00\x80\xd5\x90\x22\xa7\x24\x9e\x8f\xe5\xae\x6a\xf2\x6f\xb2\x54\x5d\x09\x5f\x09\x8f\x1e\x09\x0f\x3f\xde\xcd\x60\xf8\x20\x91\x40\x0e\x29\x0a\xa8\x94\xb7\xee\x1e\xa2\x4d\x1c\x0f\xf0\xa9\x94\x85\x71\xa6\x55\x95\xcc\x2d\xb0\x8d\x00\x96\xc5\x47\x66\x69\x6c\x51\x7a\x58\xd0\x15\x65\x02\x32\x96\xd2\x15\xc3\xe5\x95\xa8\x35\xdf\x4d\xeb\x8d\x06\x41\xd9\x96\x32\x11\xde\xcf\x4e\x0a\x58\xe2\x17\x92\xc5\x02\xee\x26\xe0\x5e\xfd\xa8\x1a\xa5\x21\xde\xb0\x95\xc0\x67\x71\x85\xea\xbc\xf2\x7d\x77\x9a\xff\xff\xf7\xcf\x7f\xba\xf8\x66\x6f\x9c\xb6\xae\xb8\x99\x06\x50\xde\x9b\x7a\xef\x3b\xa0\x4c\xe0\x0a\xf9\xeb\xa5\xae\x47\x76\xe6\xe0\xe5\xb1\x4d\x2a\x0f\x4b\x22\x50\xd0\x35\xbe\x6e\x88\x32\xce\x91\x09\x39\x9c\xa6\x82\xac\x93\x09\x50\xa9\xce\x4b\xd3\xb6\x4d\x0b\xc3\xca\x47\x23\xfa\x5d\x65\xbb\xd2\xe8\x4b\xe4\x3c\x18\xcf\xf2\xd6\x28\x6f\xf4\xbb\x2e\xab\x1b\x6d\x5f\xec\x3f\x37\xc3\x86\x41\x96\x0c\x0d\x6f\x81\x47\x72\xc2\xe9\x9a\xf0\x2d\x3c\xe1\x16\x92\x27\x19\x8d\x89\xdc\x8c\x81\x84\xd0\xea\xd9\xb4\x17\x65\x4b\x7c\x06\xaa\x0f\xbf\x09\x86\x68\xf7\x1d\x84\xd3\xff\x9d\xc3\x5f\xe4\x8b\x21\xca\x10\x9e\x13\xfd\x47\x75\xf2\xcb\xca\x33\xff\xd2\xdd\x59\x30\x03\x64\x2b\xca\xf0\x81\x3a\xd7\x2c\x17\x67\x17\x1e\x09\x4f\x51\x3c\xf4\xfe\xe5\x53\xbd\xfb\x70\x4a\xd5\xb1\x96\xbd\xb3\x79\xa3\x8d\xcc\xed\xe7\xb7\x1f\x2a\x3a\x1d\xfc\x1c\x00\x1f\x0a\x36\xcb\xd2\x03\x00\x00")
+
+func migration055_change_outboxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migration055_change_outboxSql,
+		"migration/055_change_outbox.sql",
+	)
+}
+
+func migration055_change_outboxSql() (*asset, error) {
+	bytes, err := migration055_change_outboxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migration/055_change_outbox.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9f, 0x5e, 0x83, 0x35, 0xad, 0x5d, 0x72, 0x13, 0xe9, 0x6e, 0x74, 0x89, 0x82, 0xb3, 0x8, 0x3a, 0x73, 0x1a, 0x32, 0x67, 0xd5, 0x70, 0x5b, 0x75, 0x26, 0xf9, 0x9f, 0xad, 0xf7, 0xd1, 0xb6, 0x72}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -994,6 +1393,25 @@ var _bindata = map[string]func() (*asset, error){
 	"migration/034_support_uniq_index.sql":            migration034_support_uniq_indexSql,
 	"migration/035_add_tx_count.sql":                  migration035_add_tx_countSql,
 	"migration/036_add_block_processing_state.sql":    migration036_add_block_processing_stateSql,
+	"migration/037_claim_version.sql":                 migration037_claim_versionSql,
+	"migration/038_support_history.sql":               migration038_support_historySql,
+	"migration/039_claimtrie_state.sql":               migration039_claimtrie_stateSql,
+	"migration/040_takeover.sql":                      migration040_takeoverSql,
+	"migration/041_purchase_payment.sql":              migration041_purchase_paymentSql,
+	"migration/042_claim_in_list_position.sql":        migration042_claim_in_list_positionSql,
+	"migration/043_abnormal_claim_failure.sql":        migration043_abnormal_claim_failureSql,
+	"migration/044_claim_search.sql":                  migration044_claim_searchSql,
+	"migration/045_repost_count.sql":                  migration045_repost_countSql,
+	"migration/046_short_url.sql":                     migration046_short_urlSql,
+	"migration/047_channel_stats.sql":                 migration047_channel_statsSql,
+	"migration/048_chain_stats.sql":                   migration048_chain_statsSql,
+	"migration/049_address_label.sql":                 migration049_address_labelSql,
+	"migration/050_notification_outbox.sql":           migration050_notification_outboxSql,
+	"migration/051_notification_event_id.sql":         migration051_notification_event_idSql,
+	"migration/052_notification_confirmations.sql":    migration052_notification_confirmationsSql,
+	"migration/053_notification_subscriber.sql":       migration053_notification_subscriberSql,
+	"migration/054_job_status_failures.sql":           migration054_job_status_failuresSql,
+	"migration/055_change_outbox.sql":                 migration055_change_outboxSql,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -1081,6 +1499,25 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"034_support_uniq_index.sql":            {migration034_support_uniq_indexSql, map[string]*bintree{}},
 		"035_add_tx_count.sql":                  {migration035_add_tx_countSql, map[string]*bintree{}},
 		"036_add_block_processing_state.sql":    {migration036_add_block_processing_stateSql, map[string]*bintree{}},
+		"037_claim_version.sql":                 {migration037_claim_versionSql, map[string]*bintree{}},
+		"038_support_history.sql":               {migration038_support_historySql, map[string]*bintree{}},
+		"039_claimtrie_state.sql":               {migration039_claimtrie_stateSql, map[string]*bintree{}},
+		"040_takeover.sql":                      {migration040_takeoverSql, map[string]*bintree{}},
+		"041_purchase_payment.sql":              {migration041_purchase_paymentSql, map[string]*bintree{}},
+		"042_claim_in_list_position.sql":        {migration042_claim_in_list_positionSql, map[string]*bintree{}},
+		"043_abnormal_claim_failure.sql":        {migration043_abnormal_claim_failureSql, map[string]*bintree{}},
+		"044_claim_search.sql":                  {migration044_claim_searchSql, map[string]*bintree{}},
+		"045_repost_count.sql":                  {migration045_repost_countSql, map[string]*bintree{}},
+		"046_short_url.sql":                     {migration046_short_urlSql, map[string]*bintree{}},
+		"047_channel_stats.sql":                 {migration047_channel_statsSql, map[string]*bintree{}},
+		"048_chain_stats.sql":                   {migration048_chain_statsSql, map[string]*bintree{}},
+		"049_address_label.sql":                 {migration049_address_labelSql, map[string]*bintree{}},
+		"050_notification_outbox.sql":           {migration050_notification_outboxSql, map[string]*bintree{}},
+		"051_notification_event_id.sql":         {migration051_notification_event_idSql, map[string]*bintree{}},
+		"052_notification_confirmations.sql":    {migration052_notification_confirmationsSql, map[string]*bintree{}},
+		"053_notification_subscriber.sql":       {migration053_notification_subscriberSql, map[string]*bintree{}},
+		"054_job_status_failures.sql":           {migration054_job_status_failuresSql, map[string]*bintree{}},
+		"055_change_outbox.sql":                 {migration055_change_outboxSql, map[string]*bintree{}},
 	}},
 }}
 
@@ -33,6 +33,7 @@ type AbnormalClaim struct {
 	OutputID        uint64      `boil:"output_id" json:"output_id" toml:"output_id" yaml:"output_id"`
 	ValueAsHex      string      `boil:"value_as_hex" json:"value_as_hex" toml:"value_as_hex" yaml:"value_as_hex"`
 	ValueAsJSON     null.String `boil:"value_as_json" json:"value_as_json,omitempty" toml:"value_as_json" yaml:"value_as_json,omitempty"`
+	FailureReason   null.String `boil:"failure_reason" json:"failure_reason,omitempty" toml:"failure_reason" yaml:"failure_reason,omitempty"`
 	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	ModifiedAt      time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
 
@@ -51,6 +52,7 @@ var AbnormalClaimColumns = struct {
 	OutputID        string
 	ValueAsHex      string
 	ValueAsJSON     string
+	FailureReason   string
 	CreatedAt       string
 	ModifiedAt      string
 }{
@@ -64,6 +66,7 @@ var AbnormalClaimColumns = struct {
 	OutputID:        "output_id",
 	ValueAsHex:      "value_as_hex",
 	ValueAsJSON:     "value_as_json",
+	FailureReason:   "failure_reason",
 	CreatedAt:       "created_at",
 	ModifiedAt:      "modified_at",
 }
@@ -79,6 +82,7 @@ var AbnormalClaimTableColumns = struct {
 	OutputID        string
 	ValueAsHex      string
 	ValueAsJSON     string
+	FailureReason   string
 	CreatedAt       string
 	ModifiedAt      string
 }{
@@ -92,6 +96,7 @@ var AbnormalClaimTableColumns = struct {
 	OutputID:        "abnormal_claim.output_id",
 	ValueAsHex:      "abnormal_claim.value_as_hex",
 	ValueAsJSON:     "abnormal_claim.value_as_json",
+	FailureReason:   "abnormal_claim.failure_reason",
 	CreatedAt:       "abnormal_claim.created_at",
 	ModifiedAt:      "abnormal_claim.modified_at",
 }
@@ -254,6 +259,7 @@ var AbnormalClaimWhere = struct {
 	OutputID        whereHelperuint64
 	ValueAsHex      whereHelperstring
 	ValueAsJSON     whereHelpernull_String
+	FailureReason   whereHelpernull_String
 	CreatedAt       whereHelpertime_Time
 	ModifiedAt      whereHelpertime_Time
 }{
@@ -267,6 +273,7 @@ var AbnormalClaimWhere = struct {
 	OutputID:        whereHelperuint64{field: "`abnormal_claim`.`output_id`"},
 	ValueAsHex:      whereHelperstring{field: "`abnormal_claim`.`value_as_hex`"},
 	ValueAsJSON:     whereHelpernull_String{field: "`abnormal_claim`.`value_as_json`"},
+	FailureReason:   whereHelpernull_String{field: "`abnormal_claim`.`failure_reason`"},
 	CreatedAt:       whereHelpertime_Time{field: "`abnormal_claim`.`created_at`"},
 	ModifiedAt:      whereHelpertime_Time{field: "`abnormal_claim`.`modified_at`"},
 }
@@ -299,8 +306,8 @@ func (r *abnormalClaimR) GetOutput() *Output {
 type abnormalClaimL struct{}
 
 var (
-	abnormalClaimAllColumns            = []string{"id", "name", "claim_id", "is_update", "block_hash", "transaction_hash", "vout", "output_id", "value_as_hex", "value_as_json", "created_at", "modified_at"}
-	abnormalClaimColumnsWithoutDefault = []string{"name", "claim_id", "block_hash", "transaction_hash", "vout", "output_id", "value_as_hex", "value_as_json"}
+	abnormalClaimAllColumns            = []string{"id", "name", "claim_id", "is_update", "block_hash", "transaction_hash", "vout", "output_id", "value_as_hex", "value_as_json", "failure_reason", "created_at", "modified_at"}
+	abnormalClaimColumnsWithoutDefault = []string{"name", "claim_id", "block_hash", "transaction_hash", "vout", "output_id", "value_as_hex", "value_as_json", "failure_reason"}
 	abnormalClaimColumnsWithDefault    = []string{"id", "is_update", "created_at", "modified_at"}
 	abnormalClaimPrimaryKeyColumns     = []string{"id"}
 	abnormalClaimGeneratedColumns      = []string{}
@@ -139,13 +139,16 @@ var AddressWhere = struct {
 
 // AddressRels is where relationship names are stored.
 var AddressRels = struct {
+	AddressClusters      string
 	TransactionAddresses string
 }{
+	AddressClusters:      "AddressClusters",
 	TransactionAddresses: "TransactionAddresses",
 }
 
 // addressR is where relationships are stored.
 type addressR struct {
+	AddressClusters      AddressClusterSlice     `boil:"AddressClusters" json:"AddressClusters" toml:"AddressClusters" yaml:"AddressClusters"`
 	TransactionAddresses TransactionAddressSlice `boil:"TransactionAddresses" json:"TransactionAddresses" toml:"TransactionAddresses" yaml:"TransactionAddresses"`
 }
 
@@ -154,6 +157,13 @@ func (*addressR) NewStruct() *addressR {
 	return &addressR{}
 }
 
+func (r *addressR) GetAddressClusters() AddressClusterSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AddressClusters
+}
+
 func (r *addressR) GetTransactionAddresses() TransactionAddressSlice {
 	if r == nil {
 		return nil
@@ -363,6 +373,20 @@ func (q addressQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// AddressClusters retrieves all the address_cluster's AddressClusters with an executor.
+func (o *Address) AddressClusters(mods ...qm.QueryMod) addressClusterQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`address_cluster`.`address_id`=?", o.ID),
+	)
+
+	return AddressClusters(queryMods...)
+}
+
 // TransactionAddresses retrieves all the transaction_address's TransactionAddresses with an executor.
 func (o *Address) TransactionAddresses(mods ...qm.QueryMod) transactionAddressQuery {
 	var queryMods []qm.QueryMod
@@ -377,6 +401,112 @@ func (o *Address) TransactionAddresses(mods ...qm.QueryMod) transactionAddressQu
 	return TransactionAddresses(queryMods...)
 }
 
+// LoadAddressClusters allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (addressL) LoadAddressClusters(e boil.Executor, singular bool, maybeAddress interface{}, mods queries.Applicator) error {
+	var slice []*Address
+	var object *Address
+
+	if singular {
+		var ok bool
+		object, ok = maybeAddress.(*Address)
+		if !ok {
+			object = new(Address)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAddress))
+			}
+		}
+	} else {
+		s, ok := maybeAddress.(*[]*Address)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAddress))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &addressR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &addressR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`address_cluster`),
+		qm.WhereIn(`address_cluster.address_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load address_cluster")
+	}
+
+	var resultSlice []*AddressCluster
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice address_cluster")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on address_cluster")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for address_cluster")
+	}
+
+	if singular {
+		object.R.AddressClusters = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &addressClusterR{}
+			}
+			foreign.R.Address = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AddressID {
+				local.R.AddressClusters = append(local.R.AddressClusters, foreign)
+				if foreign.R == nil {
+					foreign.R = &addressClusterR{}
+				}
+				foreign.R.Address = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadTransactionAddresses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (addressL) LoadTransactionAddresses(e boil.Executor, singular bool, maybeAddress interface{}, mods queries.Applicator) error {
@@ -483,6 +613,89 @@ func (addressL) LoadTransactionAddresses(e boil.Executor, singular bool, maybeAd
 	return nil
 }
 
+// AddAddressClustersG adds the given related objects to the existing relationships
+// of the address, optionally inserting them as new records.
+// Appends related to o.R.AddressClusters.
+// Sets related.R.Address appropriately.
+// Uses the global database handle.
+func (o *Address) AddAddressClustersG(insert bool, related ...*AddressCluster) error {
+	return o.AddAddressClusters(boil.GetDB(), insert, related...)
+}
+
+// AddAddressClustersP adds the given related objects to the existing relationships
+// of the address, optionally inserting them as new records.
+// Appends related to o.R.AddressClusters.
+// Sets related.R.Address appropriately.
+// Panics on error.
+func (o *Address) AddAddressClustersP(exec boil.Executor, insert bool, related ...*AddressCluster) {
+	if err := o.AddAddressClusters(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddAddressClustersGP adds the given related objects to the existing relationships
+// of the address, optionally inserting them as new records.
+// Appends related to o.R.AddressClusters.
+// Sets related.R.Address appropriately.
+// Uses the global database handle and panics on error.
+func (o *Address) AddAddressClustersGP(insert bool, related ...*AddressCluster) {
+	if err := o.AddAddressClusters(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddAddressClusters adds the given related objects to the existing relationships
+// of the address, optionally inserting them as new records.
+// Appends related to o.R.AddressClusters.
+// Sets related.R.Address appropriately.
+func (o *Address) AddAddressClusters(exec boil.Executor, insert bool, related ...*AddressCluster) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AddressID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `address_cluster` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"address_id"}),
+				strmangle.WhereClause("`", "`", 0, addressClusterPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AddressID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AddressID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &addressR{
+			AddressClusters: related,
+		}
+	} else {
+		o.R.AddressClusters = append(o.R.AddressClusters, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &addressClusterR{
+				Address: o,
+			}
+		} else {
+			rel.R.Address = o
+		}
+	}
+	return nil
+}
+
 // AddTransactionAddressesG adds the given related objects to the existing relationships
 // of the address, optionally inserting them as new records.
 // Appends related to o.R.TransactionAddresses.
@@ -0,0 +1,1259 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AddressCluster is an object representing the database table.
+type AddressCluster struct {
+	AddressID  uint64    `boil:"address_id" json:"address_id" toml:"address_id" yaml:"address_id"`
+	ClusterID  uint64    `boil:"cluster_id" json:"cluster_id" toml:"cluster_id" yaml:"cluster_id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt time.Time `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *addressClusterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L addressClusterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AddressClusterColumns = struct {
+	AddressID  string
+	ClusterID  string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	AddressID:  "address_id",
+	ClusterID:  "cluster_id",
+	CreatedAt:  "created_at",
+	ModifiedAt: "modified_at",
+}
+
+var AddressClusterTableColumns = struct {
+	AddressID  string
+	ClusterID  string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	AddressID:  "address_cluster.address_id",
+	ClusterID:  "address_cluster.cluster_id",
+	CreatedAt:  "address_cluster.created_at",
+	ModifiedAt: "address_cluster.modified_at",
+}
+
+// Generated where
+
+var AddressClusterWhere = struct {
+	AddressID  whereHelperuint64
+	ClusterID  whereHelperuint64
+	CreatedAt  whereHelpertime_Time
+	ModifiedAt whereHelpertime_Time
+}{
+	AddressID:  whereHelperuint64{field: "`address_cluster`.`address_id`"},
+	ClusterID:  whereHelperuint64{field: "`address_cluster`.`cluster_id`"},
+	CreatedAt:  whereHelpertime_Time{field: "`address_cluster`.`created_at`"},
+	ModifiedAt: whereHelpertime_Time{field: "`address_cluster`.`modified_at`"},
+}
+
+// AddressClusterRels is where relationship names are stored.
+var AddressClusterRels = struct {
+	Address string
+}{
+	Address: "Address",
+}
+
+// addressClusterR is where relationships are stored.
+type addressClusterR struct {
+	Address *Address `boil:"Address" json:"Address" toml:"Address" yaml:"Address"`
+}
+
+// NewStruct creates a new relationship struct
+func (*addressClusterR) NewStruct() *addressClusterR {
+	return &addressClusterR{}
+}
+
+func (r *addressClusterR) GetAddress() *Address {
+	if r == nil {
+		return nil
+	}
+	return r.Address
+}
+
+// addressClusterL is where Load methods for each relationship are stored.
+type addressClusterL struct{}
+
+var (
+	addressClusterAllColumns            = []string{"address_id", "cluster_id", "created_at", "modified_at"}
+	addressClusterColumnsWithoutDefault = []string{"address_id", "cluster_id"}
+	addressClusterColumnsWithDefault    = []string{"created_at", "modified_at"}
+	addressClusterPrimaryKeyColumns     = []string{"address_id"}
+	addressClusterGeneratedColumns      = []string{}
+)
+
+type (
+	// AddressClusterSlice is an alias for a slice of pointers to AddressCluster.
+	// This should almost always be used instead of []AddressCluster.
+	AddressClusterSlice []*AddressCluster
+
+	addressClusterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	addressClusterType                 = reflect.TypeOf(&AddressCluster{})
+	addressClusterMapping              = queries.MakeStructMapping(addressClusterType)
+	addressClusterPrimaryKeyMapping, _ = queries.BindMapping(addressClusterType, addressClusterMapping, addressClusterPrimaryKeyColumns)
+	addressClusterInsertCacheMut       sync.RWMutex
+	addressClusterInsertCache          = make(map[string]insertCache)
+	addressClusterUpdateCacheMut       sync.RWMutex
+	addressClusterUpdateCache          = make(map[string]updateCache)
+	addressClusterUpsertCacheMut       sync.RWMutex
+	addressClusterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single addressCluster record from the query using the global executor.
+func (q addressClusterQuery) OneG() (*AddressCluster, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single addressCluster record from the query using the global executor, and panics on error.
+func (q addressClusterQuery) OneGP() *AddressCluster {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single addressCluster record from the query, and panics on error.
+func (q addressClusterQuery) OneP(exec boil.Executor) *AddressCluster {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single addressCluster record from the query.
+func (q addressClusterQuery) One(exec boil.Executor) (*AddressCluster, error) {
+	o := &AddressCluster{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for address_cluster")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AddressCluster records from the query using the global executor.
+func (q addressClusterQuery) AllG() (AddressClusterSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all AddressCluster records from the query using the global executor, and panics on error.
+func (q addressClusterQuery) AllGP() AddressClusterSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AddressCluster records from the query, and panics on error.
+func (q addressClusterQuery) AllP(exec boil.Executor) AddressClusterSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AddressCluster records from the query.
+func (q addressClusterQuery) All(exec boil.Executor) (AddressClusterSlice, error) {
+	var o []*AddressCluster
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to AddressCluster slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AddressCluster records in the query using the global executor
+func (q addressClusterQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all AddressCluster records in the query using the global executor, and panics on error.
+func (q addressClusterQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AddressCluster records in the query, and panics on error.
+func (q addressClusterQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AddressCluster records in the query.
+func (q addressClusterQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count address_cluster rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q addressClusterQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q addressClusterQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q addressClusterQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q addressClusterQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if address_cluster exists")
+	}
+
+	return count > 0, nil
+}
+
+// Address pointed to by the foreign key.
+func (o *AddressCluster) Address(mods ...qm.QueryMod) addressQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.AddressID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Addresses(queryMods...)
+}
+
+// LoadAddress allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (addressClusterL) LoadAddress(e boil.Executor, singular bool, maybeAddressCluster interface{}, mods queries.Applicator) error {
+	var slice []*AddressCluster
+	var object *AddressCluster
+
+	if singular {
+		var ok bool
+		object, ok = maybeAddressCluster.(*AddressCluster)
+		if !ok {
+			object = new(AddressCluster)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAddressCluster)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAddressCluster))
+			}
+		}
+	} else {
+		s, ok := maybeAddressCluster.(*[]*AddressCluster)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAddressCluster)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAddressCluster))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &addressClusterR{}
+		}
+		args[object.AddressID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &addressClusterR{}
+			}
+
+			args[obj.AddressID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`address`),
+		qm.WhereIn(`address.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Address")
+	}
+
+	var resultSlice []*Address
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Address")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for address")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for address")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Address = foreign
+		if foreign.R == nil {
+			foreign.R = &addressR{}
+		}
+		foreign.R.AddressClusters = append(foreign.R.AddressClusters, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AddressID == foreign.ID {
+				local.R.Address = foreign
+				if foreign.R == nil {
+					foreign.R = &addressR{}
+				}
+				foreign.R.AddressClusters = append(foreign.R.AddressClusters, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAddressG of the addressCluster to the related item.
+// Sets o.R.Address to related.
+// Adds o to related.R.AddressClusters.
+// Uses the global database handle.
+func (o *AddressCluster) SetAddressG(insert bool, related *Address) error {
+	return o.SetAddress(boil.GetDB(), insert, related)
+}
+
+// SetAddressP of the addressCluster to the related item.
+// Sets o.R.Address to related.
+// Adds o to related.R.AddressClusters.
+// Panics on error.
+func (o *AddressCluster) SetAddressP(exec boil.Executor, insert bool, related *Address) {
+	if err := o.SetAddress(exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetAddressGP of the addressCluster to the related item.
+// Sets o.R.Address to related.
+// Adds o to related.R.AddressClusters.
+// Uses the global database handle and panics on error.
+func (o *AddressCluster) SetAddressGP(insert bool, related *Address) {
+	if err := o.SetAddress(boil.GetDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetAddress of the addressCluster to the related item.
+// Sets o.R.Address to related.
+// Adds o to related.R.AddressClusters.
+func (o *AddressCluster) SetAddress(exec boil.Executor, insert bool, related *Address) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `address_cluster` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"address_id"}),
+		strmangle.WhereClause("`", "`", 0, addressClusterPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AddressID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AddressID = related.ID
+	if o.R == nil {
+		o.R = &addressClusterR{
+			Address: related,
+		}
+	} else {
+		o.R.Address = related
+	}
+
+	if related.R == nil {
+		related.R = &addressR{
+			AddressClusters: AddressClusterSlice{o},
+		}
+	} else {
+		related.R.AddressClusters = append(related.R.AddressClusters, o)
+	}
+
+	return nil
+}
+
+// AddressClusters retrieves all the records using an executor.
+func AddressClusters(mods ...qm.QueryMod) addressClusterQuery {
+	mods = append(mods, qm.From("`address_cluster`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`address_cluster`.*"})
+	}
+
+	return addressClusterQuery{q}
+}
+
+// FindAddressClusterG retrieves a single record by ID.
+func FindAddressClusterG(addressID uint64, selectCols ...string) (*AddressCluster, error) {
+	return FindAddressCluster(boil.GetDB(), addressID, selectCols...)
+}
+
+// FindAddressClusterP retrieves a single record by ID with an executor, and panics on error.
+func FindAddressClusterP(exec boil.Executor, addressID uint64, selectCols ...string) *AddressCluster {
+	retobj, err := FindAddressCluster(exec, addressID, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAddressClusterGP retrieves a single record by ID, and panics on error.
+func FindAddressClusterGP(addressID uint64, selectCols ...string) *AddressCluster {
+	retobj, err := FindAddressCluster(boil.GetDB(), addressID, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAddressCluster retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAddressCluster(exec boil.Executor, addressID uint64, selectCols ...string) (*AddressCluster, error) {
+	addressClusterObj := &AddressCluster{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `address_cluster` where `address_id`=?", sel,
+	)
+
+	q := queries.Raw(query, addressID)
+
+	err := q.Bind(nil, exec, addressClusterObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from address_cluster")
+	}
+
+	return addressClusterObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AddressCluster) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AddressCluster) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AddressCluster) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AddressCluster) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no address_cluster provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(addressClusterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	addressClusterInsertCacheMut.RLock()
+	cache, cached := addressClusterInsertCache[key]
+	addressClusterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			addressClusterAllColumns,
+			addressClusterColumnsWithDefault,
+			addressClusterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(addressClusterType, addressClusterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(addressClusterType, addressClusterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `address_cluster` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `address_cluster` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `address_cluster` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, addressClusterPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	_, err = exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into address_cluster")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.AddressID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for address_cluster")
+	}
+
+CacheNoHooks:
+	if !cached {
+		addressClusterInsertCacheMut.Lock()
+		addressClusterInsertCache[key] = cache
+		addressClusterInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AddressCluster record using the global executor.
+// See Update for more documentation.
+func (o *AddressCluster) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the AddressCluster, and panics on error.
+// See Update for more documentation.
+func (o *AddressCluster) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single AddressCluster record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AddressCluster) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the AddressCluster.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AddressCluster) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	addressClusterUpdateCacheMut.RLock()
+	cache, cached := addressClusterUpdateCache[key]
+	addressClusterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			addressClusterAllColumns,
+			addressClusterPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update address_cluster, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `address_cluster` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, addressClusterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(addressClusterType, addressClusterMapping, append(wl, addressClusterPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update address_cluster row")
+	}
+
+	if !cached {
+		addressClusterUpdateCacheMut.Lock()
+		addressClusterUpdateCache[key] = cache
+		addressClusterUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q addressClusterQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q addressClusterQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q addressClusterQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q addressClusterQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for address_cluster")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AddressClusterSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AddressClusterSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AddressClusterSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AddressClusterSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressClusterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `address_cluster` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressClusterPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in addressCluster slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AddressCluster) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AddressCluster) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AddressCluster) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLAddressClusterUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AddressCluster) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no address_cluster provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(addressClusterColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAddressClusterUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	addressClusterUpsertCacheMut.RLock()
+	cache, cached := addressClusterUpsertCache[key]
+	addressClusterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			addressClusterAllColumns,
+			addressClusterColumnsWithDefault,
+			addressClusterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			addressClusterAllColumns,
+			addressClusterPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert address_cluster, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(addressClusterAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`address_cluster`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `address_cluster` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(addressClusterType, addressClusterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(addressClusterType, addressClusterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	_, err = exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for address_cluster")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(addressClusterType, addressClusterMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for address_cluster")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for address_cluster")
+	}
+
+CacheNoHooks:
+	if !cached {
+		addressClusterUpsertCacheMut.Lock()
+		addressClusterUpsertCache[key] = cache
+		addressClusterUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AddressCluster record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AddressCluster) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single AddressCluster record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AddressCluster) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single AddressCluster record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AddressCluster) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single AddressCluster record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AddressCluster) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no AddressCluster provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), addressClusterPrimaryKeyMapping)
+	sql := "DELETE FROM `address_cluster` WHERE `address_id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from address_cluster")
+	}
+
+	return nil
+}
+
+func (q addressClusterQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q addressClusterQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q addressClusterQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q addressClusterQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no addressClusterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from address_cluster")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AddressClusterSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AddressClusterSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AddressClusterSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AddressClusterSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressClusterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `address_cluster` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressClusterPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from addressCluster slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AddressCluster) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no AddressCluster provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AddressCluster) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AddressCluster) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AddressCluster) Reload(exec boil.Executor) error {
+	ret, err := FindAddressCluster(exec, o.AddressID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AddressClusterSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty AddressClusterSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AddressClusterSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AddressClusterSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AddressClusterSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AddressClusterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressClusterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `address_cluster`.* FROM `address_cluster` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressClusterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in AddressClusterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AddressClusterExistsG checks if the AddressCluster row exists.
+func AddressClusterExistsG(addressID uint64) (bool, error) {
+	return AddressClusterExists(boil.GetDB(), addressID)
+}
+
+// AddressClusterExistsP checks if the AddressCluster row exists. Panics on error.
+func AddressClusterExistsP(exec boil.Executor, addressID uint64) bool {
+	e, err := AddressClusterExists(exec, addressID)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AddressClusterExistsGP checks if the AddressCluster row exists. Panics on error.
+func AddressClusterExistsGP(addressID uint64) bool {
+	e, err := AddressClusterExists(boil.GetDB(), addressID)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AddressClusterExists checks if the AddressCluster row exists.
+func AddressClusterExists(exec boil.Executor, addressID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `address_cluster` where `address_id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, addressID)
+	}
+	row := exec.QueryRow(sql, addressID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if address_cluster exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AddressCluster row exists.
+func (o *AddressCluster) Exists(exec boil.Executor) (bool, error) {
+	return AddressClusterExists(exec, o.AddressID)
+}
@@ -0,0 +1,1098 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AddressLabel is an object representing the database table.
+type AddressLabel struct {
+	ID         uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Address    string      `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Label      string      `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Category   string      `boil:"category" json:"category" toml:"category" yaml:"category"`
+	Source     null.String `boil:"source" json:"source,omitempty" toml:"source" yaml:"source,omitempty"`
+	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *addressLabelR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L addressLabelL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AddressLabelColumns = struct {
+	ID         string
+	Address    string
+	Label      string
+	Category   string
+	Source     string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	ID:         "id",
+	Address:    "address",
+	Label:      "label",
+	Category:   "category",
+	Source:     "source",
+	CreatedAt:  "created_at",
+	ModifiedAt: "modified_at",
+}
+
+var AddressLabelTableColumns = struct {
+	ID         string
+	Address    string
+	Label      string
+	Category   string
+	Source     string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	ID:         "address_label.id",
+	Address:    "address_label.address",
+	Label:      "address_label.label",
+	Category:   "address_label.category",
+	Source:     "address_label.source",
+	CreatedAt:  "address_label.created_at",
+	ModifiedAt: "address_label.modified_at",
+}
+
+// Generated where
+
+var AddressLabelWhere = struct {
+	ID         whereHelperuint64
+	Address    whereHelperstring
+	Label      whereHelperstring
+	Category   whereHelperstring
+	Source     whereHelpernull_String
+	CreatedAt  whereHelpertime_Time
+	ModifiedAt whereHelpertime_Time
+}{
+	ID:         whereHelperuint64{field: "`address_label`.`id`"},
+	Address:    whereHelperstring{field: "`address_label`.`address`"},
+	Label:      whereHelperstring{field: "`address_label`.`label`"},
+	Category:   whereHelperstring{field: "`address_label`.`category`"},
+	Source:     whereHelpernull_String{field: "`address_label`.`source`"},
+	CreatedAt:  whereHelpertime_Time{field: "`address_label`.`created_at`"},
+	ModifiedAt: whereHelpertime_Time{field: "`address_label`.`modified_at`"},
+}
+
+// AddressLabelRels is where relationship names are stored.
+var AddressLabelRels = struct {
+}{}
+
+// addressLabelR is where relationships are stored.
+type addressLabelR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*addressLabelR) NewStruct() *addressLabelR {
+	return &addressLabelR{}
+}
+
+// addressLabelL is where Load methods for each relationship are stored.
+type addressLabelL struct{}
+
+var (
+	addressLabelAllColumns            = []string{"id", "address", "label", "category", "source", "created_at", "modified_at"}
+	addressLabelColumnsWithoutDefault = []string{"address", "label", "category", "source"}
+	addressLabelColumnsWithDefault    = []string{"id", "created_at", "modified_at"}
+	addressLabelPrimaryKeyColumns     = []string{"id"}
+	addressLabelGeneratedColumns      = []string{}
+)
+
+type (
+	// AddressLabelSlice is an alias for a slice of pointers to AddressLabel.
+	// This should almost always be used instead of []AddressLabel.
+	AddressLabelSlice []*AddressLabel
+
+	addressLabelQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	addressLabelType                 = reflect.TypeOf(&AddressLabel{})
+	addressLabelMapping              = queries.MakeStructMapping(addressLabelType)
+	addressLabelPrimaryKeyMapping, _ = queries.BindMapping(addressLabelType, addressLabelMapping, addressLabelPrimaryKeyColumns)
+	addressLabelInsertCacheMut       sync.RWMutex
+	addressLabelInsertCache          = make(map[string]insertCache)
+	addressLabelUpdateCacheMut       sync.RWMutex
+	addressLabelUpdateCache          = make(map[string]updateCache)
+	addressLabelUpsertCacheMut       sync.RWMutex
+	addressLabelUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single addressLabel record from the query using the global executor.
+func (q addressLabelQuery) OneG() (*AddressLabel, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single addressLabel record from the query using the global executor, and panics on error.
+func (q addressLabelQuery) OneGP() *AddressLabel {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single addressLabel record from the query, and panics on error.
+func (q addressLabelQuery) OneP(exec boil.Executor) *AddressLabel {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single addressLabel record from the query.
+func (q addressLabelQuery) One(exec boil.Executor) (*AddressLabel, error) {
+	o := &AddressLabel{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for address_label")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AddressLabel records from the query using the global executor.
+func (q addressLabelQuery) AllG() (AddressLabelSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all AddressLabel records from the query using the global executor, and panics on error.
+func (q addressLabelQuery) AllGP() AddressLabelSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AddressLabel records from the query, and panics on error.
+func (q addressLabelQuery) AllP(exec boil.Executor) AddressLabelSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AddressLabel records from the query.
+func (q addressLabelQuery) All(exec boil.Executor) (AddressLabelSlice, error) {
+	var o []*AddressLabel
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to AddressLabel slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AddressLabel records in the query using the global executor
+func (q addressLabelQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all AddressLabel records in the query using the global executor, and panics on error.
+func (q addressLabelQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AddressLabel records in the query, and panics on error.
+func (q addressLabelQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AddressLabel records in the query.
+func (q addressLabelQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count address_label rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q addressLabelQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q addressLabelQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q addressLabelQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q addressLabelQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if address_label exists")
+	}
+
+	return count > 0, nil
+}
+
+// AddressLabels retrieves all the records using an executor.
+func AddressLabels(mods ...qm.QueryMod) addressLabelQuery {
+	mods = append(mods, qm.From("`address_label`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`address_label`.*"})
+	}
+
+	return addressLabelQuery{q}
+}
+
+// FindAddressLabelG retrieves a single record by ID.
+func FindAddressLabelG(iD uint64, selectCols ...string) (*AddressLabel, error) {
+	return FindAddressLabel(boil.GetDB(), iD, selectCols...)
+}
+
+// FindAddressLabelP retrieves a single record by ID with an executor, and panics on error.
+func FindAddressLabelP(exec boil.Executor, iD uint64, selectCols ...string) *AddressLabel {
+	retobj, err := FindAddressLabel(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAddressLabelGP retrieves a single record by ID, and panics on error.
+func FindAddressLabelGP(iD uint64, selectCols ...string) *AddressLabel {
+	retobj, err := FindAddressLabel(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAddressLabel retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAddressLabel(exec boil.Executor, iD uint64, selectCols ...string) (*AddressLabel, error) {
+	addressLabelObj := &AddressLabel{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `address_label` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, addressLabelObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from address_label")
+	}
+
+	return addressLabelObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AddressLabel) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AddressLabel) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AddressLabel) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AddressLabel) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no address_label provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(addressLabelColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	addressLabelInsertCacheMut.RLock()
+	cache, cached := addressLabelInsertCache[key]
+	addressLabelInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			addressLabelAllColumns,
+			addressLabelColumnsWithDefault,
+			addressLabelColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(addressLabelType, addressLabelMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(addressLabelType, addressLabelMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `address_label` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `address_label` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `address_label` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, addressLabelPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into address_label")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == addressLabelMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for address_label")
+	}
+
+CacheNoHooks:
+	if !cached {
+		addressLabelInsertCacheMut.Lock()
+		addressLabelInsertCache[key] = cache
+		addressLabelInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AddressLabel record using the global executor.
+// See Update for more documentation.
+func (o *AddressLabel) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the AddressLabel, and panics on error.
+// See Update for more documentation.
+func (o *AddressLabel) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single AddressLabel record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AddressLabel) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the AddressLabel.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AddressLabel) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	addressLabelUpdateCacheMut.RLock()
+	cache, cached := addressLabelUpdateCache[key]
+	addressLabelUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			addressLabelAllColumns,
+			addressLabelPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update address_label, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `address_label` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, addressLabelPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(addressLabelType, addressLabelMapping, append(wl, addressLabelPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update address_label row")
+	}
+
+	if !cached {
+		addressLabelUpdateCacheMut.Lock()
+		addressLabelUpdateCache[key] = cache
+		addressLabelUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q addressLabelQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q addressLabelQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q addressLabelQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q addressLabelQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for address_label")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AddressLabelSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AddressLabelSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AddressLabelSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AddressLabelSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressLabelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `address_label` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressLabelPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in addressLabel slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AddressLabel) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AddressLabel) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AddressLabel) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLAddressLabelUniqueColumns = []string{
+	"id",
+	"address",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AddressLabel) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no address_label provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(addressLabelColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAddressLabelUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	addressLabelUpsertCacheMut.RLock()
+	cache, cached := addressLabelUpsertCache[key]
+	addressLabelUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			addressLabelAllColumns,
+			addressLabelColumnsWithDefault,
+			addressLabelColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			addressLabelAllColumns,
+			addressLabelPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert address_label, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(addressLabelAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`address_label`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `address_label` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(addressLabelType, addressLabelMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(addressLabelType, addressLabelMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for address_label")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == addressLabelMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(addressLabelType, addressLabelMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for address_label")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for address_label")
+	}
+
+CacheNoHooks:
+	if !cached {
+		addressLabelUpsertCacheMut.Lock()
+		addressLabelUpsertCache[key] = cache
+		addressLabelUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AddressLabel record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AddressLabel) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single AddressLabel record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AddressLabel) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single AddressLabel record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AddressLabel) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single AddressLabel record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AddressLabel) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no AddressLabel provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), addressLabelPrimaryKeyMapping)
+	sql := "DELETE FROM `address_label` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from address_label")
+	}
+
+	return nil
+}
+
+func (q addressLabelQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q addressLabelQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q addressLabelQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q addressLabelQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no addressLabelQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from address_label")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AddressLabelSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AddressLabelSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AddressLabelSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AddressLabelSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressLabelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `address_label` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressLabelPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from addressLabel slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AddressLabel) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no AddressLabel provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AddressLabel) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AddressLabel) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AddressLabel) Reload(exec boil.Executor) error {
+	ret, err := FindAddressLabel(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AddressLabelSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty AddressLabelSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AddressLabelSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AddressLabelSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AddressLabelSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AddressLabelSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), addressLabelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `address_label`.* FROM `address_label` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, addressLabelPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in AddressLabelSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AddressLabelExistsG checks if the AddressLabel row exists.
+func AddressLabelExistsG(iD uint64) (bool, error) {
+	return AddressLabelExists(boil.GetDB(), iD)
+}
+
+// AddressLabelExistsP checks if the AddressLabel row exists. Panics on error.
+func AddressLabelExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := AddressLabelExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AddressLabelExistsGP checks if the AddressLabel row exists. Panics on error.
+func AddressLabelExistsGP(iD uint64) bool {
+	e, err := AddressLabelExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AddressLabelExists checks if the AddressLabel row exists.
+func AddressLabelExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `address_label` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if address_label exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AddressLabel row exists.
+func (o *AddressLabel) Exists(exec boil.Executor) (bool, error) {
+	return AddressLabelExists(exec, o.ID)
+}
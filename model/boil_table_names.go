@@ -4,35 +4,53 @@
 package model
 
 var TableNames = struct {
-	AbnormalClaim      string
-	Address            string
-	ApplicationStatus  string
-	Block              string
-	Claim              string
-	ClaimInList        string
-	ClaimTag           string
-	Input              string
-	JobStatus          string
-	Output             string
-	Purchase           string
-	Support            string
-	Tag                string
-	Transaction        string
-	TransactionAddress string
+	AbnormalClaim          string
+	Address                string
+	AddressCluster         string
+	AddressLabel           string
+	ApplicationStatus      string
+	Block                  string
+	ChainStats             string
+	ChangeOutbox           string
+	ChannelStats           string
+	Claim                  string
+	ClaimInList            string
+	ClaimTag               string
+	ClaimVersion           string
+	Input                  string
+	JobStatus              string
+	NotificationOutbox     string
+	NotificationSubscriber string
+	Output                 string
+	Purchase               string
+	Support                string
+	Tag                    string
+	Takeover               string
+	Transaction            string
+	TransactionAddress     string
 }{
-	AbnormalClaim:      "abnormal_claim",
-	Address:            "address",
-	ApplicationStatus:  "application_status",
-	Block:              "block",
-	Claim:              "claim",
-	ClaimInList:        "claim_in_list",
-	ClaimTag:           "claim_tag",
-	Input:              "input",
-	JobStatus:          "job_status",
-	Output:             "output",
-	Purchase:           "purchase",
-	Support:            "support",
-	Tag:                "tag",
-	Transaction:        "transaction",
-	TransactionAddress: "transaction_address",
+	AbnormalClaim:          "abnormal_claim",
+	Address:                "address",
+	AddressCluster:         "address_cluster",
+	AddressLabel:           "address_label",
+	ApplicationStatus:      "application_status",
+	Block:                  "block",
+	ChainStats:             "chain_stats",
+	ChangeOutbox:           "change_outbox",
+	ChannelStats:           "channel_stats",
+	Claim:                  "claim",
+	ClaimInList:            "claim_in_list",
+	ClaimTag:               "claim_tag",
+	ClaimVersion:           "claim_version",
+	Input:                  "input",
+	JobStatus:              "job_status",
+	NotificationOutbox:     "notification_outbox",
+	NotificationSubscriber: "notification_subscriber",
+	Output:                 "output",
+	Purchase:               "purchase",
+	Support:                "support",
+	Tag:                    "tag",
+	Takeover:               "takeover",
+	Transaction:            "transaction",
+	TransactionAddress:     "transaction_address",
 }
@@ -0,0 +1,1201 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChainStat is an object representing the database table.
+type ChainStat struct {
+	ID                     uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Period                 string    `boil:"period" json:"period" toml:"period" yaml:"period"`
+	PeriodStart            uint64    `boil:"period_start" json:"period_start" toml:"period_start" yaml:"period_start"`
+	FirstHeight            uint      `boil:"first_height" json:"first_height" toml:"first_height" yaml:"first_height"`
+	LastHeight             uint      `boil:"last_height" json:"last_height" toml:"last_height" yaml:"last_height"`
+	BlockCount             uint      `boil:"block_count" json:"block_count" toml:"block_count" yaml:"block_count"`
+	TransactionCount       uint      `boil:"transaction_count" json:"transaction_count" toml:"transaction_count" yaml:"transaction_count"`
+	FeeAmount              float64   `boil:"fee_amount" json:"fee_amount" toml:"fee_amount" yaml:"fee_amount"`
+	NewAddressCount        uint      `boil:"new_address_count" json:"new_address_count" toml:"new_address_count" yaml:"new_address_count"`
+	NewStreamCount         uint      `boil:"new_stream_count" json:"new_stream_count" toml:"new_stream_count" yaml:"new_stream_count"`
+	NewChannelCount        uint      `boil:"new_channel_count" json:"new_channel_count" toml:"new_channel_count" yaml:"new_channel_count"`
+	NewCollectionCount     uint      `boil:"new_collection_count" json:"new_collection_count" toml:"new_collection_count" yaml:"new_collection_count"`
+	NewRepostCount         uint      `boil:"new_repost_count" json:"new_repost_count" toml:"new_repost_count" yaml:"new_repost_count"`
+	SupportAddedCount      uint      `boil:"support_added_count" json:"support_added_count" toml:"support_added_count" yaml:"support_added_count"`
+	SupportAddedAmount     float64   `boil:"support_added_amount" json:"support_added_amount" toml:"support_added_amount" yaml:"support_added_amount"`
+	SupportWithdrawnCount  uint      `boil:"support_withdrawn_count" json:"support_withdrawn_count" toml:"support_withdrawn_count" yaml:"support_withdrawn_count"`
+	SupportWithdrawnAmount float64   `boil:"support_withdrawn_amount" json:"support_withdrawn_amount" toml:"support_withdrawn_amount" yaml:"support_withdrawn_amount"`
+	PurchaseCount          uint      `boil:"purchase_count" json:"purchase_count" toml:"purchase_count" yaml:"purchase_count"`
+	PurchaseAmount         float64   `boil:"purchase_amount" json:"purchase_amount" toml:"purchase_amount" yaml:"purchase_amount"`
+	ActiveClaimCount       uint      `boil:"active_claim_count" json:"active_claim_count" toml:"active_claim_count" yaml:"active_claim_count"`
+	CreatedAt              time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt             time.Time `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *chainStatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chainStatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChainStatColumns = struct {
+	ID                     string
+	Period                 string
+	PeriodStart            string
+	FirstHeight            string
+	LastHeight             string
+	BlockCount             string
+	TransactionCount       string
+	FeeAmount              string
+	NewAddressCount        string
+	NewStreamCount         string
+	NewChannelCount        string
+	NewCollectionCount     string
+	NewRepostCount         string
+	SupportAddedCount      string
+	SupportAddedAmount     string
+	SupportWithdrawnCount  string
+	SupportWithdrawnAmount string
+	PurchaseCount          string
+	PurchaseAmount         string
+	ActiveClaimCount       string
+	CreatedAt              string
+	ModifiedAt             string
+}{
+	ID:                     "id",
+	Period:                 "period",
+	PeriodStart:            "period_start",
+	FirstHeight:            "first_height",
+	LastHeight:             "last_height",
+	BlockCount:             "block_count",
+	TransactionCount:       "transaction_count",
+	FeeAmount:              "fee_amount",
+	NewAddressCount:        "new_address_count",
+	NewStreamCount:         "new_stream_count",
+	NewChannelCount:        "new_channel_count",
+	NewCollectionCount:     "new_collection_count",
+	NewRepostCount:         "new_repost_count",
+	SupportAddedCount:      "support_added_count",
+	SupportAddedAmount:     "support_added_amount",
+	SupportWithdrawnCount:  "support_withdrawn_count",
+	SupportWithdrawnAmount: "support_withdrawn_amount",
+	PurchaseCount:          "purchase_count",
+	PurchaseAmount:         "purchase_amount",
+	ActiveClaimCount:       "active_claim_count",
+	CreatedAt:              "created_at",
+	ModifiedAt:             "modified_at",
+}
+
+var ChainStatTableColumns = struct {
+	ID                     string
+	Period                 string
+	PeriodStart            string
+	FirstHeight            string
+	LastHeight             string
+	BlockCount             string
+	TransactionCount       string
+	FeeAmount              string
+	NewAddressCount        string
+	NewStreamCount         string
+	NewChannelCount        string
+	NewCollectionCount     string
+	NewRepostCount         string
+	SupportAddedCount      string
+	SupportAddedAmount     string
+	SupportWithdrawnCount  string
+	SupportWithdrawnAmount string
+	PurchaseCount          string
+	PurchaseAmount         string
+	ActiveClaimCount       string
+	CreatedAt              string
+	ModifiedAt             string
+}{
+	ID:                     "chain_stats.id",
+	Period:                 "chain_stats.period",
+	PeriodStart:            "chain_stats.period_start",
+	FirstHeight:            "chain_stats.first_height",
+	LastHeight:             "chain_stats.last_height",
+	BlockCount:             "chain_stats.block_count",
+	TransactionCount:       "chain_stats.transaction_count",
+	FeeAmount:              "chain_stats.fee_amount",
+	NewAddressCount:        "chain_stats.new_address_count",
+	NewStreamCount:         "chain_stats.new_stream_count",
+	NewChannelCount:        "chain_stats.new_channel_count",
+	NewCollectionCount:     "chain_stats.new_collection_count",
+	NewRepostCount:         "chain_stats.new_repost_count",
+	SupportAddedCount:      "chain_stats.support_added_count",
+	SupportAddedAmount:     "chain_stats.support_added_amount",
+	SupportWithdrawnCount:  "chain_stats.support_withdrawn_count",
+	SupportWithdrawnAmount: "chain_stats.support_withdrawn_amount",
+	PurchaseCount:          "chain_stats.purchase_count",
+	PurchaseAmount:         "chain_stats.purchase_amount",
+	ActiveClaimCount:       "chain_stats.active_claim_count",
+	CreatedAt:              "chain_stats.created_at",
+	ModifiedAt:             "chain_stats.modified_at",
+}
+
+// Generated where
+
+var ChainStatWhere = struct {
+	ID                     whereHelperuint64
+	Period                 whereHelperstring
+	PeriodStart            whereHelperuint64
+	FirstHeight            whereHelperuint
+	LastHeight             whereHelperuint
+	BlockCount             whereHelperuint
+	TransactionCount       whereHelperuint
+	FeeAmount              whereHelperfloat64
+	NewAddressCount        whereHelperuint
+	NewStreamCount         whereHelperuint
+	NewChannelCount        whereHelperuint
+	NewCollectionCount     whereHelperuint
+	NewRepostCount         whereHelperuint
+	SupportAddedCount      whereHelperuint
+	SupportAddedAmount     whereHelperfloat64
+	SupportWithdrawnCount  whereHelperuint
+	SupportWithdrawnAmount whereHelperfloat64
+	PurchaseCount          whereHelperuint
+	PurchaseAmount         whereHelperfloat64
+	ActiveClaimCount       whereHelperuint
+	CreatedAt              whereHelpertime_Time
+	ModifiedAt             whereHelpertime_Time
+}{
+	ID:                     whereHelperuint64{field: "`chain_stats`.`id`"},
+	Period:                 whereHelperstring{field: "`chain_stats`.`period`"},
+	PeriodStart:            whereHelperuint64{field: "`chain_stats`.`period_start`"},
+	FirstHeight:            whereHelperuint{field: "`chain_stats`.`first_height`"},
+	LastHeight:             whereHelperuint{field: "`chain_stats`.`last_height`"},
+	BlockCount:             whereHelperuint{field: "`chain_stats`.`block_count`"},
+	TransactionCount:       whereHelperuint{field: "`chain_stats`.`transaction_count`"},
+	FeeAmount:              whereHelperfloat64{field: "`chain_stats`.`fee_amount`"},
+	NewAddressCount:        whereHelperuint{field: "`chain_stats`.`new_address_count`"},
+	NewStreamCount:         whereHelperuint{field: "`chain_stats`.`new_stream_count`"},
+	NewChannelCount:        whereHelperuint{field: "`chain_stats`.`new_channel_count`"},
+	NewCollectionCount:     whereHelperuint{field: "`chain_stats`.`new_collection_count`"},
+	NewRepostCount:         whereHelperuint{field: "`chain_stats`.`new_repost_count`"},
+	SupportAddedCount:      whereHelperuint{field: "`chain_stats`.`support_added_count`"},
+	SupportAddedAmount:     whereHelperfloat64{field: "`chain_stats`.`support_added_amount`"},
+	SupportWithdrawnCount:  whereHelperuint{field: "`chain_stats`.`support_withdrawn_count`"},
+	SupportWithdrawnAmount: whereHelperfloat64{field: "`chain_stats`.`support_withdrawn_amount`"},
+	PurchaseCount:          whereHelperuint{field: "`chain_stats`.`purchase_count`"},
+	PurchaseAmount:         whereHelperfloat64{field: "`chain_stats`.`purchase_amount`"},
+	ActiveClaimCount:       whereHelperuint{field: "`chain_stats`.`active_claim_count`"},
+	CreatedAt:              whereHelpertime_Time{field: "`chain_stats`.`created_at`"},
+	ModifiedAt:             whereHelpertime_Time{field: "`chain_stats`.`modified_at`"},
+}
+
+// ChainStatRels is where relationship names are stored.
+var ChainStatRels = struct {
+}{}
+
+// chainStatR is where relationships are stored.
+type chainStatR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*chainStatR) NewStruct() *chainStatR {
+	return &chainStatR{}
+}
+
+// chainStatL is where Load methods for each relationship are stored.
+type chainStatL struct{}
+
+var (
+	chainStatAllColumns            = []string{"id", "period", "period_start", "first_height", "last_height", "block_count", "transaction_count", "fee_amount", "new_address_count", "new_stream_count", "new_channel_count", "new_collection_count", "new_repost_count", "support_added_count", "support_added_amount", "support_withdrawn_count", "support_withdrawn_amount", "purchase_count", "purchase_amount", "active_claim_count", "created_at", "modified_at"}
+	chainStatColumnsWithoutDefault = []string{"period", "period_start", "first_height", "last_height"}
+	chainStatColumnsWithDefault    = []string{"id", "block_count", "transaction_count", "fee_amount", "new_address_count", "new_stream_count", "new_channel_count", "new_collection_count", "new_repost_count", "support_added_count", "support_added_amount", "support_withdrawn_count", "support_withdrawn_amount", "purchase_count", "purchase_amount", "active_claim_count", "created_at", "modified_at"}
+	chainStatPrimaryKeyColumns     = []string{"id"}
+	chainStatGeneratedColumns      = []string{}
+)
+
+type (
+	// ChainStatSlice is an alias for a slice of pointers to ChainStat.
+	// This should almost always be used instead of []ChainStat.
+	ChainStatSlice []*ChainStat
+
+	chainStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chainStatType                 = reflect.TypeOf(&ChainStat{})
+	chainStatMapping              = queries.MakeStructMapping(chainStatType)
+	chainStatPrimaryKeyMapping, _ = queries.BindMapping(chainStatType, chainStatMapping, chainStatPrimaryKeyColumns)
+	chainStatInsertCacheMut       sync.RWMutex
+	chainStatInsertCache          = make(map[string]insertCache)
+	chainStatUpdateCacheMut       sync.RWMutex
+	chainStatUpdateCache          = make(map[string]updateCache)
+	chainStatUpsertCacheMut       sync.RWMutex
+	chainStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single chainStat record from the query using the global executor.
+func (q chainStatQuery) OneG() (*ChainStat, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single chainStat record from the query using the global executor, and panics on error.
+func (q chainStatQuery) OneGP() *ChainStat {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single chainStat record from the query, and panics on error.
+func (q chainStatQuery) OneP(exec boil.Executor) *ChainStat {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single chainStat record from the query.
+func (q chainStatQuery) One(exec boil.Executor) (*ChainStat, error) {
+	o := &ChainStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for chain_stats")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChainStat records from the query using the global executor.
+func (q chainStatQuery) AllG() (ChainStatSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all ChainStat records from the query using the global executor, and panics on error.
+func (q chainStatQuery) AllGP() ChainStatSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all ChainStat records from the query, and panics on error.
+func (q chainStatQuery) AllP(exec boil.Executor) ChainStatSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all ChainStat records from the query.
+func (q chainStatQuery) All(exec boil.Executor) (ChainStatSlice, error) {
+	var o []*ChainStat
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ChainStat slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChainStat records in the query using the global executor
+func (q chainStatQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all ChainStat records in the query using the global executor, and panics on error.
+func (q chainStatQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all ChainStat records in the query, and panics on error.
+func (q chainStatQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all ChainStat records in the query.
+func (q chainStatQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count chain_stats rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chainStatQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q chainStatQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q chainStatQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q chainStatQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if chain_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChainStats retrieves all the records using an executor.
+func ChainStats(mods ...qm.QueryMod) chainStatQuery {
+	mods = append(mods, qm.From("`chain_stats`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`chain_stats`.*"})
+	}
+
+	return chainStatQuery{q}
+}
+
+// FindChainStatG retrieves a single record by ID.
+func FindChainStatG(iD uint64, selectCols ...string) (*ChainStat, error) {
+	return FindChainStat(boil.GetDB(), iD, selectCols...)
+}
+
+// FindChainStatP retrieves a single record by ID with an executor, and panics on error.
+func FindChainStatP(exec boil.Executor, iD uint64, selectCols ...string) *ChainStat {
+	retobj, err := FindChainStat(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChainStatGP retrieves a single record by ID, and panics on error.
+func FindChainStatGP(iD uint64, selectCols ...string) *ChainStat {
+	retobj, err := FindChainStat(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChainStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChainStat(exec boil.Executor, iD uint64, selectCols ...string) (*ChainStat, error) {
+	chainStatObj := &ChainStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `chain_stats` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, chainStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from chain_stats")
+	}
+
+	return chainStatObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChainStat) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *ChainStat) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *ChainStat) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChainStat) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no chain_stats provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(chainStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chainStatInsertCacheMut.RLock()
+	cache, cached := chainStatInsertCache[key]
+	chainStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chainStatAllColumns,
+			chainStatColumnsWithDefault,
+			chainStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chainStatType, chainStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chainStatType, chainStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `chain_stats` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `chain_stats` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `chain_stats` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, chainStatPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into chain_stats")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == chainStatMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for chain_stats")
+	}
+
+CacheNoHooks:
+	if !cached {
+		chainStatInsertCacheMut.Lock()
+		chainStatInsertCache[key] = cache
+		chainStatInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChainStat record using the global executor.
+// See Update for more documentation.
+func (o *ChainStat) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the ChainStat, and panics on error.
+// See Update for more documentation.
+func (o *ChainStat) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single ChainStat record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *ChainStat) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the ChainStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChainStat) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	chainStatUpdateCacheMut.RLock()
+	cache, cached := chainStatUpdateCache[key]
+	chainStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chainStatAllColumns,
+			chainStatPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update chain_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `chain_stats` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, chainStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chainStatType, chainStatMapping, append(wl, chainStatPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update chain_stats row")
+	}
+
+	if !cached {
+		chainStatUpdateCacheMut.Lock()
+		chainStatUpdateCache[key] = cache
+		chainStatUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q chainStatQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chainStatQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q chainStatQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chainStatQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for chain_stats")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChainStatSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o ChainStatSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o ChainStatSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChainStatSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `chain_stats` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chainStatPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in chainStat slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChainStat) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *ChainStat) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *ChainStat) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLChainStatUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChainStat) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no chain_stats provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chainStatColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLChainStatUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chainStatUpsertCacheMut.RLock()
+	cache, cached := chainStatUpsertCache[key]
+	chainStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			chainStatAllColumns,
+			chainStatColumnsWithDefault,
+			chainStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chainStatAllColumns,
+			chainStatPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert chain_stats, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(chainStatAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`chain_stats`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `chain_stats` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chainStatType, chainStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chainStatType, chainStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for chain_stats")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == chainStatMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(chainStatType, chainStatMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for chain_stats")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for chain_stats")
+	}
+
+CacheNoHooks:
+	if !cached {
+		chainStatUpsertCacheMut.Lock()
+		chainStatUpsertCache[key] = cache
+		chainStatUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChainStat record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChainStat) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single ChainStat record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChainStat) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single ChainStat record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChainStat) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single ChainStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChainStat) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no ChainStat provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chainStatPrimaryKeyMapping)
+	sql := "DELETE FROM `chain_stats` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from chain_stats")
+	}
+
+	return nil
+}
+
+func (q chainStatQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q chainStatQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q chainStatQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q chainStatQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no chainStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from chain_stats")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChainStatSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o ChainStatSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o ChainStatSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChainStatSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `chain_stats` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chainStatPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from chainStat slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChainStat) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no ChainStat provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *ChainStat) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *ChainStat) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChainStat) Reload(exec boil.Executor) error {
+	ret, err := FindChainStat(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChainStatSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty ChainStatSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChainStatSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChainStatSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChainStatSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChainStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chainStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `chain_stats`.* FROM `chain_stats` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chainStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ChainStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChainStatExistsG checks if the ChainStat row exists.
+func ChainStatExistsG(iD uint64) (bool, error) {
+	return ChainStatExists(boil.GetDB(), iD)
+}
+
+// ChainStatExistsP checks if the ChainStat row exists. Panics on error.
+func ChainStatExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := ChainStatExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChainStatExistsGP checks if the ChainStat row exists. Panics on error.
+func ChainStatExistsGP(iD uint64) bool {
+	e, err := ChainStatExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChainStatExists checks if the ChainStat row exists.
+func ChainStatExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `chain_stats` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if chain_stats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChainStat row exists.
+func (o *ChainStat) Exists(exec boil.Executor) (bool, error) {
+	return ChainStatExists(exec, o.ID)
+}
@@ -0,0 +1,1132 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChangeOutbox is an object representing the database table.
+type ChangeOutbox struct {
+	ID            uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BlockHash     string      `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	Height        uint64      `boil:"height" json:"height" toml:"height" yaml:"height"`
+	Reverted      bool        `boil:"reverted" json:"reverted" toml:"reverted" yaml:"reverted"`
+	Payload       string      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      uint        `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError     null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	PublishedAt   null.Time   `boil:"published_at" json:"published_at,omitempty" toml:"published_at" yaml:"published_at,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt    time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *changeOutboxR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L changeOutboxL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChangeOutboxColumns = struct {
+	ID            string
+	BlockHash     string
+	Height        string
+	Reverted      string
+	Payload       string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	PublishedAt   string
+	CreatedAt     string
+	ModifiedAt    string
+}{
+	ID:            "id",
+	BlockHash:     "block_hash",
+	Height:        "height",
+	Reverted:      "reverted",
+	Payload:       "payload",
+	Status:        "status",
+	Attempts:      "attempts",
+	NextAttemptAt: "next_attempt_at",
+	LastError:     "last_error",
+	PublishedAt:   "published_at",
+	CreatedAt:     "created_at",
+	ModifiedAt:    "modified_at",
+}
+
+var ChangeOutboxTableColumns = struct {
+	ID            string
+	BlockHash     string
+	Height        string
+	Reverted      string
+	Payload       string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	PublishedAt   string
+	CreatedAt     string
+	ModifiedAt    string
+}{
+	ID:            "change_outbox.id",
+	BlockHash:     "change_outbox.block_hash",
+	Height:        "change_outbox.height",
+	Reverted:      "change_outbox.reverted",
+	Payload:       "change_outbox.payload",
+	Status:        "change_outbox.status",
+	Attempts:      "change_outbox.attempts",
+	NextAttemptAt: "change_outbox.next_attempt_at",
+	LastError:     "change_outbox.last_error",
+	PublishedAt:   "change_outbox.published_at",
+	CreatedAt:     "change_outbox.created_at",
+	ModifiedAt:    "change_outbox.modified_at",
+}
+
+// Generated where
+
+var ChangeOutboxWhere = struct {
+	ID            whereHelperuint64
+	BlockHash     whereHelperstring
+	Height        whereHelperuint64
+	Reverted      whereHelperbool
+	Payload       whereHelperstring
+	Status        whereHelperstring
+	Attempts      whereHelperuint
+	NextAttemptAt whereHelpertime_Time
+	LastError     whereHelpernull_String
+	PublishedAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	ModifiedAt    whereHelpertime_Time
+}{
+	ID:            whereHelperuint64{field: "`change_outbox`.`id`"},
+	BlockHash:     whereHelperstring{field: "`change_outbox`.`block_hash`"},
+	Height:        whereHelperuint64{field: "`change_outbox`.`height`"},
+	Reverted:      whereHelperbool{field: "`change_outbox`.`reverted`"},
+	Payload:       whereHelperstring{field: "`change_outbox`.`payload`"},
+	Status:        whereHelperstring{field: "`change_outbox`.`status`"},
+	Attempts:      whereHelperuint{field: "`change_outbox`.`attempts`"},
+	NextAttemptAt: whereHelpertime_Time{field: "`change_outbox`.`next_attempt_at`"},
+	LastError:     whereHelpernull_String{field: "`change_outbox`.`last_error`"},
+	PublishedAt:   whereHelpernull_Time{field: "`change_outbox`.`published_at`"},
+	CreatedAt:     whereHelpertime_Time{field: "`change_outbox`.`created_at`"},
+	ModifiedAt:    whereHelpertime_Time{field: "`change_outbox`.`modified_at`"},
+}
+
+// ChangeOutboxRels is where relationship names are stored.
+var ChangeOutboxRels = struct {
+}{}
+
+// changeOutboxR is where relationships are stored.
+type changeOutboxR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*changeOutboxR) NewStruct() *changeOutboxR {
+	return &changeOutboxR{}
+}
+
+// changeOutboxL is where Load methods for each relationship are stored.
+type changeOutboxL struct{}
+
+var (
+	changeOutboxAllColumns            = []string{"id", "block_hash", "height", "reverted", "payload", "status", "attempts", "next_attempt_at", "last_error", "published_at", "created_at", "modified_at"}
+	changeOutboxColumnsWithoutDefault = []string{"block_hash", "height", "payload", "last_error", "published_at"}
+	changeOutboxColumnsWithDefault    = []string{"id", "reverted", "status", "attempts", "next_attempt_at", "created_at", "modified_at"}
+	changeOutboxPrimaryKeyColumns     = []string{"id"}
+	changeOutboxGeneratedColumns      = []string{}
+)
+
+type (
+	// ChangeOutboxSlice is an alias for a slice of pointers to ChangeOutbox.
+	// This should almost always be used instead of []ChangeOutbox.
+	ChangeOutboxSlice []*ChangeOutbox
+
+	changeOutboxQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	changeOutboxType                 = reflect.TypeOf(&ChangeOutbox{})
+	changeOutboxMapping              = queries.MakeStructMapping(changeOutboxType)
+	changeOutboxPrimaryKeyMapping, _ = queries.BindMapping(changeOutboxType, changeOutboxMapping, changeOutboxPrimaryKeyColumns)
+	changeOutboxInsertCacheMut       sync.RWMutex
+	changeOutboxInsertCache          = make(map[string]insertCache)
+	changeOutboxUpdateCacheMut       sync.RWMutex
+	changeOutboxUpdateCache          = make(map[string]updateCache)
+	changeOutboxUpsertCacheMut       sync.RWMutex
+	changeOutboxUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single changeOutbox record from the query using the global executor.
+func (q changeOutboxQuery) OneG() (*ChangeOutbox, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single changeOutbox record from the query using the global executor, and panics on error.
+func (q changeOutboxQuery) OneGP() *ChangeOutbox {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single changeOutbox record from the query, and panics on error.
+func (q changeOutboxQuery) OneP(exec boil.Executor) *ChangeOutbox {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single changeOutbox record from the query.
+func (q changeOutboxQuery) One(exec boil.Executor) (*ChangeOutbox, error) {
+	o := &ChangeOutbox{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for change_outbox")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChangeOutbox records from the query using the global executor.
+func (q changeOutboxQuery) AllG() (ChangeOutboxSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all ChangeOutbox records from the query using the global executor, and panics on error.
+func (q changeOutboxQuery) AllGP() ChangeOutboxSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all ChangeOutbox records from the query, and panics on error.
+func (q changeOutboxQuery) AllP(exec boil.Executor) ChangeOutboxSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all ChangeOutbox records from the query.
+func (q changeOutboxQuery) All(exec boil.Executor) (ChangeOutboxSlice, error) {
+	var o []*ChangeOutbox
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ChangeOutbox slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChangeOutbox records in the query using the global executor
+func (q changeOutboxQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all ChangeOutbox records in the query using the global executor, and panics on error.
+func (q changeOutboxQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all ChangeOutbox records in the query, and panics on error.
+func (q changeOutboxQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all ChangeOutbox records in the query.
+func (q changeOutboxQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count change_outbox rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q changeOutboxQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q changeOutboxQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q changeOutboxQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q changeOutboxQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if change_outbox exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChangeOutboxes retrieves all the records using an executor.
+func ChangeOutboxes(mods ...qm.QueryMod) changeOutboxQuery {
+	mods = append(mods, qm.From("`change_outbox`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`change_outbox`.*"})
+	}
+
+	return changeOutboxQuery{q}
+}
+
+// FindChangeOutboxG retrieves a single record by ID.
+func FindChangeOutboxG(iD uint64, selectCols ...string) (*ChangeOutbox, error) {
+	return FindChangeOutbox(boil.GetDB(), iD, selectCols...)
+}
+
+// FindChangeOutboxP retrieves a single record by ID with an executor, and panics on error.
+func FindChangeOutboxP(exec boil.Executor, iD uint64, selectCols ...string) *ChangeOutbox {
+	retobj, err := FindChangeOutbox(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChangeOutboxGP retrieves a single record by ID, and panics on error.
+func FindChangeOutboxGP(iD uint64, selectCols ...string) *ChangeOutbox {
+	retobj, err := FindChangeOutbox(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChangeOutbox retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChangeOutbox(exec boil.Executor, iD uint64, selectCols ...string) (*ChangeOutbox, error) {
+	changeOutboxObj := &ChangeOutbox{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `change_outbox` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, changeOutboxObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from change_outbox")
+	}
+
+	return changeOutboxObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChangeOutbox) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *ChangeOutbox) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *ChangeOutbox) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChangeOutbox) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no change_outbox provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(changeOutboxColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	changeOutboxInsertCacheMut.RLock()
+	cache, cached := changeOutboxInsertCache[key]
+	changeOutboxInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			changeOutboxAllColumns,
+			changeOutboxColumnsWithDefault,
+			changeOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `change_outbox` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `change_outbox` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `change_outbox` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, changeOutboxPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into change_outbox")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == changeOutboxMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for change_outbox")
+	}
+
+CacheNoHooks:
+	if !cached {
+		changeOutboxInsertCacheMut.Lock()
+		changeOutboxInsertCache[key] = cache
+		changeOutboxInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChangeOutbox record using the global executor.
+// See Update for more documentation.
+func (o *ChangeOutbox) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the ChangeOutbox, and panics on error.
+// See Update for more documentation.
+func (o *ChangeOutbox) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single ChangeOutbox record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *ChangeOutbox) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the ChangeOutbox.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChangeOutbox) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	changeOutboxUpdateCacheMut.RLock()
+	cache, cached := changeOutboxUpdateCache[key]
+	changeOutboxUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			changeOutboxAllColumns,
+			changeOutboxPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update change_outbox, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `change_outbox` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, changeOutboxPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, append(wl, changeOutboxPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update change_outbox row")
+	}
+
+	if !cached {
+		changeOutboxUpdateCacheMut.Lock()
+		changeOutboxUpdateCache[key] = cache
+		changeOutboxUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q changeOutboxQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q changeOutboxQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q changeOutboxQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q changeOutboxQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for change_outbox")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChangeOutboxSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o ChangeOutboxSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o ChangeOutboxSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChangeOutboxSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `change_outbox` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, changeOutboxPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in changeOutbox slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChangeOutbox) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *ChangeOutbox) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *ChangeOutbox) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLChangeOutboxUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChangeOutbox) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no change_outbox provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(changeOutboxColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLChangeOutboxUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	changeOutboxUpsertCacheMut.RLock()
+	cache, cached := changeOutboxUpsertCache[key]
+	changeOutboxUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			changeOutboxAllColumns,
+			changeOutboxColumnsWithDefault,
+			changeOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			changeOutboxAllColumns,
+			changeOutboxPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert change_outbox, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(changeOutboxAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`change_outbox`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `change_outbox` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for change_outbox")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == changeOutboxMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(changeOutboxType, changeOutboxMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for change_outbox")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for change_outbox")
+	}
+
+CacheNoHooks:
+	if !cached {
+		changeOutboxUpsertCacheMut.Lock()
+		changeOutboxUpsertCache[key] = cache
+		changeOutboxUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChangeOutbox record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChangeOutbox) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single ChangeOutbox record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChangeOutbox) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single ChangeOutbox record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChangeOutbox) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single ChangeOutbox record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChangeOutbox) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no ChangeOutbox provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), changeOutboxPrimaryKeyMapping)
+	sql := "DELETE FROM `change_outbox` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from change_outbox")
+	}
+
+	return nil
+}
+
+func (q changeOutboxQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q changeOutboxQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q changeOutboxQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q changeOutboxQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no changeOutboxQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from change_outbox")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChangeOutboxSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o ChangeOutboxSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o ChangeOutboxSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChangeOutboxSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `change_outbox` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, changeOutboxPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from changeOutbox slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChangeOutbox) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no ChangeOutbox provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *ChangeOutbox) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *ChangeOutbox) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChangeOutbox) Reload(exec boil.Executor) error {
+	ret, err := FindChangeOutbox(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChangeOutboxSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty ChangeOutboxSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChangeOutboxSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChangeOutboxSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChangeOutboxSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChangeOutboxSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `change_outbox`.* FROM `change_outbox` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, changeOutboxPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ChangeOutboxSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChangeOutboxExistsG checks if the ChangeOutbox row exists.
+func ChangeOutboxExistsG(iD uint64) (bool, error) {
+	return ChangeOutboxExists(boil.GetDB(), iD)
+}
+
+// ChangeOutboxExistsP checks if the ChangeOutbox row exists. Panics on error.
+func ChangeOutboxExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := ChangeOutboxExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChangeOutboxExistsGP checks if the ChangeOutbox row exists. Panics on error.
+func ChangeOutboxExistsGP(iD uint64) bool {
+	e, err := ChangeOutboxExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChangeOutboxExists checks if the ChangeOutbox row exists.
+func ChangeOutboxExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `change_outbox` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if change_outbox exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChangeOutbox row exists.
+func (o *ChangeOutbox) Exists(exec boil.Executor) (bool, error) {
+	return ChangeOutboxExists(exec, o.ID)
+}
@@ -0,0 +1,1209 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChannelStat is an object representing the database table.
+type ChannelStat struct {
+	ID                 uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChannelClaimID     string    `boil:"channel_claim_id" json:"channel_claim_id" toml:"channel_claim_id" yaml:"channel_claim_id"`
+	StreamCount        uint      `boil:"stream_count" json:"stream_count" toml:"stream_count" yaml:"stream_count"`
+	CollectionCount    uint      `boil:"collection_count" json:"collection_count" toml:"collection_count" yaml:"collection_count"`
+	RepostCount        uint      `boil:"repost_count" json:"repost_count" toml:"repost_count" yaml:"repost_count"`
+	SupportAmount      float64   `boil:"support_amount" json:"support_amount" toml:"support_amount" yaml:"support_amount"`
+	TipAmount          float64   `boil:"tip_amount" json:"tip_amount" toml:"tip_amount" yaml:"tip_amount"`
+	PurchaseCount      uint      `boil:"purchase_count" json:"purchase_count" toml:"purchase_count" yaml:"purchase_count"`
+	PurchaseRevenue    float64   `boil:"purchase_revenue" json:"purchase_revenue" toml:"purchase_revenue" yaml:"purchase_revenue"`
+	FirstPublishHeight null.Uint `boil:"first_publish_height" json:"first_publish_height,omitempty" toml:"first_publish_height" yaml:"first_publish_height,omitempty"`
+	LastPublishHeight  null.Uint `boil:"last_publish_height" json:"last_publish_height,omitempty" toml:"last_publish_height" yaml:"last_publish_height,omitempty"`
+	Tags               null.JSON `boil:"tags" json:"tags,omitempty" toml:"tags" yaml:"tags,omitempty"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt         time.Time `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *channelStatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L channelStatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChannelStatColumns = struct {
+	ID                 string
+	ChannelClaimID     string
+	StreamCount        string
+	CollectionCount    string
+	RepostCount        string
+	SupportAmount      string
+	TipAmount          string
+	PurchaseCount      string
+	PurchaseRevenue    string
+	FirstPublishHeight string
+	LastPublishHeight  string
+	Tags               string
+	CreatedAt          string
+	ModifiedAt         string
+}{
+	ID:                 "id",
+	ChannelClaimID:     "channel_claim_id",
+	StreamCount:        "stream_count",
+	CollectionCount:    "collection_count",
+	RepostCount:        "repost_count",
+	SupportAmount:      "support_amount",
+	TipAmount:          "tip_amount",
+	PurchaseCount:      "purchase_count",
+	PurchaseRevenue:    "purchase_revenue",
+	FirstPublishHeight: "first_publish_height",
+	LastPublishHeight:  "last_publish_height",
+	Tags:               "tags",
+	CreatedAt:          "created_at",
+	ModifiedAt:         "modified_at",
+}
+
+var ChannelStatTableColumns = struct {
+	ID                 string
+	ChannelClaimID     string
+	StreamCount        string
+	CollectionCount    string
+	RepostCount        string
+	SupportAmount      string
+	TipAmount          string
+	PurchaseCount      string
+	PurchaseRevenue    string
+	FirstPublishHeight string
+	LastPublishHeight  string
+	Tags               string
+	CreatedAt          string
+	ModifiedAt         string
+}{
+	ID:                 "channel_stats.id",
+	ChannelClaimID:     "channel_stats.channel_claim_id",
+	StreamCount:        "channel_stats.stream_count",
+	CollectionCount:    "channel_stats.collection_count",
+	RepostCount:        "channel_stats.repost_count",
+	SupportAmount:      "channel_stats.support_amount",
+	TipAmount:          "channel_stats.tip_amount",
+	PurchaseCount:      "channel_stats.purchase_count",
+	PurchaseRevenue:    "channel_stats.purchase_revenue",
+	FirstPublishHeight: "channel_stats.first_publish_height",
+	LastPublishHeight:  "channel_stats.last_publish_height",
+	Tags:               "channel_stats.tags",
+	CreatedAt:          "channel_stats.created_at",
+	ModifiedAt:         "channel_stats.modified_at",
+}
+
+// Generated where
+
+type whereHelpernull_Uint struct{ field string }
+
+func (w whereHelpernull_Uint) EQ(x null.Uint) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Uint) NEQ(x null.Uint) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Uint) LT(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Uint) LTE(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Uint) GT(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Uint) GTE(x null.Uint) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Uint) IN(slice []uint) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Uint) NIN(slice []uint) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Uint) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Uint) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ChannelStatWhere = struct {
+	ID                 whereHelperuint64
+	ChannelClaimID     whereHelperstring
+	StreamCount        whereHelperuint
+	CollectionCount    whereHelperuint
+	RepostCount        whereHelperuint
+	SupportAmount      whereHelperfloat64
+	TipAmount          whereHelperfloat64
+	PurchaseCount      whereHelperuint
+	PurchaseRevenue    whereHelperfloat64
+	FirstPublishHeight whereHelpernull_Uint
+	LastPublishHeight  whereHelpernull_Uint
+	Tags               whereHelpernull_JSON
+	CreatedAt          whereHelpertime_Time
+	ModifiedAt         whereHelpertime_Time
+}{
+	ID:                 whereHelperuint64{field: "`channel_stats`.`id`"},
+	ChannelClaimID:     whereHelperstring{field: "`channel_stats`.`channel_claim_id`"},
+	StreamCount:        whereHelperuint{field: "`channel_stats`.`stream_count`"},
+	CollectionCount:    whereHelperuint{field: "`channel_stats`.`collection_count`"},
+	RepostCount:        whereHelperuint{field: "`channel_stats`.`repost_count`"},
+	SupportAmount:      whereHelperfloat64{field: "`channel_stats`.`support_amount`"},
+	TipAmount:          whereHelperfloat64{field: "`channel_stats`.`tip_amount`"},
+	PurchaseCount:      whereHelperuint{field: "`channel_stats`.`purchase_count`"},
+	PurchaseRevenue:    whereHelperfloat64{field: "`channel_stats`.`purchase_revenue`"},
+	FirstPublishHeight: whereHelpernull_Uint{field: "`channel_stats`.`first_publish_height`"},
+	LastPublishHeight:  whereHelpernull_Uint{field: "`channel_stats`.`last_publish_height`"},
+	Tags:               whereHelpernull_JSON{field: "`channel_stats`.`tags`"},
+	CreatedAt:          whereHelpertime_Time{field: "`channel_stats`.`created_at`"},
+	ModifiedAt:         whereHelpertime_Time{field: "`channel_stats`.`modified_at`"},
+}
+
+// ChannelStatRels is where relationship names are stored.
+var ChannelStatRels = struct {
+}{}
+
+// channelStatR is where relationships are stored.
+type channelStatR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*channelStatR) NewStruct() *channelStatR {
+	return &channelStatR{}
+}
+
+// channelStatL is where Load methods for each relationship are stored.
+type channelStatL struct{}
+
+var (
+	channelStatAllColumns            = []string{"id", "channel_claim_id", "stream_count", "collection_count", "repost_count", "support_amount", "tip_amount", "purchase_count", "purchase_revenue", "first_publish_height", "last_publish_height", "tags", "created_at", "modified_at"}
+	channelStatColumnsWithoutDefault = []string{"channel_claim_id", "first_publish_height", "last_publish_height", "tags"}
+	channelStatColumnsWithDefault    = []string{"id", "stream_count", "collection_count", "repost_count", "support_amount", "tip_amount", "purchase_count", "purchase_revenue", "created_at", "modified_at"}
+	channelStatPrimaryKeyColumns     = []string{"id"}
+	channelStatGeneratedColumns      = []string{}
+)
+
+type (
+	// ChannelStatSlice is an alias for a slice of pointers to ChannelStat.
+	// This should almost always be used instead of []ChannelStat.
+	ChannelStatSlice []*ChannelStat
+
+	channelStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	channelStatType                 = reflect.TypeOf(&ChannelStat{})
+	channelStatMapping              = queries.MakeStructMapping(channelStatType)
+	channelStatPrimaryKeyMapping, _ = queries.BindMapping(channelStatType, channelStatMapping, channelStatPrimaryKeyColumns)
+	channelStatInsertCacheMut       sync.RWMutex
+	channelStatInsertCache          = make(map[string]insertCache)
+	channelStatUpdateCacheMut       sync.RWMutex
+	channelStatUpdateCache          = make(map[string]updateCache)
+	channelStatUpsertCacheMut       sync.RWMutex
+	channelStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single channelStat record from the query using the global executor.
+func (q channelStatQuery) OneG() (*ChannelStat, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single channelStat record from the query using the global executor, and panics on error.
+func (q channelStatQuery) OneGP() *ChannelStat {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single channelStat record from the query, and panics on error.
+func (q channelStatQuery) OneP(exec boil.Executor) *ChannelStat {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single channelStat record from the query.
+func (q channelStatQuery) One(exec boil.Executor) (*ChannelStat, error) {
+	o := &ChannelStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for channel_stats")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChannelStat records from the query using the global executor.
+func (q channelStatQuery) AllG() (ChannelStatSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all ChannelStat records from the query using the global executor, and panics on error.
+func (q channelStatQuery) AllGP() ChannelStatSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all ChannelStat records from the query, and panics on error.
+func (q channelStatQuery) AllP(exec boil.Executor) ChannelStatSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all ChannelStat records from the query.
+func (q channelStatQuery) All(exec boil.Executor) (ChannelStatSlice, error) {
+	var o []*ChannelStat
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ChannelStat slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChannelStat records in the query using the global executor
+func (q channelStatQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all ChannelStat records in the query using the global executor, and panics on error.
+func (q channelStatQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all ChannelStat records in the query, and panics on error.
+func (q channelStatQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all ChannelStat records in the query.
+func (q channelStatQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count channel_stats rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q channelStatQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q channelStatQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q channelStatQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q channelStatQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if channel_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChannelStats retrieves all the records using an executor.
+func ChannelStats(mods ...qm.QueryMod) channelStatQuery {
+	mods = append(mods, qm.From("`channel_stats`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`channel_stats`.*"})
+	}
+
+	return channelStatQuery{q}
+}
+
+// FindChannelStatG retrieves a single record by ID.
+func FindChannelStatG(iD uint64, selectCols ...string) (*ChannelStat, error) {
+	return FindChannelStat(boil.GetDB(), iD, selectCols...)
+}
+
+// FindChannelStatP retrieves a single record by ID with an executor, and panics on error.
+func FindChannelStatP(exec boil.Executor, iD uint64, selectCols ...string) *ChannelStat {
+	retobj, err := FindChannelStat(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChannelStatGP retrieves a single record by ID, and panics on error.
+func FindChannelStatGP(iD uint64, selectCols ...string) *ChannelStat {
+	retobj, err := FindChannelStat(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindChannelStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChannelStat(exec boil.Executor, iD uint64, selectCols ...string) (*ChannelStat, error) {
+	channelStatObj := &ChannelStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `channel_stats` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, channelStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from channel_stats")
+	}
+
+	return channelStatObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChannelStat) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *ChannelStat) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *ChannelStat) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChannelStat) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no channel_stats provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(channelStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	channelStatInsertCacheMut.RLock()
+	cache, cached := channelStatInsertCache[key]
+	channelStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			channelStatAllColumns,
+			channelStatColumnsWithDefault,
+			channelStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(channelStatType, channelStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(channelStatType, channelStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `channel_stats` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `channel_stats` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `channel_stats` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, channelStatPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into channel_stats")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == channelStatMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for channel_stats")
+	}
+
+CacheNoHooks:
+	if !cached {
+		channelStatInsertCacheMut.Lock()
+		channelStatInsertCache[key] = cache
+		channelStatInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChannelStat record using the global executor.
+// See Update for more documentation.
+func (o *ChannelStat) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the ChannelStat, and panics on error.
+// See Update for more documentation.
+func (o *ChannelStat) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single ChannelStat record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *ChannelStat) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the ChannelStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChannelStat) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	channelStatUpdateCacheMut.RLock()
+	cache, cached := channelStatUpdateCache[key]
+	channelStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			channelStatAllColumns,
+			channelStatPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update channel_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `channel_stats` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, channelStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(channelStatType, channelStatMapping, append(wl, channelStatPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update channel_stats row")
+	}
+
+	if !cached {
+		channelStatUpdateCacheMut.Lock()
+		channelStatUpdateCache[key] = cache
+		channelStatUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q channelStatQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q channelStatQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q channelStatQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q channelStatQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for channel_stats")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChannelStatSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o ChannelStatSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o ChannelStatSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChannelStatSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), channelStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `channel_stats` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, channelStatPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in channelStat slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChannelStat) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *ChannelStat) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *ChannelStat) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLChannelStatUniqueColumns = []string{
+	"id",
+	"channel_claim_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChannelStat) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no channel_stats provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(channelStatColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLChannelStatUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	channelStatUpsertCacheMut.RLock()
+	cache, cached := channelStatUpsertCache[key]
+	channelStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			channelStatAllColumns,
+			channelStatColumnsWithDefault,
+			channelStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			channelStatAllColumns,
+			channelStatPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert channel_stats, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(channelStatAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`channel_stats`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `channel_stats` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(channelStatType, channelStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(channelStatType, channelStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for channel_stats")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == channelStatMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(channelStatType, channelStatMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for channel_stats")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for channel_stats")
+	}
+
+CacheNoHooks:
+	if !cached {
+		channelStatUpsertCacheMut.Lock()
+		channelStatUpsertCache[key] = cache
+		channelStatUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChannelStat record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChannelStat) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single ChannelStat record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChannelStat) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single ChannelStat record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ChannelStat) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single ChannelStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChannelStat) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no ChannelStat provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), channelStatPrimaryKeyMapping)
+	sql := "DELETE FROM `channel_stats` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from channel_stats")
+	}
+
+	return nil
+}
+
+func (q channelStatQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q channelStatQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q channelStatQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q channelStatQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no channelStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from channel_stats")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChannelStatSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o ChannelStatSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o ChannelStatSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChannelStatSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), channelStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `channel_stats` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, channelStatPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from channelStat slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChannelStat) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no ChannelStat provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *ChannelStat) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *ChannelStat) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChannelStat) Reload(exec boil.Executor) error {
+	ret, err := FindChannelStat(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChannelStatSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty ChannelStatSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChannelStatSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ChannelStatSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChannelStatSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChannelStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), channelStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `channel_stats`.* FROM `channel_stats` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, channelStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ChannelStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChannelStatExistsG checks if the ChannelStat row exists.
+func ChannelStatExistsG(iD uint64) (bool, error) {
+	return ChannelStatExists(boil.GetDB(), iD)
+}
+
+// ChannelStatExistsP checks if the ChannelStat row exists. Panics on error.
+func ChannelStatExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := ChannelStatExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChannelStatExistsGP checks if the ChannelStat row exists. Panics on error.
+func ChannelStatExistsGP(iD uint64) bool {
+	e, err := ChannelStatExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ChannelStatExists checks if the ChannelStat row exists.
+func ChannelStatExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `channel_stats` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if channel_stats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChannelStat row exists.
+func (o *ChannelStat) Exists(exec boil.Executor) (bool, error) {
+	return ChannelStatExists(exec, o.ID)
+}
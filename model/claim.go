@@ -27,6 +27,7 @@ type Claim struct {
 	TransactionHashID null.String `boil:"transaction_hash_id" json:"transaction_hash_id,omitempty" toml:"transaction_hash_id" yaml:"transaction_hash_id,omitempty"`
 	Vout              uint        `boil:"vout" json:"vout" toml:"vout" yaml:"vout"`
 	Name              string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ShortURL          null.String `boil:"short_url" json:"short_url,omitempty" toml:"short_url" yaml:"short_url,omitempty"`
 	ClaimID           string      `boil:"claim_id" json:"claim_id" toml:"claim_id" yaml:"claim_id"`
 	ClaimType         int8        `boil:"claim_type" json:"claim_type" toml:"claim_type" yaml:"claim_type"`
 	// references a ClaimId with CertificateType
@@ -73,6 +74,7 @@ type Claim struct {
 	Email                 null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
 	HasClaimList          null.Bool   `boil:"has_claim_list" json:"has_claim_list,omitempty" toml:"has_claim_list" yaml:"has_claim_list,omitempty"`
 	ClaimReference        null.String `boil:"claim_reference" json:"claim_reference,omitempty" toml:"claim_reference" yaml:"claim_reference,omitempty"`
+	RepostCount           uint        `boil:"repost_count" json:"repost_count" toml:"repost_count" yaml:"repost_count"`
 	ListType              null.Int16  `boil:"list_type" json:"list_type,omitempty" toml:"list_type" yaml:"list_type,omitempty"`
 	ClaimIDList           null.JSON   `boil:"claim_id_list" json:"claim_id_list,omitempty" toml:"claim_id_list" yaml:"claim_id_list,omitempty"`
 	TransactionHashUpdate null.String `boil:"transaction_hash_update" json:"transaction_hash_update,omitempty" toml:"transaction_hash_update" yaml:"transaction_hash_update,omitempty"`
@@ -88,6 +90,7 @@ var ClaimColumns = struct {
 	TransactionHashID     string
 	Vout                  string
 	Name                  string
+	ShortURL              string
 	ClaimID               string
 	ClaimType             string
 	PublisherID           string
@@ -133,6 +136,7 @@ var ClaimColumns = struct {
 	Email                 string
 	HasClaimList          string
 	ClaimReference        string
+	RepostCount           string
 	ListType              string
 	ClaimIDList           string
 	TransactionHashUpdate string
@@ -143,6 +147,7 @@ var ClaimColumns = struct {
 	TransactionHashID:     "transaction_hash_id",
 	Vout:                  "vout",
 	Name:                  "name",
+	ShortURL:              "short_url",
 	ClaimID:               "claim_id",
 	ClaimType:             "claim_type",
 	PublisherID:           "publisher_id",
@@ -188,6 +193,7 @@ var ClaimColumns = struct {
 	Email:                 "email",
 	HasClaimList:          "has_claim_list",
 	ClaimReference:        "claim_reference",
+	RepostCount:           "repost_count",
 	ListType:              "list_type",
 	ClaimIDList:           "claim_id_list",
 	TransactionHashUpdate: "transaction_hash_update",
@@ -200,6 +206,7 @@ var ClaimTableColumns = struct {
 	TransactionHashID     string
 	Vout                  string
 	Name                  string
+	ShortURL              string
 	ClaimID               string
 	ClaimType             string
 	PublisherID           string
@@ -245,6 +252,7 @@ var ClaimTableColumns = struct {
 	Email                 string
 	HasClaimList          string
 	ClaimReference        string
+	RepostCount           string
 	ListType              string
 	ClaimIDList           string
 	TransactionHashUpdate string
@@ -255,6 +263,7 @@ var ClaimTableColumns = struct {
 	TransactionHashID:     "claim.transaction_hash_id",
 	Vout:                  "claim.vout",
 	Name:                  "claim.name",
+	ShortURL:              "claim.short_url",
 	ClaimID:               "claim.claim_id",
 	ClaimType:             "claim.claim_type",
 	PublisherID:           "claim.publisher_id",
@@ -300,6 +309,7 @@ var ClaimTableColumns = struct {
 	Email:                 "claim.email",
 	HasClaimList:          "claim.has_claim_list",
 	ClaimReference:        "claim.claim_reference",
+	RepostCount:           "claim.repost_count",
 	ListType:              "claim.list_type",
 	ClaimIDList:           "claim.claim_id_list",
 	TransactionHashUpdate: "claim.transaction_hash_update",
@@ -370,30 +380,6 @@ func (w whereHelpernull_Uint64) NIN(slice []uint64) qm.QueryMod {
 func (w whereHelpernull_Uint64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Uint64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpernull_JSON struct{ field string }
-
-func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 type whereHelpernull_Bool struct{ field string }
 
 func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
@@ -456,44 +442,6 @@ func (w whereHelpernull_Int16) NIN(slice []int16) qm.QueryMod {
 func (w whereHelpernull_Int16) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Int16) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpernull_Uint struct{ field string }
-
-func (w whereHelpernull_Uint) EQ(x null.Uint) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Uint) NEQ(x null.Uint) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Uint) LT(x null.Uint) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Uint) LTE(x null.Uint) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Uint) GT(x null.Uint) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Uint) GTE(x null.Uint) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Uint) IN(slice []uint) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Uint) NIN(slice []uint) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Uint) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Uint) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 type whereHelperint64 struct{ field string }
 
 func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -522,6 +470,7 @@ var ClaimWhere = struct {
 	TransactionHashID     whereHelpernull_String
 	Vout                  whereHelperuint
 	Name                  whereHelperstring
+	ShortURL              whereHelpernull_String
 	ClaimID               whereHelperstring
 	ClaimType             whereHelperint8
 	PublisherID           whereHelpernull_String
@@ -567,6 +516,7 @@ var ClaimWhere = struct {
 	Email                 whereHelpernull_String
 	HasClaimList          whereHelpernull_Bool
 	ClaimReference        whereHelpernull_String
+	RepostCount           whereHelperuint
 	ListType              whereHelpernull_Int16
 	ClaimIDList           whereHelpernull_JSON
 	TransactionHashUpdate whereHelpernull_String
@@ -577,6 +527,7 @@ var ClaimWhere = struct {
 	TransactionHashID:     whereHelpernull_String{field: "`claim`.`transaction_hash_id`"},
 	Vout:                  whereHelperuint{field: "`claim`.`vout`"},
 	Name:                  whereHelperstring{field: "`claim`.`name`"},
+	ShortURL:              whereHelpernull_String{field: "`claim`.`short_url`"},
 	ClaimID:               whereHelperstring{field: "`claim`.`claim_id`"},
 	ClaimType:             whereHelperint8{field: "`claim`.`claim_type`"},
 	PublisherID:           whereHelpernull_String{field: "`claim`.`publisher_id`"},
@@ -622,6 +573,7 @@ var ClaimWhere = struct {
 	Email:                 whereHelpernull_String{field: "`claim`.`email`"},
 	HasClaimList:          whereHelpernull_Bool{field: "`claim`.`has_claim_list`"},
 	ClaimReference:        whereHelpernull_String{field: "`claim`.`claim_reference`"},
+	RepostCount:           whereHelperuint{field: "`claim`.`repost_count`"},
 	ListType:              whereHelpernull_Int16{field: "`claim`.`list_type`"},
 	ClaimIDList:           whereHelpernull_JSON{field: "`claim`.`claim_id_list`"},
 	TransactionHashUpdate: whereHelpernull_String{field: "`claim`.`transaction_hash_update`"},
@@ -677,9 +629,9 @@ func (r *claimR) GetClaimTags() ClaimTagSlice {
 type claimL struct{}
 
 var (
-	claimAllColumns            = []string{"id", "transaction_hash_id", "vout", "name", "claim_id", "claim_type", "publisher_id", "publisher_sig", "certificate", "sd_hash", "transaction_time", "version", "value_as_hex", "value_as_json", "valid_at_height", "height", "effective_amount", "author", "description", "content_type", "is_nsfw", "language", "thumbnail_url", "title", "fee", "fee_currency", "fee_address", "is_filtered", "bid_state", "created_at", "modified_at", "claim_address", "is_cert_valid", "is_cert_processed", "license", "type", "release_time", "source_hash", "source_name", "source_size", "source_media_type", "source_url", "frame_width", "frame_height", "duration", "audio_duration", "email", "has_claim_list", "claim_reference", "list_type", "claim_id_list", "transaction_hash_update", "vout_update", "claim_count"}
-	claimColumnsWithoutDefault = []string{"transaction_hash_id", "vout", "name", "claim_id", "claim_type", "publisher_id", "publisher_sig", "certificate", "sd_hash", "transaction_time", "version", "value_as_hex", "value_as_json", "valid_at_height", "height", "author", "description", "content_type", "language", "thumbnail_url", "title", "fee_currency", "fee_address", "claim_address", "is_cert_valid", "is_cert_processed", "license", "type", "release_time", "source_hash", "source_name", "source_size", "source_media_type", "source_url", "frame_width", "frame_height", "duration", "audio_duration", "email", "has_claim_list", "claim_reference", "list_type", "claim_id_list", "transaction_hash_update", "vout_update"}
-	claimColumnsWithDefault    = []string{"id", "effective_amount", "is_nsfw", "fee", "is_filtered", "bid_state", "created_at", "modified_at", "claim_count"}
+	claimAllColumns            = []string{"id", "transaction_hash_id", "vout", "name", "short_url", "claim_id", "claim_type", "publisher_id", "publisher_sig", "certificate", "sd_hash", "transaction_time", "version", "value_as_hex", "value_as_json", "valid_at_height", "height", "effective_amount", "author", "description", "content_type", "is_nsfw", "language", "thumbnail_url", "title", "fee", "fee_currency", "fee_address", "is_filtered", "bid_state", "created_at", "modified_at", "claim_address", "is_cert_valid", "is_cert_processed", "license", "type", "release_time", "source_hash", "source_name", "source_size", "source_media_type", "source_url", "frame_width", "frame_height", "duration", "audio_duration", "email", "has_claim_list", "claim_reference", "repost_count", "list_type", "claim_id_list", "transaction_hash_update", "vout_update", "claim_count"}
+	claimColumnsWithoutDefault = []string{"transaction_hash_id", "vout", "name", "short_url", "claim_id", "claim_type", "publisher_id", "publisher_sig", "certificate", "sd_hash", "transaction_time", "version", "value_as_hex", "value_as_json", "valid_at_height", "height", "author", "description", "content_type", "language", "thumbnail_url", "title", "fee_currency", "fee_address", "claim_address", "is_cert_valid", "is_cert_processed", "license", "type", "release_time", "source_hash", "source_name", "source_size", "source_media_type", "source_url", "frame_width", "frame_height", "duration", "audio_duration", "email", "has_claim_list", "claim_reference", "list_type", "claim_id_list", "transaction_hash_update", "vout_update"}
+	claimColumnsWithDefault    = []string{"id", "effective_amount", "is_nsfw", "fee", "is_filtered", "bid_state", "created_at", "modified_at", "repost_count", "claim_count"}
 	claimPrimaryKeyColumns     = []string{"id"}
 	claimGeneratedColumns      = []string{}
 )
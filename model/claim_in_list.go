@@ -26,6 +26,7 @@ type ClaimInList struct {
 	ID          uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
 	ListClaimID string      `boil:"list_claim_id" json:"list_claim_id" toml:"list_claim_id" yaml:"list_claim_id"`
 	ClaimID     null.String `boil:"claim_id" json:"claim_id,omitempty" toml:"claim_id" yaml:"claim_id,omitempty"`
+	Position    uint        `boil:"position" json:"position" toml:"position" yaml:"position"`
 	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	ModifiedAt  time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
 
@@ -37,12 +38,14 @@ var ClaimInListColumns = struct {
 	ID          string
 	ListClaimID string
 	ClaimID     string
+	Position    string
 	CreatedAt   string
 	ModifiedAt  string
 }{
 	ID:          "id",
 	ListClaimID: "list_claim_id",
 	ClaimID:     "claim_id",
+	Position:    "position",
 	CreatedAt:   "created_at",
 	ModifiedAt:  "modified_at",
 }
@@ -51,12 +54,14 @@ var ClaimInListTableColumns = struct {
 	ID          string
 	ListClaimID string
 	ClaimID     string
+	Position    string
 	CreatedAt   string
 	ModifiedAt  string
 }{
 	ID:          "claim_in_list.id",
 	ListClaimID: "claim_in_list.list_claim_id",
 	ClaimID:     "claim_in_list.claim_id",
+	Position:    "claim_in_list.position",
 	CreatedAt:   "claim_in_list.created_at",
 	ModifiedAt:  "claim_in_list.modified_at",
 }
@@ -67,12 +72,14 @@ var ClaimInListWhere = struct {
 	ID          whereHelperuint64
 	ListClaimID whereHelperstring
 	ClaimID     whereHelpernull_String
+	Position    whereHelperuint
 	CreatedAt   whereHelpertime_Time
 	ModifiedAt  whereHelpertime_Time
 }{
 	ID:          whereHelperuint64{field: "`claim_in_list`.`id`"},
 	ListClaimID: whereHelperstring{field: "`claim_in_list`.`list_claim_id`"},
 	ClaimID:     whereHelpernull_String{field: "`claim_in_list`.`claim_id`"},
+	Position:    whereHelperuint{field: "`claim_in_list`.`position`"},
 	CreatedAt:   whereHelpertime_Time{field: "`claim_in_list`.`created_at`"},
 	ModifiedAt:  whereHelpertime_Time{field: "`claim_in_list`.`modified_at`"},
 }
@@ -105,9 +112,9 @@ func (r *claimInListR) GetListClaim() *Claim {
 type claimInListL struct{}
 
 var (
-	claimInListAllColumns            = []string{"id", "list_claim_id", "claim_id", "created_at", "modified_at"}
+	claimInListAllColumns            = []string{"id", "list_claim_id", "claim_id", "position", "created_at", "modified_at"}
 	claimInListColumnsWithoutDefault = []string{"list_claim_id", "claim_id"}
-	claimInListColumnsWithDefault    = []string{"id", "created_at", "modified_at"}
+	claimInListColumnsWithDefault    = []string{"id", "position", "created_at", "modified_at"}
 	claimInListPrimaryKeyColumns     = []string{"id"}
 	claimInListGeneratedColumns      = []string{}
 )
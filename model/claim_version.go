@@ -0,0 +1,1396 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ClaimVersion is an object representing the database table.
+type ClaimVersion struct {
+	ID              uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ClaimID         string      `boil:"claim_id" json:"claim_id" toml:"claim_id" yaml:"claim_id"`
+	TransactionHash string      `boil:"transaction_hash" json:"transaction_hash" toml:"transaction_hash" yaml:"transaction_hash"`
+	Vout            uint        `boil:"vout" json:"vout" toml:"vout" yaml:"vout"`
+	Height          uint        `boil:"height" json:"height" toml:"height" yaml:"height"`
+	IsUpdate        bool        `boil:"is_update" json:"is_update" toml:"is_update" yaml:"is_update"`
+	Name            string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ClaimAddress    string      `boil:"claim_address" json:"claim_address" toml:"claim_address" yaml:"claim_address"`
+	PublisherID     null.String `boil:"publisher_id" json:"publisher_id,omitempty" toml:"publisher_id" yaml:"publisher_id,omitempty"`
+	Type            null.String `boil:"type" json:"type,omitempty" toml:"type" yaml:"type,omitempty"`
+	Title           null.String `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	Description     null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	ThumbnailURL    null.String `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
+	Fee             float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	FeeCurrency     null.String `boil:"fee_currency" json:"fee_currency,omitempty" toml:"fee_currency" yaml:"fee_currency,omitempty"`
+	FeeAddress      null.String `boil:"fee_address" json:"fee_address,omitempty" toml:"fee_address" yaml:"fee_address,omitempty"`
+	ValueAsHex      string      `boil:"value_as_hex" json:"value_as_hex" toml:"value_as_hex" yaml:"value_as_hex"`
+	ValueAsJSON     null.JSON   `boil:"value_as_json" json:"value_as_json,omitempty" toml:"value_as_json" yaml:"value_as_json,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt      time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *claimVersionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L claimVersionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClaimVersionColumns = struct {
+	ID              string
+	ClaimID         string
+	TransactionHash string
+	Vout            string
+	Height          string
+	IsUpdate        string
+	Name            string
+	ClaimAddress    string
+	PublisherID     string
+	Type            string
+	Title           string
+	Description     string
+	ThumbnailURL    string
+	Fee             string
+	FeeCurrency     string
+	FeeAddress      string
+	ValueAsHex      string
+	ValueAsJSON     string
+	CreatedAt       string
+	ModifiedAt      string
+}{
+	ID:              "id",
+	ClaimID:         "claim_id",
+	TransactionHash: "transaction_hash",
+	Vout:            "vout",
+	Height:          "height",
+	IsUpdate:        "is_update",
+	Name:            "name",
+	ClaimAddress:    "claim_address",
+	PublisherID:     "publisher_id",
+	Type:            "type",
+	Title:           "title",
+	Description:     "description",
+	ThumbnailURL:    "thumbnail_url",
+	Fee:             "fee",
+	FeeCurrency:     "fee_currency",
+	FeeAddress:      "fee_address",
+	ValueAsHex:      "value_as_hex",
+	ValueAsJSON:     "value_as_json",
+	CreatedAt:       "created_at",
+	ModifiedAt:      "modified_at",
+}
+
+var ClaimVersionTableColumns = struct {
+	ID              string
+	ClaimID         string
+	TransactionHash string
+	Vout            string
+	Height          string
+	IsUpdate        string
+	Name            string
+	ClaimAddress    string
+	PublisherID     string
+	Type            string
+	Title           string
+	Description     string
+	ThumbnailURL    string
+	Fee             string
+	FeeCurrency     string
+	FeeAddress      string
+	ValueAsHex      string
+	ValueAsJSON     string
+	CreatedAt       string
+	ModifiedAt      string
+}{
+	ID:              "claim_version.id",
+	ClaimID:         "claim_version.claim_id",
+	TransactionHash: "claim_version.transaction_hash",
+	Vout:            "claim_version.vout",
+	Height:          "claim_version.height",
+	IsUpdate:        "claim_version.is_update",
+	Name:            "claim_version.name",
+	ClaimAddress:    "claim_version.claim_address",
+	PublisherID:     "claim_version.publisher_id",
+	Type:            "claim_version.type",
+	Title:           "claim_version.title",
+	Description:     "claim_version.description",
+	ThumbnailURL:    "claim_version.thumbnail_url",
+	Fee:             "claim_version.fee",
+	FeeCurrency:     "claim_version.fee_currency",
+	FeeAddress:      "claim_version.fee_address",
+	ValueAsHex:      "claim_version.value_as_hex",
+	ValueAsJSON:     "claim_version.value_as_json",
+	CreatedAt:       "claim_version.created_at",
+	ModifiedAt:      "claim_version.modified_at",
+}
+
+// Generated where
+
+var ClaimVersionWhere = struct {
+	ID              whereHelperuint64
+	ClaimID         whereHelperstring
+	TransactionHash whereHelperstring
+	Vout            whereHelperuint
+	Height          whereHelperuint
+	IsUpdate        whereHelperbool
+	Name            whereHelperstring
+	ClaimAddress    whereHelperstring
+	PublisherID     whereHelpernull_String
+	Type            whereHelpernull_String
+	Title           whereHelpernull_String
+	Description     whereHelpernull_String
+	ThumbnailURL    whereHelpernull_String
+	Fee             whereHelperfloat64
+	FeeCurrency     whereHelpernull_String
+	FeeAddress      whereHelpernull_String
+	ValueAsHex      whereHelperstring
+	ValueAsJSON     whereHelpernull_JSON
+	CreatedAt       whereHelpertime_Time
+	ModifiedAt      whereHelpertime_Time
+}{
+	ID:              whereHelperuint64{field: "`claim_version`.`id`"},
+	ClaimID:         whereHelperstring{field: "`claim_version`.`claim_id`"},
+	TransactionHash: whereHelperstring{field: "`claim_version`.`transaction_hash`"},
+	Vout:            whereHelperuint{field: "`claim_version`.`vout`"},
+	Height:          whereHelperuint{field: "`claim_version`.`height`"},
+	IsUpdate:        whereHelperbool{field: "`claim_version`.`is_update`"},
+	Name:            whereHelperstring{field: "`claim_version`.`name`"},
+	ClaimAddress:    whereHelperstring{field: "`claim_version`.`claim_address`"},
+	PublisherID:     whereHelpernull_String{field: "`claim_version`.`publisher_id`"},
+	Type:            whereHelpernull_String{field: "`claim_version`.`type`"},
+	Title:           whereHelpernull_String{field: "`claim_version`.`title`"},
+	Description:     whereHelpernull_String{field: "`claim_version`.`description`"},
+	ThumbnailURL:    whereHelpernull_String{field: "`claim_version`.`thumbnail_url`"},
+	Fee:             whereHelperfloat64{field: "`claim_version`.`fee`"},
+	FeeCurrency:     whereHelpernull_String{field: "`claim_version`.`fee_currency`"},
+	FeeAddress:      whereHelpernull_String{field: "`claim_version`.`fee_address`"},
+	ValueAsHex:      whereHelperstring{field: "`claim_version`.`value_as_hex`"},
+	ValueAsJSON:     whereHelpernull_JSON{field: "`claim_version`.`value_as_json`"},
+	CreatedAt:       whereHelpertime_Time{field: "`claim_version`.`created_at`"},
+	ModifiedAt:      whereHelpertime_Time{field: "`claim_version`.`modified_at`"},
+}
+
+// ClaimVersionRels is where relationship names are stored.
+var ClaimVersionRels = struct {
+	TransactionHashTransaction string
+}{
+	TransactionHashTransaction: "TransactionHashTransaction",
+}
+
+// claimVersionR is where relationships are stored.
+type claimVersionR struct {
+	TransactionHashTransaction *Transaction `boil:"TransactionHashTransaction" json:"TransactionHashTransaction" toml:"TransactionHashTransaction" yaml:"TransactionHashTransaction"`
+}
+
+// NewStruct creates a new relationship struct
+func (*claimVersionR) NewStruct() *claimVersionR {
+	return &claimVersionR{}
+}
+
+func (r *claimVersionR) GetTransactionHashTransaction() *Transaction {
+	if r == nil {
+		return nil
+	}
+	return r.TransactionHashTransaction
+}
+
+// claimVersionL is where Load methods for each relationship are stored.
+type claimVersionL struct{}
+
+var (
+	claimVersionAllColumns            = []string{"id", "claim_id", "transaction_hash", "vout", "height", "is_update", "name", "claim_address", "publisher_id", "type", "title", "description", "thumbnail_url", "fee", "fee_currency", "fee_address", "value_as_hex", "value_as_json", "created_at", "modified_at"}
+	claimVersionColumnsWithoutDefault = []string{"claim_id", "transaction_hash", "vout", "height", "name", "claim_address", "publisher_id", "type", "title", "description", "thumbnail_url", "fee_currency", "fee_address", "value_as_hex", "value_as_json"}
+	claimVersionColumnsWithDefault    = []string{"id", "is_update", "fee", "created_at", "modified_at"}
+	claimVersionPrimaryKeyColumns     = []string{"id"}
+	claimVersionGeneratedColumns      = []string{}
+)
+
+type (
+	// ClaimVersionSlice is an alias for a slice of pointers to ClaimVersion.
+	// This should almost always be used instead of []ClaimVersion.
+	ClaimVersionSlice []*ClaimVersion
+
+	claimVersionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	claimVersionType                 = reflect.TypeOf(&ClaimVersion{})
+	claimVersionMapping              = queries.MakeStructMapping(claimVersionType)
+	claimVersionPrimaryKeyMapping, _ = queries.BindMapping(claimVersionType, claimVersionMapping, claimVersionPrimaryKeyColumns)
+	claimVersionInsertCacheMut       sync.RWMutex
+	claimVersionInsertCache          = make(map[string]insertCache)
+	claimVersionUpdateCacheMut       sync.RWMutex
+	claimVersionUpdateCache          = make(map[string]updateCache)
+	claimVersionUpsertCacheMut       sync.RWMutex
+	claimVersionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single claimVersion record from the query using the global executor.
+func (q claimVersionQuery) OneG() (*ClaimVersion, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single claimVersion record from the query using the global executor, and panics on error.
+func (q claimVersionQuery) OneGP() *ClaimVersion {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single claimVersion record from the query, and panics on error.
+func (q claimVersionQuery) OneP(exec boil.Executor) *ClaimVersion {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single claimVersion record from the query.
+func (q claimVersionQuery) One(exec boil.Executor) (*ClaimVersion, error) {
+	o := &ClaimVersion{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for claim_version")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ClaimVersion records from the query using the global executor.
+func (q claimVersionQuery) AllG() (ClaimVersionSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all ClaimVersion records from the query using the global executor, and panics on error.
+func (q claimVersionQuery) AllGP() ClaimVersionSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all ClaimVersion records from the query, and panics on error.
+func (q claimVersionQuery) AllP(exec boil.Executor) ClaimVersionSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all ClaimVersion records from the query.
+func (q claimVersionQuery) All(exec boil.Executor) (ClaimVersionSlice, error) {
+	var o []*ClaimVersion
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ClaimVersion slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ClaimVersion records in the query using the global executor
+func (q claimVersionQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all ClaimVersion records in the query using the global executor, and panics on error.
+func (q claimVersionQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all ClaimVersion records in the query, and panics on error.
+func (q claimVersionQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all ClaimVersion records in the query.
+func (q claimVersionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count claim_version rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q claimVersionQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q claimVersionQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q claimVersionQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q claimVersionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if claim_version exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransactionHashTransaction pointed to by the foreign key.
+func (o *ClaimVersion) TransactionHashTransaction(mods ...qm.QueryMod) transactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hash` = ?", o.TransactionHash),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Transactions(queryMods...)
+}
+
+// LoadTransactionHashTransaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (claimVersionL) LoadTransactionHashTransaction(e boil.Executor, singular bool, maybeClaimVersion interface{}, mods queries.Applicator) error {
+	var slice []*ClaimVersion
+	var object *ClaimVersion
+
+	if singular {
+		var ok bool
+		object, ok = maybeClaimVersion.(*ClaimVersion)
+		if !ok {
+			object = new(ClaimVersion)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClaimVersion)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClaimVersion))
+			}
+		}
+	} else {
+		s, ok := maybeClaimVersion.(*[]*ClaimVersion)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClaimVersion)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClaimVersion))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &claimVersionR{}
+		}
+		args[object.TransactionHash] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &claimVersionR{}
+			}
+
+			args[obj.TransactionHash] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.hash in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransactionHashTransaction = foreign
+		if foreign.R == nil {
+			foreign.R = &transactionR{}
+		}
+		foreign.R.TransactionHashClaimVersions = append(foreign.R.TransactionHashClaimVersions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TransactionHash == foreign.Hash {
+				local.R.TransactionHashTransaction = foreign
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.TransactionHashClaimVersions = append(foreign.R.TransactionHashClaimVersions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTransactionHashTransactionG of the claimVersion to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashClaimVersions.
+// Uses the global database handle.
+func (o *ClaimVersion) SetTransactionHashTransactionG(insert bool, related *Transaction) error {
+	return o.SetTransactionHashTransaction(boil.GetDB(), insert, related)
+}
+
+// SetTransactionHashTransactionP of the claimVersion to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashClaimVersions.
+// Panics on error.
+func (o *ClaimVersion) SetTransactionHashTransactionP(exec boil.Executor, insert bool, related *Transaction) {
+	if err := o.SetTransactionHashTransaction(exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashTransactionGP of the claimVersion to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashClaimVersions.
+// Uses the global database handle and panics on error.
+func (o *ClaimVersion) SetTransactionHashTransactionGP(insert bool, related *Transaction) {
+	if err := o.SetTransactionHashTransaction(boil.GetDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashTransaction of the claimVersion to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashClaimVersions.
+func (o *ClaimVersion) SetTransactionHashTransaction(exec boil.Executor, insert bool, related *Transaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `claim_version` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"transaction_hash"}),
+		strmangle.WhereClause("`", "`", 0, claimVersionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Hash, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TransactionHash = related.Hash
+	if o.R == nil {
+		o.R = &claimVersionR{
+			TransactionHashTransaction: related,
+		}
+	} else {
+		o.R.TransactionHashTransaction = related
+	}
+
+	if related.R == nil {
+		related.R = &transactionR{
+			TransactionHashClaimVersions: ClaimVersionSlice{o},
+		}
+	} else {
+		related.R.TransactionHashClaimVersions = append(related.R.TransactionHashClaimVersions, o)
+	}
+
+	return nil
+}
+
+// ClaimVersions retrieves all the records using an executor.
+func ClaimVersions(mods ...qm.QueryMod) claimVersionQuery {
+	mods = append(mods, qm.From("`claim_version`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`claim_version`.*"})
+	}
+
+	return claimVersionQuery{q}
+}
+
+// FindClaimVersionG retrieves a single record by ID.
+func FindClaimVersionG(iD uint64, selectCols ...string) (*ClaimVersion, error) {
+	return FindClaimVersion(boil.GetDB(), iD, selectCols...)
+}
+
+// FindClaimVersionP retrieves a single record by ID with an executor, and panics on error.
+func FindClaimVersionP(exec boil.Executor, iD uint64, selectCols ...string) *ClaimVersion {
+	retobj, err := FindClaimVersion(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindClaimVersionGP retrieves a single record by ID, and panics on error.
+func FindClaimVersionGP(iD uint64, selectCols ...string) *ClaimVersion {
+	retobj, err := FindClaimVersion(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindClaimVersion retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClaimVersion(exec boil.Executor, iD uint64, selectCols ...string) (*ClaimVersion, error) {
+	claimVersionObj := &ClaimVersion{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `claim_version` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, claimVersionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from claim_version")
+	}
+
+	return claimVersionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ClaimVersion) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *ClaimVersion) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *ClaimVersion) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ClaimVersion) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no claim_version provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(claimVersionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	claimVersionInsertCacheMut.RLock()
+	cache, cached := claimVersionInsertCache[key]
+	claimVersionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			claimVersionAllColumns,
+			claimVersionColumnsWithDefault,
+			claimVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(claimVersionType, claimVersionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(claimVersionType, claimVersionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `claim_version` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `claim_version` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `claim_version` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, claimVersionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into claim_version")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == claimVersionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for claim_version")
+	}
+
+CacheNoHooks:
+	if !cached {
+		claimVersionInsertCacheMut.Lock()
+		claimVersionInsertCache[key] = cache
+		claimVersionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ClaimVersion record using the global executor.
+// See Update for more documentation.
+func (o *ClaimVersion) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the ClaimVersion, and panics on error.
+// See Update for more documentation.
+func (o *ClaimVersion) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single ClaimVersion record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *ClaimVersion) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the ClaimVersion.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ClaimVersion) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	claimVersionUpdateCacheMut.RLock()
+	cache, cached := claimVersionUpdateCache[key]
+	claimVersionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			claimVersionAllColumns,
+			claimVersionPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update claim_version, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `claim_version` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, claimVersionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(claimVersionType, claimVersionMapping, append(wl, claimVersionPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update claim_version row")
+	}
+
+	if !cached {
+		claimVersionUpdateCacheMut.Lock()
+		claimVersionUpdateCache[key] = cache
+		claimVersionUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q claimVersionQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q claimVersionQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q claimVersionQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q claimVersionQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for claim_version")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ClaimVersionSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o ClaimVersionSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o ClaimVersionSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClaimVersionSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), claimVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `claim_version` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, claimVersionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in claimVersion slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ClaimVersion) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *ClaimVersion) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *ClaimVersion) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLClaimVersionUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ClaimVersion) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no claim_version provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(claimVersionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLClaimVersionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	claimVersionUpsertCacheMut.RLock()
+	cache, cached := claimVersionUpsertCache[key]
+	claimVersionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			claimVersionAllColumns,
+			claimVersionColumnsWithDefault,
+			claimVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			claimVersionAllColumns,
+			claimVersionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert claim_version, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(claimVersionAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`claim_version`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `claim_version` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(claimVersionType, claimVersionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(claimVersionType, claimVersionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for claim_version")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == claimVersionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(claimVersionType, claimVersionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for claim_version")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for claim_version")
+	}
+
+CacheNoHooks:
+	if !cached {
+		claimVersionUpsertCacheMut.Lock()
+		claimVersionUpsertCache[key] = cache
+		claimVersionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ClaimVersion record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ClaimVersion) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single ClaimVersion record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ClaimVersion) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single ClaimVersion record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *ClaimVersion) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single ClaimVersion record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ClaimVersion) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no ClaimVersion provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), claimVersionPrimaryKeyMapping)
+	sql := "DELETE FROM `claim_version` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from claim_version")
+	}
+
+	return nil
+}
+
+func (q claimVersionQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q claimVersionQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q claimVersionQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q claimVersionQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no claimVersionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from claim_version")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ClaimVersionSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o ClaimVersionSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o ClaimVersionSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClaimVersionSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), claimVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `claim_version` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, claimVersionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from claimVersion slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ClaimVersion) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no ClaimVersion provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *ClaimVersion) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *ClaimVersion) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ClaimVersion) Reload(exec boil.Executor) error {
+	ret, err := FindClaimVersion(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClaimVersionSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty ClaimVersionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ClaimVersionSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *ClaimVersionSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClaimVersionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClaimVersionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), claimVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `claim_version`.* FROM `claim_version` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, claimVersionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ClaimVersionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClaimVersionExistsG checks if the ClaimVersion row exists.
+func ClaimVersionExistsG(iD uint64) (bool, error) {
+	return ClaimVersionExists(boil.GetDB(), iD)
+}
+
+// ClaimVersionExistsP checks if the ClaimVersion row exists. Panics on error.
+func ClaimVersionExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := ClaimVersionExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ClaimVersionExistsGP checks if the ClaimVersion row exists. Panics on error.
+func ClaimVersionExistsGP(iD uint64) bool {
+	e, err := ClaimVersionExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ClaimVersionExists checks if the ClaimVersion row exists.
+func ClaimVersionExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `claim_version` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if claim_version exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ClaimVersion row exists.
+func (o *ClaimVersion) Exists(exec boil.Executor) (bool, error) {
+	return ClaimVersionExists(exec, o.ID)
+}
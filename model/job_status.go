@@ -27,6 +27,7 @@ type JobStatus struct {
 	LastSync     time.Time   `boil:"last_sync" json:"last_sync" toml:"last_sync" yaml:"last_sync"`
 	IsSuccess    bool        `boil:"is_success" json:"is_success" toml:"is_success" yaml:"is_success"`
 	ErrorMessage null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	Failures     uint        `boil:"failures" json:"failures" toml:"failures" yaml:"failures"`
 	State        null.JSON   `boil:"state" json:"state,omitempty" toml:"state" yaml:"state,omitempty"`
 
 	R *jobStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,12 +39,14 @@ var JobStatusColumns = struct {
 	LastSync     string
 	IsSuccess    string
 	ErrorMessage string
+	Failures     string
 	State        string
 }{
 	JobName:      "job_name",
 	LastSync:     "last_sync",
 	IsSuccess:    "is_success",
 	ErrorMessage: "error_message",
+	Failures:     "failures",
 	State:        "state",
 }
 
@@ -52,12 +55,14 @@ var JobStatusTableColumns = struct {
 	LastSync     string
 	IsSuccess    string
 	ErrorMessage string
+	Failures     string
 	State        string
 }{
 	JobName:      "job_status.job_name",
 	LastSync:     "job_status.last_sync",
 	IsSuccess:    "job_status.is_success",
 	ErrorMessage: "job_status.error_message",
+	Failures:     "job_status.failures",
 	State:        "job_status.state",
 }
 
@@ -68,12 +73,14 @@ var JobStatusWhere = struct {
 	LastSync     whereHelpertime_Time
 	IsSuccess    whereHelperbool
 	ErrorMessage whereHelpernull_String
+	Failures     whereHelperuint
 	State        whereHelpernull_JSON
 }{
 	JobName:      whereHelperstring{field: "`job_status`.`job_name`"},
 	LastSync:     whereHelpertime_Time{field: "`job_status`.`last_sync`"},
 	IsSuccess:    whereHelperbool{field: "`job_status`.`is_success`"},
 	ErrorMessage: whereHelpernull_String{field: "`job_status`.`error_message`"},
+	Failures:     whereHelperuint{field: "`job_status`.`failures`"},
 	State:        whereHelpernull_JSON{field: "`job_status`.`state`"},
 }
 
@@ -94,9 +101,9 @@ func (*jobStatusR) NewStruct() *jobStatusR {
 type jobStatusL struct{}
 
 var (
-	jobStatusAllColumns            = []string{"job_name", "last_sync", "is_success", "error_message", "state"}
+	jobStatusAllColumns            = []string{"job_name", "last_sync", "is_success", "error_message", "failures", "state"}
 	jobStatusColumnsWithoutDefault = []string{"job_name", "error_message", "state"}
-	jobStatusColumnsWithDefault    = []string{"last_sync", "is_success"}
+	jobStatusColumnsWithDefault    = []string{"last_sync", "is_success", "failures"}
 	jobStatusPrimaryKeyColumns     = []string{"job_name"}
 	jobStatusGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1440 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NotificationOutbox is an object representing the database table.
+type NotificationOutbox struct {
+	ID                uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	EventID           string      `boil:"event_id" json:"event_id" toml:"event_id" yaml:"event_id"`
+	Subscriber        string      `boil:"subscriber" json:"subscriber" toml:"subscriber" yaml:"subscriber"`
+	Type              string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Payload           string      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	TransactionHash   null.String `boil:"transaction_hash" json:"transaction_hash,omitempty" toml:"transaction_hash" yaml:"transaction_hash,omitempty"`
+	Depth             uint        `boil:"depth" json:"depth" toml:"depth" yaml:"depth"`
+	Reverted          bool        `boil:"reverted" json:"reverted" toml:"reverted" yaml:"reverted"`
+	RevertedBlockHash null.String `boil:"reverted_block_hash" json:"reverted_block_hash,omitempty" toml:"reverted_block_hash" yaml:"reverted_block_hash,omitempty"`
+	RevertedHeight    null.Uint   `boil:"reverted_height" json:"reverted_height,omitempty" toml:"reverted_height" yaml:"reverted_height,omitempty"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts          uint        `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt     time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError         null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	DeliveredAt       null.Time   `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt        time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *notificationOutboxR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationOutboxL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationOutboxColumns = struct {
+	ID                string
+	EventID           string
+	Subscriber        string
+	Type              string
+	Payload           string
+	TransactionHash   string
+	Depth             string
+	Reverted          string
+	RevertedBlockHash string
+	RevertedHeight    string
+	Status            string
+	Attempts          string
+	NextAttemptAt     string
+	LastError         string
+	DeliveredAt       string
+	CreatedAt         string
+	ModifiedAt        string
+}{
+	ID:                "id",
+	EventID:           "event_id",
+	Subscriber:        "subscriber",
+	Type:              "type",
+	Payload:           "payload",
+	TransactionHash:   "transaction_hash",
+	Depth:             "depth",
+	Reverted:          "reverted",
+	RevertedBlockHash: "reverted_block_hash",
+	RevertedHeight:    "reverted_height",
+	Status:            "status",
+	Attempts:          "attempts",
+	NextAttemptAt:     "next_attempt_at",
+	LastError:         "last_error",
+	DeliveredAt:       "delivered_at",
+	CreatedAt:         "created_at",
+	ModifiedAt:        "modified_at",
+}
+
+var NotificationOutboxTableColumns = struct {
+	ID                string
+	EventID           string
+	Subscriber        string
+	Type              string
+	Payload           string
+	TransactionHash   string
+	Depth             string
+	Reverted          string
+	RevertedBlockHash string
+	RevertedHeight    string
+	Status            string
+	Attempts          string
+	NextAttemptAt     string
+	LastError         string
+	DeliveredAt       string
+	CreatedAt         string
+	ModifiedAt        string
+}{
+	ID:                "notification_outbox.id",
+	EventID:           "notification_outbox.event_id",
+	Subscriber:        "notification_outbox.subscriber",
+	Type:              "notification_outbox.type",
+	Payload:           "notification_outbox.payload",
+	TransactionHash:   "notification_outbox.transaction_hash",
+	Depth:             "notification_outbox.depth",
+	Reverted:          "notification_outbox.reverted",
+	RevertedBlockHash: "notification_outbox.reverted_block_hash",
+	RevertedHeight:    "notification_outbox.reverted_height",
+	Status:            "notification_outbox.status",
+	Attempts:          "notification_outbox.attempts",
+	NextAttemptAt:     "notification_outbox.next_attempt_at",
+	LastError:         "notification_outbox.last_error",
+	DeliveredAt:       "notification_outbox.delivered_at",
+	CreatedAt:         "notification_outbox.created_at",
+	ModifiedAt:        "notification_outbox.modified_at",
+}
+
+// Generated where
+
+var NotificationOutboxWhere = struct {
+	ID                whereHelperuint64
+	EventID           whereHelperstring
+	Subscriber        whereHelperstring
+	Type              whereHelperstring
+	Payload           whereHelperstring
+	TransactionHash   whereHelpernull_String
+	Depth             whereHelperuint
+	Reverted          whereHelperbool
+	RevertedBlockHash whereHelpernull_String
+	RevertedHeight    whereHelpernull_Uint
+	Status            whereHelperstring
+	Attempts          whereHelperuint
+	NextAttemptAt     whereHelpertime_Time
+	LastError         whereHelpernull_String
+	DeliveredAt       whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	ModifiedAt        whereHelpertime_Time
+}{
+	ID:                whereHelperuint64{field: "`notification_outbox`.`id`"},
+	EventID:           whereHelperstring{field: "`notification_outbox`.`event_id`"},
+	Subscriber:        whereHelperstring{field: "`notification_outbox`.`subscriber`"},
+	Type:              whereHelperstring{field: "`notification_outbox`.`type`"},
+	Payload:           whereHelperstring{field: "`notification_outbox`.`payload`"},
+	TransactionHash:   whereHelpernull_String{field: "`notification_outbox`.`transaction_hash`"},
+	Depth:             whereHelperuint{field: "`notification_outbox`.`depth`"},
+	Reverted:          whereHelperbool{field: "`notification_outbox`.`reverted`"},
+	RevertedBlockHash: whereHelpernull_String{field: "`notification_outbox`.`reverted_block_hash`"},
+	RevertedHeight:    whereHelpernull_Uint{field: "`notification_outbox`.`reverted_height`"},
+	Status:            whereHelperstring{field: "`notification_outbox`.`status`"},
+	Attempts:          whereHelperuint{field: "`notification_outbox`.`attempts`"},
+	NextAttemptAt:     whereHelpertime_Time{field: "`notification_outbox`.`next_attempt_at`"},
+	LastError:         whereHelpernull_String{field: "`notification_outbox`.`last_error`"},
+	DeliveredAt:       whereHelpernull_Time{field: "`notification_outbox`.`delivered_at`"},
+	CreatedAt:         whereHelpertime_Time{field: "`notification_outbox`.`created_at`"},
+	ModifiedAt:        whereHelpertime_Time{field: "`notification_outbox`.`modified_at`"},
+}
+
+// NotificationOutboxRels is where relationship names are stored.
+var NotificationOutboxRels = struct {
+	TransactionHashTransaction string
+}{
+	TransactionHashTransaction: "TransactionHashTransaction",
+}
+
+// notificationOutboxR is where relationships are stored.
+type notificationOutboxR struct {
+	TransactionHashTransaction *Transaction `boil:"TransactionHashTransaction" json:"TransactionHashTransaction" toml:"TransactionHashTransaction" yaml:"TransactionHashTransaction"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationOutboxR) NewStruct() *notificationOutboxR {
+	return &notificationOutboxR{}
+}
+
+func (r *notificationOutboxR) GetTransactionHashTransaction() *Transaction {
+	if r == nil {
+		return nil
+	}
+	return r.TransactionHashTransaction
+}
+
+// notificationOutboxL is where Load methods for each relationship are stored.
+type notificationOutboxL struct{}
+
+var (
+	notificationOutboxAllColumns            = []string{"id", "event_id", "subscriber", "type", "payload", "transaction_hash", "depth", "reverted", "reverted_block_hash", "reverted_height", "status", "attempts", "next_attempt_at", "last_error", "delivered_at", "created_at", "modified_at"}
+	notificationOutboxColumnsWithoutDefault = []string{"event_id", "subscriber", "type", "payload", "transaction_hash", "reverted_block_hash", "reverted_height", "last_error", "delivered_at"}
+	notificationOutboxColumnsWithDefault    = []string{"id", "depth", "reverted", "status", "attempts", "next_attempt_at", "created_at", "modified_at"}
+	notificationOutboxPrimaryKeyColumns     = []string{"id"}
+	notificationOutboxGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationOutboxSlice is an alias for a slice of pointers to NotificationOutbox.
+	// This should almost always be used instead of []NotificationOutbox.
+	NotificationOutboxSlice []*NotificationOutbox
+
+	notificationOutboxQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationOutboxType                 = reflect.TypeOf(&NotificationOutbox{})
+	notificationOutboxMapping              = queries.MakeStructMapping(notificationOutboxType)
+	notificationOutboxPrimaryKeyMapping, _ = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, notificationOutboxPrimaryKeyColumns)
+	notificationOutboxInsertCacheMut       sync.RWMutex
+	notificationOutboxInsertCache          = make(map[string]insertCache)
+	notificationOutboxUpdateCacheMut       sync.RWMutex
+	notificationOutboxUpdateCache          = make(map[string]updateCache)
+	notificationOutboxUpsertCacheMut       sync.RWMutex
+	notificationOutboxUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single notificationOutbox record from the query using the global executor.
+func (q notificationOutboxQuery) OneG() (*NotificationOutbox, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single notificationOutbox record from the query using the global executor, and panics on error.
+func (q notificationOutboxQuery) OneGP() *NotificationOutbox {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single notificationOutbox record from the query, and panics on error.
+func (q notificationOutboxQuery) OneP(exec boil.Executor) *NotificationOutbox {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single notificationOutbox record from the query.
+func (q notificationOutboxQuery) One(exec boil.Executor) (*NotificationOutbox, error) {
+	o := &NotificationOutbox{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for notification_outbox")
+	}
+
+	return o, nil
+}
+
+// AllG returns all NotificationOutbox records from the query using the global executor.
+func (q notificationOutboxQuery) AllG() (NotificationOutboxSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all NotificationOutbox records from the query using the global executor, and panics on error.
+func (q notificationOutboxQuery) AllGP() NotificationOutboxSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all NotificationOutbox records from the query, and panics on error.
+func (q notificationOutboxQuery) AllP(exec boil.Executor) NotificationOutboxSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all NotificationOutbox records from the query.
+func (q notificationOutboxQuery) All(exec boil.Executor) (NotificationOutboxSlice, error) {
+	var o []*NotificationOutbox
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to NotificationOutbox slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all NotificationOutbox records in the query using the global executor
+func (q notificationOutboxQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all NotificationOutbox records in the query using the global executor, and panics on error.
+func (q notificationOutboxQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all NotificationOutbox records in the query, and panics on error.
+func (q notificationOutboxQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all NotificationOutbox records in the query.
+func (q notificationOutboxQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count notification_outbox rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q notificationOutboxQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q notificationOutboxQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q notificationOutboxQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationOutboxQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if notification_outbox exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransactionHashTransaction pointed to by the foreign key.
+func (o *NotificationOutbox) TransactionHashTransaction(mods ...qm.QueryMod) transactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hash` = ?", o.TransactionHash),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Transactions(queryMods...)
+}
+
+// LoadTransactionHashTransaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationOutboxL) LoadTransactionHashTransaction(e boil.Executor, singular bool, maybeNotificationOutbox interface{}, mods queries.Applicator) error {
+	var slice []*NotificationOutbox
+	var object *NotificationOutbox
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationOutbox.(*NotificationOutbox)
+		if !ok {
+			object = new(NotificationOutbox)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationOutbox)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationOutbox))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationOutbox.(*[]*NotificationOutbox)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationOutbox)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationOutbox))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationOutboxR{}
+		}
+		if !queries.IsNil(object.TransactionHash) {
+			args[object.TransactionHash] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationOutboxR{}
+			}
+
+			if !queries.IsNil(obj.TransactionHash) {
+				args[obj.TransactionHash] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.hash in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransactionHashTransaction = foreign
+		if foreign.R == nil {
+			foreign.R = &transactionR{}
+		}
+		foreign.R.TransactionHashNotificationOutboxes = append(foreign.R.TransactionHashNotificationOutboxes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransactionHash, foreign.Hash) {
+				local.R.TransactionHashTransaction = foreign
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.TransactionHashNotificationOutboxes = append(foreign.R.TransactionHashNotificationOutboxes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTransactionHashTransactionG of the notificationOutbox to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashNotificationOutboxes.
+// Uses the global database handle.
+func (o *NotificationOutbox) SetTransactionHashTransactionG(insert bool, related *Transaction) error {
+	return o.SetTransactionHashTransaction(boil.GetDB(), insert, related)
+}
+
+// SetTransactionHashTransactionP of the notificationOutbox to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashNotificationOutboxes.
+// Panics on error.
+func (o *NotificationOutbox) SetTransactionHashTransactionP(exec boil.Executor, insert bool, related *Transaction) {
+	if err := o.SetTransactionHashTransaction(exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashTransactionGP of the notificationOutbox to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashNotificationOutboxes.
+// Uses the global database handle and panics on error.
+func (o *NotificationOutbox) SetTransactionHashTransactionGP(insert bool, related *Transaction) {
+	if err := o.SetTransactionHashTransaction(boil.GetDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashTransaction of the notificationOutbox to the related item.
+// Sets o.R.TransactionHashTransaction to related.
+// Adds o to related.R.TransactionHashNotificationOutboxes.
+func (o *NotificationOutbox) SetTransactionHashTransaction(exec boil.Executor, insert bool, related *Transaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notification_outbox` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"transaction_hash"}),
+		strmangle.WhereClause("`", "`", 0, notificationOutboxPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Hash, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransactionHash, related.Hash)
+	if o.R == nil {
+		o.R = &notificationOutboxR{
+			TransactionHashTransaction: related,
+		}
+	} else {
+		o.R.TransactionHashTransaction = related
+	}
+
+	if related.R == nil {
+		related.R = &transactionR{
+			TransactionHashNotificationOutboxes: NotificationOutboxSlice{o},
+		}
+	} else {
+		related.R.TransactionHashNotificationOutboxes = append(related.R.TransactionHashNotificationOutboxes, o)
+	}
+
+	return nil
+}
+
+// RemoveTransactionHashTransactionG relationship.
+// Sets o.R.TransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *NotificationOutbox) RemoveTransactionHashTransactionG(related *Transaction) error {
+	return o.RemoveTransactionHashTransaction(boil.GetDB(), related)
+}
+
+// RemoveTransactionHashTransactionP relationship.
+// Sets o.R.TransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Panics on error.
+func (o *NotificationOutbox) RemoveTransactionHashTransactionP(exec boil.Executor, related *Transaction) {
+	if err := o.RemoveTransactionHashTransaction(exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveTransactionHashTransactionGP relationship.
+// Sets o.R.TransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle and panics on error.
+func (o *NotificationOutbox) RemoveTransactionHashTransactionGP(related *Transaction) {
+	if err := o.RemoveTransactionHashTransaction(boil.GetDB(), related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveTransactionHashTransaction relationship.
+// Sets o.R.TransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *NotificationOutbox) RemoveTransactionHashTransaction(exec boil.Executor, related *Transaction) error {
+	var err error
+
+	queries.SetScanner(&o.TransactionHash, nil)
+	if err = o.Update(exec, boil.Whitelist("transaction_hash")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TransactionHashTransaction = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TransactionHashNotificationOutboxes {
+		if queries.Equal(o.TransactionHash, ri.TransactionHash) {
+			continue
+		}
+
+		ln := len(related.R.TransactionHashNotificationOutboxes)
+		if ln > 1 && i < ln-1 {
+			related.R.TransactionHashNotificationOutboxes[i] = related.R.TransactionHashNotificationOutboxes[ln-1]
+		}
+		related.R.TransactionHashNotificationOutboxes = related.R.TransactionHashNotificationOutboxes[:ln-1]
+		break
+	}
+	return nil
+}
+
+// NotificationOutboxes retrieves all the records using an executor.
+func NotificationOutboxes(mods ...qm.QueryMod) notificationOutboxQuery {
+	mods = append(mods, qm.From("`notification_outbox`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`notification_outbox`.*"})
+	}
+
+	return notificationOutboxQuery{q}
+}
+
+// FindNotificationOutboxG retrieves a single record by ID.
+func FindNotificationOutboxG(iD uint64, selectCols ...string) (*NotificationOutbox, error) {
+	return FindNotificationOutbox(boil.GetDB(), iD, selectCols...)
+}
+
+// FindNotificationOutboxP retrieves a single record by ID with an executor, and panics on error.
+func FindNotificationOutboxP(exec boil.Executor, iD uint64, selectCols ...string) *NotificationOutbox {
+	retobj, err := FindNotificationOutbox(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindNotificationOutboxGP retrieves a single record by ID, and panics on error.
+func FindNotificationOutboxGP(iD uint64, selectCols ...string) *NotificationOutbox {
+	retobj, err := FindNotificationOutbox(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindNotificationOutbox retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationOutbox(exec boil.Executor, iD uint64, selectCols ...string) (*NotificationOutbox, error) {
+	notificationOutboxObj := &NotificationOutbox{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `notification_outbox` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, notificationOutboxObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from notification_outbox")
+	}
+
+	return notificationOutboxObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *NotificationOutbox) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *NotificationOutbox) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *NotificationOutbox) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationOutbox) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no notification_outbox provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOutboxColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationOutboxInsertCacheMut.RLock()
+	cache, cached := notificationOutboxInsertCache[key]
+	notificationOutboxInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxColumnsWithDefault,
+			notificationOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `notification_outbox` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `notification_outbox` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `notification_outbox` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, notificationOutboxPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into notification_outbox")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationOutboxMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for notification_outbox")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationOutboxInsertCacheMut.Lock()
+		notificationOutboxInsertCache[key] = cache
+		notificationOutboxInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single NotificationOutbox record using the global executor.
+// See Update for more documentation.
+func (o *NotificationOutbox) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the NotificationOutbox, and panics on error.
+// See Update for more documentation.
+func (o *NotificationOutbox) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single NotificationOutbox record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *NotificationOutbox) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the NotificationOutbox.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationOutbox) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	notificationOutboxUpdateCacheMut.RLock()
+	cache, cached := notificationOutboxUpdateCache[key]
+	notificationOutboxUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update notification_outbox, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `notification_outbox` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, notificationOutboxPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, append(wl, notificationOutboxPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update notification_outbox row")
+	}
+
+	if !cached {
+		notificationOutboxUpdateCacheMut.Lock()
+		notificationOutboxUpdateCache[key] = cache
+		notificationOutboxUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q notificationOutboxQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q notificationOutboxQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q notificationOutboxQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationOutboxQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for notification_outbox")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o NotificationOutboxSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o NotificationOutboxSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o NotificationOutboxSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationOutboxSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `notification_outbox` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in notificationOutbox slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *NotificationOutbox) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *NotificationOutbox) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *NotificationOutbox) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLNotificationOutboxUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationOutbox) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no notification_outbox provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOutboxColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLNotificationOutboxUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationOutboxUpsertCacheMut.RLock()
+	cache, cached := notificationOutboxUpsertCache[key]
+	notificationOutboxUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxColumnsWithDefault,
+			notificationOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert notification_outbox, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationOutboxAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`notification_outbox`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `notification_outbox` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for notification_outbox")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationOutboxMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for notification_outbox")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for notification_outbox")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationOutboxUpsertCacheMut.Lock()
+		notificationOutboxUpsertCache[key] = cache
+		notificationOutboxUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single NotificationOutbox record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *NotificationOutbox) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single NotificationOutbox record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *NotificationOutbox) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single NotificationOutbox record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *NotificationOutbox) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single NotificationOutbox record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationOutbox) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no NotificationOutbox provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationOutboxPrimaryKeyMapping)
+	sql := "DELETE FROM `notification_outbox` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from notification_outbox")
+	}
+
+	return nil
+}
+
+func (q notificationOutboxQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q notificationOutboxQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q notificationOutboxQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationOutboxQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no notificationOutboxQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from notification_outbox")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o NotificationOutboxSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o NotificationOutboxSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o NotificationOutboxSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationOutboxSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `notification_outbox` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from notificationOutbox slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *NotificationOutbox) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no NotificationOutbox provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *NotificationOutbox) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *NotificationOutbox) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationOutbox) Reload(exec boil.Executor) error {
+	ret, err := FindNotificationOutbox(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationOutboxSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty NotificationOutboxSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *NotificationOutboxSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *NotificationOutboxSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationOutboxSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationOutboxSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `notification_outbox`.* FROM `notification_outbox` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in NotificationOutboxSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationOutboxExistsG checks if the NotificationOutbox row exists.
+func NotificationOutboxExistsG(iD uint64) (bool, error) {
+	return NotificationOutboxExists(boil.GetDB(), iD)
+}
+
+// NotificationOutboxExistsP checks if the NotificationOutbox row exists. Panics on error.
+func NotificationOutboxExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := NotificationOutboxExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// NotificationOutboxExistsGP checks if the NotificationOutbox row exists. Panics on error.
+func NotificationOutboxExistsGP(iD uint64) bool {
+	e, err := NotificationOutboxExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// NotificationOutboxExists checks if the NotificationOutbox row exists.
+func NotificationOutboxExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `notification_outbox` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if notification_outbox exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationOutbox row exists.
+func (o *NotificationOutbox) Exists(exec boil.Executor) (bool, error) {
+	return NotificationOutboxExists(exec, o.ID)
+}
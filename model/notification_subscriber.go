@@ -0,0 +1,1089 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NotificationSubscriber is an object representing the database table.
+type NotificationSubscriber struct {
+	ID         uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	URL        string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Type       string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Params     string    `boil:"params" json:"params" toml:"params" yaml:"params"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt time.Time `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+
+	R *notificationSubscriberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationSubscriberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationSubscriberColumns = struct {
+	ID         string
+	URL        string
+	Type       string
+	Params     string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	ID:         "id",
+	URL:        "url",
+	Type:       "type",
+	Params:     "params",
+	CreatedAt:  "created_at",
+	ModifiedAt: "modified_at",
+}
+
+var NotificationSubscriberTableColumns = struct {
+	ID         string
+	URL        string
+	Type       string
+	Params     string
+	CreatedAt  string
+	ModifiedAt string
+}{
+	ID:         "notification_subscriber.id",
+	URL:        "notification_subscriber.url",
+	Type:       "notification_subscriber.type",
+	Params:     "notification_subscriber.params",
+	CreatedAt:  "notification_subscriber.created_at",
+	ModifiedAt: "notification_subscriber.modified_at",
+}
+
+// Generated where
+
+var NotificationSubscriberWhere = struct {
+	ID         whereHelperuint64
+	URL        whereHelperstring
+	Type       whereHelperstring
+	Params     whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	ModifiedAt whereHelpertime_Time
+}{
+	ID:         whereHelperuint64{field: "`notification_subscriber`.`id`"},
+	URL:        whereHelperstring{field: "`notification_subscriber`.`url`"},
+	Type:       whereHelperstring{field: "`notification_subscriber`.`type`"},
+	Params:     whereHelperstring{field: "`notification_subscriber`.`params`"},
+	CreatedAt:  whereHelpertime_Time{field: "`notification_subscriber`.`created_at`"},
+	ModifiedAt: whereHelpertime_Time{field: "`notification_subscriber`.`modified_at`"},
+}
+
+// NotificationSubscriberRels is where relationship names are stored.
+var NotificationSubscriberRels = struct {
+}{}
+
+// notificationSubscriberR is where relationships are stored.
+type notificationSubscriberR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationSubscriberR) NewStruct() *notificationSubscriberR {
+	return &notificationSubscriberR{}
+}
+
+// notificationSubscriberL is where Load methods for each relationship are stored.
+type notificationSubscriberL struct{}
+
+var (
+	notificationSubscriberAllColumns            = []string{"id", "url", "type", "params", "created_at", "modified_at"}
+	notificationSubscriberColumnsWithoutDefault = []string{"url", "type", "params"}
+	notificationSubscriberColumnsWithDefault    = []string{"id", "created_at", "modified_at"}
+	notificationSubscriberPrimaryKeyColumns     = []string{"id"}
+	notificationSubscriberGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationSubscriberSlice is an alias for a slice of pointers to NotificationSubscriber.
+	// This should almost always be used instead of []NotificationSubscriber.
+	NotificationSubscriberSlice []*NotificationSubscriber
+
+	notificationSubscriberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationSubscriberType                 = reflect.TypeOf(&NotificationSubscriber{})
+	notificationSubscriberMapping              = queries.MakeStructMapping(notificationSubscriberType)
+	notificationSubscriberPrimaryKeyMapping, _ = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, notificationSubscriberPrimaryKeyColumns)
+	notificationSubscriberInsertCacheMut       sync.RWMutex
+	notificationSubscriberInsertCache          = make(map[string]insertCache)
+	notificationSubscriberUpdateCacheMut       sync.RWMutex
+	notificationSubscriberUpdateCache          = make(map[string]updateCache)
+	notificationSubscriberUpsertCacheMut       sync.RWMutex
+	notificationSubscriberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single notificationSubscriber record from the query using the global executor.
+func (q notificationSubscriberQuery) OneG() (*NotificationSubscriber, error) {
+	return q.One(boil.GetDB())
+}
+
+// OneGP returns a single notificationSubscriber record from the query using the global executor, and panics on error.
+func (q notificationSubscriberQuery) OneGP() *NotificationSubscriber {
+	o, err := q.One(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single notificationSubscriber record from the query, and panics on error.
+func (q notificationSubscriberQuery) OneP(exec boil.Executor) *NotificationSubscriber {
+	o, err := q.One(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single notificationSubscriber record from the query.
+func (q notificationSubscriberQuery) One(exec boil.Executor) (*NotificationSubscriber, error) {
+	o := &NotificationSubscriber{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for notification_subscriber")
+	}
+
+	return o, nil
+}
+
+// AllG returns all NotificationSubscriber records from the query using the global executor.
+func (q notificationSubscriberQuery) AllG() (NotificationSubscriberSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// AllGP returns all NotificationSubscriber records from the query using the global executor, and panics on error.
+func (q notificationSubscriberQuery) AllGP() NotificationSubscriberSlice {
+	o, err := q.All(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all NotificationSubscriber records from the query, and panics on error.
+func (q notificationSubscriberQuery) AllP(exec boil.Executor) NotificationSubscriberSlice {
+	o, err := q.All(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all NotificationSubscriber records from the query.
+func (q notificationSubscriberQuery) All(exec boil.Executor) (NotificationSubscriberSlice, error) {
+	var o []*NotificationSubscriber
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to NotificationSubscriber slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all NotificationSubscriber records in the query using the global executor
+func (q notificationSubscriberQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// CountGP returns the count of all NotificationSubscriber records in the query using the global executor, and panics on error.
+func (q notificationSubscriberQuery) CountGP() int64 {
+	c, err := q.Count(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all NotificationSubscriber records in the query, and panics on error.
+func (q notificationSubscriberQuery) CountP(exec boil.Executor) int64 {
+	c, err := q.Count(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all NotificationSubscriber records in the query.
+func (q notificationSubscriberQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count notification_subscriber rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q notificationSubscriberQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q notificationSubscriberQuery) ExistsGP() bool {
+	e, err := q.Exists(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q notificationSubscriberQuery) ExistsP(exec boil.Executor) bool {
+	e, err := q.Exists(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationSubscriberQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if notification_subscriber exists")
+	}
+
+	return count > 0, nil
+}
+
+// NotificationSubscribers retrieves all the records using an executor.
+func NotificationSubscribers(mods ...qm.QueryMod) notificationSubscriberQuery {
+	mods = append(mods, qm.From("`notification_subscriber`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`notification_subscriber`.*"})
+	}
+
+	return notificationSubscriberQuery{q}
+}
+
+// FindNotificationSubscriberG retrieves a single record by ID.
+func FindNotificationSubscriberG(iD uint64, selectCols ...string) (*NotificationSubscriber, error) {
+	return FindNotificationSubscriber(boil.GetDB(), iD, selectCols...)
+}
+
+// FindNotificationSubscriberP retrieves a single record by ID with an executor, and panics on error.
+func FindNotificationSubscriberP(exec boil.Executor, iD uint64, selectCols ...string) *NotificationSubscriber {
+	retobj, err := FindNotificationSubscriber(exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindNotificationSubscriberGP retrieves a single record by ID, and panics on error.
+func FindNotificationSubscriberGP(iD uint64, selectCols ...string) *NotificationSubscriber {
+	retobj, err := FindNotificationSubscriber(boil.GetDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindNotificationSubscriber retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationSubscriber(exec boil.Executor, iD uint64, selectCols ...string) (*NotificationSubscriber, error) {
+	notificationSubscriberObj := &NotificationSubscriber{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `notification_subscriber` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, notificationSubscriberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from notification_subscriber")
+	}
+
+	return notificationSubscriberObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *NotificationSubscriber) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *NotificationSubscriber) InsertP(exec boil.Executor, columns boil.Columns) {
+	if err := o.Insert(exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *NotificationSubscriber) InsertGP(columns boil.Columns) {
+	if err := o.Insert(boil.GetDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationSubscriber) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no notification_subscriber provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationSubscriberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationSubscriberInsertCacheMut.RLock()
+	cache, cached := notificationSubscriberInsertCache[key]
+	notificationSubscriberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationSubscriberAllColumns,
+			notificationSubscriberColumnsWithDefault,
+			notificationSubscriberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `notification_subscriber` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `notification_subscriber` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `notification_subscriber` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, notificationSubscriberPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into notification_subscriber")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationSubscriberMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for notification_subscriber")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationSubscriberInsertCacheMut.Lock()
+		notificationSubscriberInsertCache[key] = cache
+		notificationSubscriberInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single NotificationSubscriber record using the global executor.
+// See Update for more documentation.
+func (o *NotificationSubscriber) UpdateG(columns boil.Columns) error {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// UpdateP uses an executor to update the NotificationSubscriber, and panics on error.
+// See Update for more documentation.
+func (o *NotificationSubscriber) UpdateP(exec boil.Executor, columns boil.Columns) {
+	err := o.Update(exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateGP a single NotificationSubscriber record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *NotificationSubscriber) UpdateGP(columns boil.Columns) {
+	err := o.Update(boil.GetDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Update uses an executor to update the NotificationSubscriber.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationSubscriber) Update(exec boil.Executor, columns boil.Columns) error {
+	var err error
+	key := makeCacheKey(columns, nil)
+	notificationSubscriberUpdateCacheMut.RLock()
+	cache, cached := notificationSubscriberUpdateCache[key]
+	notificationSubscriberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationSubscriberAllColumns,
+			notificationSubscriberPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return errors.New("model: unable to update notification_subscriber, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `notification_subscriber` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, notificationSubscriberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, append(wl, notificationSubscriberPrimaryKeyColumns...))
+		if err != nil {
+			return err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update notification_subscriber row")
+	}
+
+	if !cached {
+		notificationSubscriberUpdateCacheMut.Lock()
+		notificationSubscriberUpdateCache[key] = cache
+		notificationSubscriberUpdateCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q notificationSubscriberQuery) UpdateAllP(exec boil.Executor, cols M) {
+	err := q.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q notificationSubscriberQuery) UpdateAllG(cols M) error {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q notificationSubscriberQuery) UpdateAllGP(cols M) {
+	err := q.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationSubscriberQuery) UpdateAll(exec boil.Executor, cols M) error {
+	queries.SetUpdate(q.Query, cols)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all for notification_subscriber")
+	}
+
+	return nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o NotificationSubscriberSlice) UpdateAllG(cols M) error {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o NotificationSubscriberSlice) UpdateAllGP(cols M) {
+	err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o NotificationSubscriberSlice) UpdateAllP(exec boil.Executor, cols M) {
+	err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationSubscriberSlice) UpdateAll(exec boil.Executor, cols M) error {
+	ln := int64(len(o))
+	if ln == 0 {
+		return nil
+	}
+
+	if len(cols) == 0 {
+		return errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `notification_subscriber` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriberPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to update all in notificationSubscriber slice")
+	}
+
+	return nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *NotificationSubscriber) UpsertG(updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(boil.GetDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *NotificationSubscriber) UpsertGP(updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(boil.GetDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *NotificationSubscriber) UpsertP(exec boil.Executor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLNotificationSubscriberUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationSubscriber) Upsert(exec boil.Executor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no notification_subscriber provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationSubscriberColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLNotificationSubscriberUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationSubscriberUpsertCacheMut.RLock()
+	cache, cached := notificationSubscriberUpsertCache[key]
+	notificationSubscriberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationSubscriberAllColumns,
+			notificationSubscriberColumnsWithDefault,
+			notificationSubscriberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationSubscriberAllColumns,
+			notificationSubscriberPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert notification_subscriber, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationSubscriberAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`notification_subscriber`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `notification_subscriber` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for notification_subscriber")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == notificationSubscriberMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(notificationSubscriberType, notificationSubscriberMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for notification_subscriber")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for notification_subscriber")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationSubscriberUpsertCacheMut.Lock()
+		notificationSubscriberUpsertCache[key] = cache
+		notificationSubscriberUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single NotificationSubscriber record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *NotificationSubscriber) DeleteG() error {
+	return o.Delete(boil.GetDB())
+}
+
+// DeleteP deletes a single NotificationSubscriber record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *NotificationSubscriber) DeleteP(exec boil.Executor) {
+	err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteGP deletes a single NotificationSubscriber record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *NotificationSubscriber) DeleteGP() {
+	err := o.Delete(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Delete deletes a single NotificationSubscriber record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationSubscriber) Delete(exec boil.Executor) error {
+	if o == nil {
+		return errors.New("model: no NotificationSubscriber provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationSubscriberPrimaryKeyMapping)
+	sql := "DELETE FROM `notification_subscriber` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete from notification_subscriber")
+	}
+
+	return nil
+}
+
+func (q notificationSubscriberQuery) DeleteAllG() error {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q notificationSubscriberQuery) DeleteAllP(exec boil.Executor) {
+	err := q.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q notificationSubscriberQuery) DeleteAllGP() {
+	err := q.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationSubscriberQuery) DeleteAll(exec boil.Executor) error {
+	if q.Query == nil {
+		return errors.New("model: no notificationSubscriberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	_, err := q.Query.Exec(exec)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from notification_subscriber")
+	}
+
+	return nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o NotificationSubscriberSlice) DeleteAllG() error {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o NotificationSubscriberSlice) DeleteAllP(exec boil.Executor) {
+	err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o NotificationSubscriberSlice) DeleteAllGP() {
+	err := o.DeleteAll(boil.GetDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationSubscriberSlice) DeleteAll(exec boil.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `notification_subscriber` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriberPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	_, err := exec.Exec(sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to delete all from notificationSubscriber slice")
+	}
+
+	return nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *NotificationSubscriber) ReloadG() error {
+	if o == nil {
+		return errors.New("model: no NotificationSubscriber provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *NotificationSubscriber) ReloadP(exec boil.Executor) {
+	if err := o.Reload(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *NotificationSubscriber) ReloadGP() {
+	if err := o.Reload(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationSubscriber) Reload(exec boil.Executor) error {
+	ret, err := FindNotificationSubscriber(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationSubscriberSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("model: empty NotificationSubscriberSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *NotificationSubscriberSlice) ReloadAllP(exec boil.Executor) {
+	if err := o.ReloadAll(exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *NotificationSubscriberSlice) ReloadAllGP() {
+	if err := o.ReloadAll(boil.GetDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationSubscriberSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationSubscriberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `notification_subscriber`.* FROM `notification_subscriber` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in NotificationSubscriberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationSubscriberExistsG checks if the NotificationSubscriber row exists.
+func NotificationSubscriberExistsG(iD uint64) (bool, error) {
+	return NotificationSubscriberExists(boil.GetDB(), iD)
+}
+
+// NotificationSubscriberExistsP checks if the NotificationSubscriber row exists. Panics on error.
+func NotificationSubscriberExistsP(exec boil.Executor, iD uint64) bool {
+	e, err := NotificationSubscriberExists(exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// NotificationSubscriberExistsGP checks if the NotificationSubscriber row exists. Panics on error.
+func NotificationSubscriberExistsGP(iD uint64) bool {
+	e, err := NotificationSubscriberExists(boil.GetDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// NotificationSubscriberExists checks if the NotificationSubscriber row exists.
+func NotificationSubscriberExists(exec boil.Executor, iD uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `notification_subscriber` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if notification_subscriber exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationSubscriber row exists.
+func (o *NotificationSubscriber) Exists(exec boil.Executor) (bool, error) {
+	return NotificationSubscriberExists(exec, o.ID)
+}
@@ -30,6 +30,13 @@ type Purchase struct {
 	PublisherID         null.String `boil:"publisher_id" json:"publisher_id,omitempty" toml:"publisher_id" yaml:"publisher_id,omitempty"`
 	Height              uint        `boil:"height" json:"height" toml:"height" yaml:"height"`
 	AmountSatoshi       int64       `boil:"amount_satoshi" json:"amount_satoshi" toml:"amount_satoshi" yaml:"amount_satoshi"`
+	PaymentVout         null.Uint   `boil:"payment_vout" json:"payment_vout,omitempty" toml:"payment_vout" yaml:"payment_vout,omitempty"`
+	BuyerAddress        null.String `boil:"buyer_address" json:"buyer_address,omitempty" toml:"buyer_address" yaml:"buyer_address,omitempty"`
+	Fee                 float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	FeeCurrency         null.String `boil:"fee_currency" json:"fee_currency,omitempty" toml:"fee_currency" yaml:"fee_currency,omitempty"`
+	FeeAddress          null.String `boil:"fee_address" json:"fee_address,omitempty" toml:"fee_address" yaml:"fee_address,omitempty"`
+	FeeSatisfied        null.Bool   `boil:"fee_satisfied" json:"fee_satisfied,omitempty" toml:"fee_satisfied" yaml:"fee_satisfied,omitempty"`
+	IsResolved          bool        `boil:"is_resolved" json:"is_resolved" toml:"is_resolved" yaml:"is_resolved"`
 	Created             time.Time   `boil:"created" json:"created" toml:"created" yaml:"created"`
 	Modified            time.Time   `boil:"modified" json:"modified" toml:"modified" yaml:"modified"`
 
@@ -45,6 +52,13 @@ var PurchaseColumns = struct {
 	PublisherID         string
 	Height              string
 	AmountSatoshi       string
+	PaymentVout         string
+	BuyerAddress        string
+	Fee                 string
+	FeeCurrency         string
+	FeeAddress          string
+	FeeSatisfied        string
+	IsResolved          string
 	Created             string
 	Modified            string
 }{
@@ -55,6 +69,13 @@ var PurchaseColumns = struct {
 	PublisherID:         "publisher_id",
 	Height:              "height",
 	AmountSatoshi:       "amount_satoshi",
+	PaymentVout:         "payment_vout",
+	BuyerAddress:        "buyer_address",
+	Fee:                 "fee",
+	FeeCurrency:         "fee_currency",
+	FeeAddress:          "fee_address",
+	FeeSatisfied:        "fee_satisfied",
+	IsResolved:          "is_resolved",
 	Created:             "created",
 	Modified:            "modified",
 }
@@ -67,6 +88,13 @@ var PurchaseTableColumns = struct {
 	PublisherID         string
 	Height              string
 	AmountSatoshi       string
+	PaymentVout         string
+	BuyerAddress        string
+	Fee                 string
+	FeeCurrency         string
+	FeeAddress          string
+	FeeSatisfied        string
+	IsResolved          string
 	Created             string
 	Modified            string
 }{
@@ -77,6 +105,13 @@ var PurchaseTableColumns = struct {
 	PublisherID:         "purchase.publisher_id",
 	Height:              "purchase.height",
 	AmountSatoshi:       "purchase.amount_satoshi",
+	PaymentVout:         "purchase.payment_vout",
+	BuyerAddress:        "purchase.buyer_address",
+	Fee:                 "purchase.fee",
+	FeeCurrency:         "purchase.fee_currency",
+	FeeAddress:          "purchase.fee_address",
+	FeeSatisfied:        "purchase.fee_satisfied",
+	IsResolved:          "purchase.is_resolved",
 	Created:             "purchase.created",
 	Modified:            "purchase.modified",
 }
@@ -91,6 +126,13 @@ var PurchaseWhere = struct {
 	PublisherID         whereHelpernull_String
 	Height              whereHelperuint
 	AmountSatoshi       whereHelperint64
+	PaymentVout         whereHelpernull_Uint
+	BuyerAddress        whereHelpernull_String
+	Fee                 whereHelperfloat64
+	FeeCurrency         whereHelpernull_String
+	FeeAddress          whereHelpernull_String
+	FeeSatisfied        whereHelpernull_Bool
+	IsResolved          whereHelperbool
 	Created             whereHelpertime_Time
 	Modified            whereHelpertime_Time
 }{
@@ -101,6 +143,13 @@ var PurchaseWhere = struct {
 	PublisherID:         whereHelpernull_String{field: "`purchase`.`publisher_id`"},
 	Height:              whereHelperuint{field: "`purchase`.`height`"},
 	AmountSatoshi:       whereHelperint64{field: "`purchase`.`amount_satoshi`"},
+	PaymentVout:         whereHelpernull_Uint{field: "`purchase`.`payment_vout`"},
+	BuyerAddress:        whereHelpernull_String{field: "`purchase`.`buyer_address`"},
+	Fee:                 whereHelperfloat64{field: "`purchase`.`fee`"},
+	FeeCurrency:         whereHelpernull_String{field: "`purchase`.`fee_currency`"},
+	FeeAddress:          whereHelpernull_String{field: "`purchase`.`fee_address`"},
+	FeeSatisfied:        whereHelpernull_Bool{field: "`purchase`.`fee_satisfied`"},
+	IsResolved:          whereHelperbool{field: "`purchase`.`is_resolved`"},
 	Created:             whereHelpertime_Time{field: "`purchase`.`created`"},
 	Modified:            whereHelpertime_Time{field: "`purchase`.`modified`"},
 }
@@ -133,9 +182,9 @@ func (r *purchaseR) GetTransactionByHash() *Transaction {
 type purchaseL struct{}
 
 var (
-	purchaseAllColumns            = []string{"id", "transaction_by_hash_id", "vout", "claim_id", "publisher_id", "height", "amount_satoshi", "created", "modified"}
-	purchaseColumnsWithoutDefault = []string{"transaction_by_hash_id", "vout", "claim_id", "publisher_id", "height"}
-	purchaseColumnsWithDefault    = []string{"id", "amount_satoshi", "created", "modified"}
+	purchaseAllColumns            = []string{"id", "transaction_by_hash_id", "vout", "claim_id", "publisher_id", "height", "amount_satoshi", "payment_vout", "buyer_address", "fee", "fee_currency", "fee_address", "fee_satisfied", "is_resolved", "created", "modified"}
+	purchaseColumnsWithoutDefault = []string{"transaction_by_hash_id", "vout", "claim_id", "publisher_id", "height", "payment_vout", "buyer_address", "fee_currency", "fee_address", "fee_satisfied"}
+	purchaseColumnsWithDefault    = []string{"id", "amount_satoshi", "fee", "is_resolved", "created", "modified"}
 	purchasePrimaryKeyColumns     = []string{"id"}
 	purchaseGeneratedColumns      = []string{}
 )
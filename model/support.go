@@ -23,98 +23,143 @@ import (
 
 // Support is an object representing the database table.
 type Support struct {
-	ID                 uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	SupportedClaimID   string      `boil:"supported_claim_id" json:"supported_claim_id" toml:"supported_claim_id" yaml:"supported_claim_id"`
-	SupportAmount      float64     `boil:"support_amount" json:"support_amount" toml:"support_amount" yaml:"support_amount"`
-	BidState           string      `boil:"bid_state" json:"bid_state" toml:"bid_state" yaml:"bid_state"`
-	TransactionHashID  null.String `boil:"transaction_hash_id" json:"transaction_hash_id,omitempty" toml:"transaction_hash_id" yaml:"transaction_hash_id,omitempty"`
-	Vout               uint        `boil:"vout" json:"vout" toml:"vout" yaml:"vout"`
-	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	ModifiedAt         time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
-	SupportedByClaimID null.String `boil:"supported_by_claim_id" json:"supported_by_claim_id,omitempty" toml:"supported_by_claim_id" yaml:"supported_by_claim_id,omitempty"`
+	ID                   uint64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SupportedClaimID     string      `boil:"supported_claim_id" json:"supported_claim_id" toml:"supported_claim_id" yaml:"supported_claim_id"`
+	SupportAmount        float64     `boil:"support_amount" json:"support_amount" toml:"support_amount" yaml:"support_amount"`
+	BidState             string      `boil:"bid_state" json:"bid_state" toml:"bid_state" yaml:"bid_state"`
+	TransactionHashID    null.String `boil:"transaction_hash_id" json:"transaction_hash_id,omitempty" toml:"transaction_hash_id" yaml:"transaction_hash_id,omitempty"`
+	Vout                 uint        `boil:"vout" json:"vout" toml:"vout" yaml:"vout"`
+	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ModifiedAt           time.Time   `boil:"modified_at" json:"modified_at" toml:"modified_at" yaml:"modified_at"`
+	SupportedByClaimID   null.String `boil:"supported_by_claim_id" json:"supported_by_claim_id,omitempty" toml:"supported_by_claim_id" yaml:"supported_by_claim_id,omitempty"`
+	Height               uint        `boil:"height" json:"height" toml:"height" yaml:"height"`
+	SupporterAddress     null.String `boil:"supporter_address" json:"supporter_address,omitempty" toml:"supporter_address" yaml:"supporter_address,omitempty"`
+	IsTip                bool        `boil:"is_tip" json:"is_tip" toml:"is_tip" yaml:"is_tip"`
+	SpentTransactionHash null.String `boil:"spent_transaction_hash" json:"spent_transaction_hash,omitempty" toml:"spent_transaction_hash" yaml:"spent_transaction_hash,omitempty"`
+	SpentHeight          null.Uint   `boil:"spent_height" json:"spent_height,omitempty" toml:"spent_height" yaml:"spent_height,omitempty"`
+	ValidAtHeight        uint        `boil:"valid_at_height" json:"valid_at_height" toml:"valid_at_height" yaml:"valid_at_height"`
 
 	R *supportR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L supportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SupportColumns = struct {
-	ID                 string
-	SupportedClaimID   string
-	SupportAmount      string
-	BidState           string
-	TransactionHashID  string
-	Vout               string
-	CreatedAt          string
-	ModifiedAt         string
-	SupportedByClaimID string
+	ID                   string
+	SupportedClaimID     string
+	SupportAmount        string
+	BidState             string
+	TransactionHashID    string
+	Vout                 string
+	CreatedAt            string
+	ModifiedAt           string
+	SupportedByClaimID   string
+	Height               string
+	SupporterAddress     string
+	IsTip                string
+	SpentTransactionHash string
+	SpentHeight          string
+	ValidAtHeight        string
 }{
-	ID:                 "id",
-	SupportedClaimID:   "supported_claim_id",
-	SupportAmount:      "support_amount",
-	BidState:           "bid_state",
-	TransactionHashID:  "transaction_hash_id",
-	Vout:               "vout",
-	CreatedAt:          "created_at",
-	ModifiedAt:         "modified_at",
-	SupportedByClaimID: "supported_by_claim_id",
+	ID:                   "id",
+	SupportedClaimID:     "supported_claim_id",
+	SupportAmount:        "support_amount",
+	BidState:             "bid_state",
+	TransactionHashID:    "transaction_hash_id",
+	Vout:                 "vout",
+	CreatedAt:            "created_at",
+	ModifiedAt:           "modified_at",
+	SupportedByClaimID:   "supported_by_claim_id",
+	Height:               "height",
+	SupporterAddress:     "supporter_address",
+	IsTip:                "is_tip",
+	SpentTransactionHash: "spent_transaction_hash",
+	SpentHeight:          "spent_height",
+	ValidAtHeight:        "valid_at_height",
 }
 
 var SupportTableColumns = struct {
-	ID                 string
-	SupportedClaimID   string
-	SupportAmount      string
-	BidState           string
-	TransactionHashID  string
-	Vout               string
-	CreatedAt          string
-	ModifiedAt         string
-	SupportedByClaimID string
+	ID                   string
+	SupportedClaimID     string
+	SupportAmount        string
+	BidState             string
+	TransactionHashID    string
+	Vout                 string
+	CreatedAt            string
+	ModifiedAt           string
+	SupportedByClaimID   string
+	Height               string
+	SupporterAddress     string
+	IsTip                string
+	SpentTransactionHash string
+	SpentHeight          string
+	ValidAtHeight        string
 }{
-	ID:                 "support.id",
-	SupportedClaimID:   "support.supported_claim_id",
-	SupportAmount:      "support.support_amount",
-	BidState:           "support.bid_state",
-	TransactionHashID:  "support.transaction_hash_id",
-	Vout:               "support.vout",
-	CreatedAt:          "support.created_at",
-	ModifiedAt:         "support.modified_at",
-	SupportedByClaimID: "support.supported_by_claim_id",
+	ID:                   "support.id",
+	SupportedClaimID:     "support.supported_claim_id",
+	SupportAmount:        "support.support_amount",
+	BidState:             "support.bid_state",
+	TransactionHashID:    "support.transaction_hash_id",
+	Vout:                 "support.vout",
+	CreatedAt:            "support.created_at",
+	ModifiedAt:           "support.modified_at",
+	SupportedByClaimID:   "support.supported_by_claim_id",
+	Height:               "support.height",
+	SupporterAddress:     "support.supporter_address",
+	IsTip:                "support.is_tip",
+	SpentTransactionHash: "support.spent_transaction_hash",
+	SpentHeight:          "support.spent_height",
+	ValidAtHeight:        "support.valid_at_height",
 }
 
 // Generated where
 
 var SupportWhere = struct {
-	ID                 whereHelperuint64
-	SupportedClaimID   whereHelperstring
-	SupportAmount      whereHelperfloat64
-	BidState           whereHelperstring
-	TransactionHashID  whereHelpernull_String
-	Vout               whereHelperuint
-	CreatedAt          whereHelpertime_Time
-	ModifiedAt         whereHelpertime_Time
-	SupportedByClaimID whereHelpernull_String
+	ID                   whereHelperuint64
+	SupportedClaimID     whereHelperstring
+	SupportAmount        whereHelperfloat64
+	BidState             whereHelperstring
+	TransactionHashID    whereHelpernull_String
+	Vout                 whereHelperuint
+	CreatedAt            whereHelpertime_Time
+	ModifiedAt           whereHelpertime_Time
+	SupportedByClaimID   whereHelpernull_String
+	Height               whereHelperuint
+	SupporterAddress     whereHelpernull_String
+	IsTip                whereHelperbool
+	SpentTransactionHash whereHelpernull_String
+	SpentHeight          whereHelpernull_Uint
+	ValidAtHeight        whereHelperuint
 }{
-	ID:                 whereHelperuint64{field: "`support`.`id`"},
-	SupportedClaimID:   whereHelperstring{field: "`support`.`supported_claim_id`"},
-	SupportAmount:      whereHelperfloat64{field: "`support`.`support_amount`"},
-	BidState:           whereHelperstring{field: "`support`.`bid_state`"},
-	TransactionHashID:  whereHelpernull_String{field: "`support`.`transaction_hash_id`"},
-	Vout:               whereHelperuint{field: "`support`.`vout`"},
-	CreatedAt:          whereHelpertime_Time{field: "`support`.`created_at`"},
-	ModifiedAt:         whereHelpertime_Time{field: "`support`.`modified_at`"},
-	SupportedByClaimID: whereHelpernull_String{field: "`support`.`supported_by_claim_id`"},
+	ID:                   whereHelperuint64{field: "`support`.`id`"},
+	SupportedClaimID:     whereHelperstring{field: "`support`.`supported_claim_id`"},
+	SupportAmount:        whereHelperfloat64{field: "`support`.`support_amount`"},
+	BidState:             whereHelperstring{field: "`support`.`bid_state`"},
+	TransactionHashID:    whereHelpernull_String{field: "`support`.`transaction_hash_id`"},
+	Vout:                 whereHelperuint{field: "`support`.`vout`"},
+	CreatedAt:            whereHelpertime_Time{field: "`support`.`created_at`"},
+	ModifiedAt:           whereHelpertime_Time{field: "`support`.`modified_at`"},
+	SupportedByClaimID:   whereHelpernull_String{field: "`support`.`supported_by_claim_id`"},
+	Height:               whereHelperuint{field: "`support`.`height`"},
+	SupporterAddress:     whereHelpernull_String{field: "`support`.`supporter_address`"},
+	IsTip:                whereHelperbool{field: "`support`.`is_tip`"},
+	SpentTransactionHash: whereHelpernull_String{field: "`support`.`spent_transaction_hash`"},
+	SpentHeight:          whereHelpernull_Uint{field: "`support`.`spent_height`"},
+	ValidAtHeight:        whereHelperuint{field: "`support`.`valid_at_height`"},
 }
 
 // SupportRels is where relationship names are stored.
 var SupportRels = struct {
-	TransactionHash string
+	SpentTransactionHashTransaction string
+	TransactionHash                 string
 }{
-	TransactionHash: "TransactionHash",
+	SpentTransactionHashTransaction: "SpentTransactionHashTransaction",
+	TransactionHash:                 "TransactionHash",
 }
 
 // supportR is where relationships are stored.
 type supportR struct {
-	TransactionHash *Transaction `boil:"TransactionHash" json:"TransactionHash" toml:"TransactionHash" yaml:"TransactionHash"`
+	SpentTransactionHashTransaction *Transaction `boil:"SpentTransactionHashTransaction" json:"SpentTransactionHashTransaction" toml:"SpentTransactionHashTransaction" yaml:"SpentTransactionHashTransaction"`
+	TransactionHash                 *Transaction `boil:"TransactionHash" json:"TransactionHash" toml:"TransactionHash" yaml:"TransactionHash"`
 }
 
 // NewStruct creates a new relationship struct
@@ -122,6 +167,13 @@ func (*supportR) NewStruct() *supportR {
 	return &supportR{}
 }
 
+func (r *supportR) GetSpentTransactionHashTransaction() *Transaction {
+	if r == nil {
+		return nil
+	}
+	return r.SpentTransactionHashTransaction
+}
+
 func (r *supportR) GetTransactionHash() *Transaction {
 	if r == nil {
 		return nil
@@ -133,9 +185,9 @@ func (r *supportR) GetTransactionHash() *Transaction {
 type supportL struct{}
 
 var (
-	supportAllColumns            = []string{"id", "supported_claim_id", "support_amount", "bid_state", "transaction_hash_id", "vout", "created_at", "modified_at", "supported_by_claim_id"}
-	supportColumnsWithoutDefault = []string{"supported_claim_id", "transaction_hash_id", "vout", "supported_by_claim_id"}
-	supportColumnsWithDefault    = []string{"id", "support_amount", "bid_state", "created_at", "modified_at"}
+	supportAllColumns            = []string{"id", "supported_claim_id", "support_amount", "bid_state", "transaction_hash_id", "vout", "created_at", "modified_at", "supported_by_claim_id", "height", "supporter_address", "is_tip", "spent_transaction_hash", "spent_height", "valid_at_height"}
+	supportColumnsWithoutDefault = []string{"supported_claim_id", "transaction_hash_id", "vout", "supported_by_claim_id", "supporter_address", "spent_transaction_hash", "spent_height"}
+	supportColumnsWithDefault    = []string{"id", "support_amount", "bid_state", "created_at", "modified_at", "height", "is_tip", "valid_at_height"}
 	supportPrimaryKeyColumns     = []string{"id"}
 	supportGeneratedColumns      = []string{}
 )
@@ -331,6 +383,17 @@ func (q supportQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// SpentTransactionHashTransaction pointed to by the foreign key.
+func (o *Support) SpentTransactionHashTransaction(mods ...qm.QueryMod) transactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hash` = ?", o.SpentTransactionHash),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Transactions(queryMods...)
+}
+
 // TransactionHash pointed to by the foreign key.
 func (o *Support) TransactionHash(mods ...qm.QueryMod) transactionQuery {
 	queryMods := []qm.QueryMod{
@@ -342,6 +405,122 @@ func (o *Support) TransactionHash(mods ...qm.QueryMod) transactionQuery {
 	return Transactions(queryMods...)
 }
 
+// LoadSpentTransactionHashTransaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (supportL) LoadSpentTransactionHashTransaction(e boil.Executor, singular bool, maybeSupport interface{}, mods queries.Applicator) error {
+	var slice []*Support
+	var object *Support
+
+	if singular {
+		var ok bool
+		object, ok = maybeSupport.(*Support)
+		if !ok {
+			object = new(Support)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSupport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSupport))
+			}
+		}
+	} else {
+		s, ok := maybeSupport.(*[]*Support)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSupport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSupport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &supportR{}
+		}
+		if !queries.IsNil(object.SpentTransactionHash) {
+			args[object.SpentTransactionHash] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &supportR{}
+			}
+
+			if !queries.IsNil(obj.SpentTransactionHash) {
+				args[obj.SpentTransactionHash] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transaction`),
+		qm.WhereIn(`transaction.hash in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Transaction")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SpentTransactionHashTransaction = foreign
+		if foreign.R == nil {
+			foreign.R = &transactionR{}
+		}
+		foreign.R.SpentTransactionHashSupports = append(foreign.R.SpentTransactionHashSupports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SpentTransactionHash, foreign.Hash) {
+				local.R.SpentTransactionHashTransaction = foreign
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.SpentTransactionHashSupports = append(foreign.R.SpentTransactionHashSupports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadTransactionHash allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (supportL) LoadTransactionHash(e boil.Executor, singular bool, maybeSupport interface{}, mods queries.Applicator) error {
@@ -458,6 +637,141 @@ func (supportL) LoadTransactionHash(e boil.Executor, singular bool, maybeSupport
 	return nil
 }
 
+// SetSpentTransactionHashTransactionG of the support to the related item.
+// Sets o.R.SpentTransactionHashTransaction to related.
+// Adds o to related.R.SpentTransactionHashSupports.
+// Uses the global database handle.
+func (o *Support) SetSpentTransactionHashTransactionG(insert bool, related *Transaction) error {
+	return o.SetSpentTransactionHashTransaction(boil.GetDB(), insert, related)
+}
+
+// SetSpentTransactionHashTransactionP of the support to the related item.
+// Sets o.R.SpentTransactionHashTransaction to related.
+// Adds o to related.R.SpentTransactionHashSupports.
+// Panics on error.
+func (o *Support) SetSpentTransactionHashTransactionP(exec boil.Executor, insert bool, related *Transaction) {
+	if err := o.SetSpentTransactionHashTransaction(exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetSpentTransactionHashTransactionGP of the support to the related item.
+// Sets o.R.SpentTransactionHashTransaction to related.
+// Adds o to related.R.SpentTransactionHashSupports.
+// Uses the global database handle and panics on error.
+func (o *Support) SetSpentTransactionHashTransactionGP(insert bool, related *Transaction) {
+	if err := o.SetSpentTransactionHashTransaction(boil.GetDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetSpentTransactionHashTransaction of the support to the related item.
+// Sets o.R.SpentTransactionHashTransaction to related.
+// Adds o to related.R.SpentTransactionHashSupports.
+func (o *Support) SetSpentTransactionHashTransaction(exec boil.Executor, insert bool, related *Transaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `support` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"spent_transaction_hash"}),
+		strmangle.WhereClause("`", "`", 0, supportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Hash, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SpentTransactionHash, related.Hash)
+	if o.R == nil {
+		o.R = &supportR{
+			SpentTransactionHashTransaction: related,
+		}
+	} else {
+		o.R.SpentTransactionHashTransaction = related
+	}
+
+	if related.R == nil {
+		related.R = &transactionR{
+			SpentTransactionHashSupports: SupportSlice{o},
+		}
+	} else {
+		related.R.SpentTransactionHashSupports = append(related.R.SpentTransactionHashSupports, o)
+	}
+
+	return nil
+}
+
+// RemoveSpentTransactionHashTransactionG relationship.
+// Sets o.R.SpentTransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Support) RemoveSpentTransactionHashTransactionG(related *Transaction) error {
+	return o.RemoveSpentTransactionHashTransaction(boil.GetDB(), related)
+}
+
+// RemoveSpentTransactionHashTransactionP relationship.
+// Sets o.R.SpentTransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Panics on error.
+func (o *Support) RemoveSpentTransactionHashTransactionP(exec boil.Executor, related *Transaction) {
+	if err := o.RemoveSpentTransactionHashTransaction(exec, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveSpentTransactionHashTransactionGP relationship.
+// Sets o.R.SpentTransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle and panics on error.
+func (o *Support) RemoveSpentTransactionHashTransactionGP(related *Transaction) {
+	if err := o.RemoveSpentTransactionHashTransaction(boil.GetDB(), related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveSpentTransactionHashTransaction relationship.
+// Sets o.R.SpentTransactionHashTransaction to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Support) RemoveSpentTransactionHashTransaction(exec boil.Executor, related *Transaction) error {
+	var err error
+
+	queries.SetScanner(&o.SpentTransactionHash, nil)
+	if err = o.Update(exec, boil.Whitelist("spent_transaction_hash")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.SpentTransactionHashTransaction = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SpentTransactionHashSupports {
+		if queries.Equal(o.SpentTransactionHash, ri.SpentTransactionHash) {
+			continue
+		}
+
+		ln := len(related.R.SpentTransactionHashSupports)
+		if ln > 1 && i < ln-1 {
+			related.R.SpentTransactionHashSupports[i] = related.R.SpentTransactionHashSupports[ln-1]
+		}
+		related.R.SpentTransactionHashSupports = related.R.SpentTransactionHashSupports[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetTransactionHashG of the support to the related item.
 // Sets o.R.TransactionHash to related.
 // Adds o to related.R.TransactionHashSupports.
@@ -135,32 +135,41 @@ var TransactionWhere = struct {
 
 // TransactionRels is where relationship names are stored.
 var TransactionRels = struct {
-	BlockHash                  string
-	TransactionHashClaims      string
-	Inputs                     string
-	Outputs                    string
-	TransactionByHashPurchases string
-	TransactionHashSupports    string
-	TransactionAddresses       string
+	BlockHash                           string
+	TransactionHashClaims               string
+	TransactionHashClaimVersions        string
+	Inputs                              string
+	TransactionHashNotificationOutboxes string
+	Outputs                             string
+	TransactionByHashPurchases          string
+	SpentTransactionHashSupports        string
+	TransactionHashSupports             string
+	TransactionAddresses                string
 }{
-	BlockHash:                  "BlockHash",
-	TransactionHashClaims:      "TransactionHashClaims",
-	Inputs:                     "Inputs",
-	Outputs:                    "Outputs",
-	TransactionByHashPurchases: "TransactionByHashPurchases",
-	TransactionHashSupports:    "TransactionHashSupports",
-	TransactionAddresses:       "TransactionAddresses",
+	BlockHash:                           "BlockHash",
+	TransactionHashClaims:               "TransactionHashClaims",
+	TransactionHashClaimVersions:        "TransactionHashClaimVersions",
+	Inputs:                              "Inputs",
+	TransactionHashNotificationOutboxes: "TransactionHashNotificationOutboxes",
+	Outputs:                             "Outputs",
+	TransactionByHashPurchases:          "TransactionByHashPurchases",
+	SpentTransactionHashSupports:        "SpentTransactionHashSupports",
+	TransactionHashSupports:             "TransactionHashSupports",
+	TransactionAddresses:                "TransactionAddresses",
 }
 
 // transactionR is where relationships are stored.
 type transactionR struct {
-	BlockHash                  *Block                  `boil:"BlockHash" json:"BlockHash" toml:"BlockHash" yaml:"BlockHash"`
-	TransactionHashClaims      ClaimSlice              `boil:"TransactionHashClaims" json:"TransactionHashClaims" toml:"TransactionHashClaims" yaml:"TransactionHashClaims"`
-	Inputs                     InputSlice              `boil:"Inputs" json:"Inputs" toml:"Inputs" yaml:"Inputs"`
-	Outputs                    OutputSlice             `boil:"Outputs" json:"Outputs" toml:"Outputs" yaml:"Outputs"`
-	TransactionByHashPurchases PurchaseSlice           `boil:"TransactionByHashPurchases" json:"TransactionByHashPurchases" toml:"TransactionByHashPurchases" yaml:"TransactionByHashPurchases"`
-	TransactionHashSupports    SupportSlice            `boil:"TransactionHashSupports" json:"TransactionHashSupports" toml:"TransactionHashSupports" yaml:"TransactionHashSupports"`
-	TransactionAddresses       TransactionAddressSlice `boil:"TransactionAddresses" json:"TransactionAddresses" toml:"TransactionAddresses" yaml:"TransactionAddresses"`
+	BlockHash                           *Block                  `boil:"BlockHash" json:"BlockHash" toml:"BlockHash" yaml:"BlockHash"`
+	TransactionHashClaims               ClaimSlice              `boil:"TransactionHashClaims" json:"TransactionHashClaims" toml:"TransactionHashClaims" yaml:"TransactionHashClaims"`
+	TransactionHashClaimVersions        ClaimVersionSlice       `boil:"TransactionHashClaimVersions" json:"TransactionHashClaimVersions" toml:"TransactionHashClaimVersions" yaml:"TransactionHashClaimVersions"`
+	Inputs                              InputSlice              `boil:"Inputs" json:"Inputs" toml:"Inputs" yaml:"Inputs"`
+	TransactionHashNotificationOutboxes NotificationOutboxSlice `boil:"TransactionHashNotificationOutboxes" json:"TransactionHashNotificationOutboxes" toml:"TransactionHashNotificationOutboxes" yaml:"TransactionHashNotificationOutboxes"`
+	Outputs                             OutputSlice             `boil:"Outputs" json:"Outputs" toml:"Outputs" yaml:"Outputs"`
+	TransactionByHashPurchases          PurchaseSlice           `boil:"TransactionByHashPurchases" json:"TransactionByHashPurchases" toml:"TransactionByHashPurchases" yaml:"TransactionByHashPurchases"`
+	SpentTransactionHashSupports        SupportSlice            `boil:"SpentTransactionHashSupports" json:"SpentTransactionHashSupports" toml:"SpentTransactionHashSupports" yaml:"SpentTransactionHashSupports"`
+	TransactionHashSupports             SupportSlice            `boil:"TransactionHashSupports" json:"TransactionHashSupports" toml:"TransactionHashSupports" yaml:"TransactionHashSupports"`
+	TransactionAddresses                TransactionAddressSlice `boil:"TransactionAddresses" json:"TransactionAddresses" toml:"TransactionAddresses" yaml:"TransactionAddresses"`
 }
 
 // NewStruct creates a new relationship struct
@@ -182,6 +191,13 @@ func (r *transactionR) GetTransactionHashClaims() ClaimSlice {
 	return r.TransactionHashClaims
 }
 
+func (r *transactionR) GetTransactionHashClaimVersions() ClaimVersionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransactionHashClaimVersions
+}
+
 func (r *transactionR) GetInputs() InputSlice {
 	if r == nil {
 		return nil
@@ -189,6 +205,13 @@ func (r *transactionR) GetInputs() InputSlice {
 	return r.Inputs
 }
 
+func (r *transactionR) GetTransactionHashNotificationOutboxes() NotificationOutboxSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransactionHashNotificationOutboxes
+}
+
 func (r *transactionR) GetOutputs() OutputSlice {
 	if r == nil {
 		return nil
@@ -203,6 +226,13 @@ func (r *transactionR) GetTransactionByHashPurchases() PurchaseSlice {
 	return r.TransactionByHashPurchases
 }
 
+func (r *transactionR) GetSpentTransactionHashSupports() SupportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SpentTransactionHashSupports
+}
+
 func (r *transactionR) GetTransactionHashSupports() SupportSlice {
 	if r == nil {
 		return nil
@@ -444,6 +474,20 @@ func (o *Transaction) TransactionHashClaims(mods ...qm.QueryMod) claimQuery {
 	return Claims(queryMods...)
 }
 
+// TransactionHashClaimVersions retrieves all the claim_version's ClaimVersions with an executor via transaction_hash column.
+func (o *Transaction) TransactionHashClaimVersions(mods ...qm.QueryMod) claimVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`claim_version`.`transaction_hash`=?", o.Hash),
+	)
+
+	return ClaimVersions(queryMods...)
+}
+
 // Inputs retrieves all the input's Inputs with an executor.
 func (o *Transaction) Inputs(mods ...qm.QueryMod) inputQuery {
 	var queryMods []qm.QueryMod
@@ -458,6 +502,20 @@ func (o *Transaction) Inputs(mods ...qm.QueryMod) inputQuery {
 	return Inputs(queryMods...)
 }
 
+// TransactionHashNotificationOutboxes retrieves all the notification_outbox's NotificationOutboxes with an executor via transaction_hash column.
+func (o *Transaction) TransactionHashNotificationOutboxes(mods ...qm.QueryMod) notificationOutboxQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notification_outbox`.`transaction_hash`=?", o.Hash),
+	)
+
+	return NotificationOutboxes(queryMods...)
+}
+
 // Outputs retrieves all the output's Outputs with an executor.
 func (o *Transaction) Outputs(mods ...qm.QueryMod) outputQuery {
 	var queryMods []qm.QueryMod
@@ -486,6 +544,20 @@ func (o *Transaction) TransactionByHashPurchases(mods ...qm.QueryMod) purchaseQu
 	return Purchases(queryMods...)
 }
 
+// SpentTransactionHashSupports retrieves all the support's Supports with an executor via spent_transaction_hash column.
+func (o *Transaction) SpentTransactionHashSupports(mods ...qm.QueryMod) supportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`support`.`spent_transaction_hash`=?", o.Hash),
+	)
+
+	return Supports(queryMods...)
+}
+
 // TransactionHashSupports retrieves all the support's Supports with an executor via transaction_hash_id column.
 func (o *Transaction) TransactionHashSupports(mods ...qm.QueryMod) supportQuery {
 	var queryMods []qm.QueryMod
@@ -736,6 +808,112 @@ func (transactionL) LoadTransactionHashClaims(e boil.Executor, singular bool, ma
 	return nil
 }
 
+// LoadTransactionHashClaimVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadTransactionHashClaimVersions(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args[object.Hash] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+			args[obj.Hash] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`claim_version`),
+		qm.WhereIn(`claim_version.transaction_hash in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load claim_version")
+	}
+
+	var resultSlice []*ClaimVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice claim_version")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on claim_version")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for claim_version")
+	}
+
+	if singular {
+		object.R.TransactionHashClaimVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &claimVersionR{}
+			}
+			foreign.R.TransactionHashTransaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Hash == foreign.TransactionHash {
+				local.R.TransactionHashClaimVersions = append(local.R.TransactionHashClaimVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &claimVersionR{}
+				}
+				foreign.R.TransactionHashTransaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadInputs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (transactionL) LoadInputs(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
@@ -842,6 +1020,112 @@ func (transactionL) LoadInputs(e boil.Executor, singular bool, maybeTransaction
 	return nil
 }
 
+// LoadTransactionHashNotificationOutboxes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadTransactionHashNotificationOutboxes(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args[object.Hash] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+			args[obj.Hash] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_outbox`),
+		qm.WhereIn(`notification_outbox.transaction_hash in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_outbox")
+	}
+
+	var resultSlice []*NotificationOutbox
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_outbox")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_outbox")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_outbox")
+	}
+
+	if singular {
+		object.R.TransactionHashNotificationOutboxes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationOutboxR{}
+			}
+			foreign.R.TransactionHashTransaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.Hash, foreign.TransactionHash) {
+				local.R.TransactionHashNotificationOutboxes = append(local.R.TransactionHashNotificationOutboxes, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationOutboxR{}
+				}
+				foreign.R.TransactionHashTransaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadOutputs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (transactionL) LoadOutputs(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
@@ -1054,9 +1338,9 @@ func (transactionL) LoadTransactionByHashPurchases(e boil.Executor, singular boo
 	return nil
 }
 
-// LoadTransactionHashSupports allows an eager lookup of values, cached into the
+// LoadSpentTransactionHashSupports allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (transactionL) LoadTransactionHashSupports(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+func (transactionL) LoadSpentTransactionHashSupports(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
 	var slice []*Transaction
 	var object *Transaction
 
@@ -1110,7 +1394,7 @@ func (transactionL) LoadTransactionHashSupports(e boil.Executor, singular bool,
 
 	query := NewQuery(
 		qm.From(`support`),
-		qm.WhereIn(`support.transaction_hash_id in ?`, argsSlice...),
+		qm.WhereIn(`support.spent_transaction_hash in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1134,24 +1418,24 @@ func (transactionL) LoadTransactionHashSupports(e boil.Executor, singular bool,
 	}
 
 	if singular {
-		object.R.TransactionHashSupports = resultSlice
+		object.R.SpentTransactionHashSupports = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &supportR{}
 			}
-			foreign.R.TransactionHash = object
+			foreign.R.SpentTransactionHashTransaction = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.Hash, foreign.TransactionHashID) {
-				local.R.TransactionHashSupports = append(local.R.TransactionHashSupports, foreign)
+			if queries.Equal(local.Hash, foreign.SpentTransactionHash) {
+				local.R.SpentTransactionHashSupports = append(local.R.SpentTransactionHashSupports, foreign)
 				if foreign.R == nil {
 					foreign.R = &supportR{}
 				}
-				foreign.R.TransactionHash = local
+				foreign.R.SpentTransactionHashTransaction = local
 				break
 			}
 		}
@@ -1160,9 +1444,9 @@ func (transactionL) LoadTransactionHashSupports(e boil.Executor, singular bool,
 	return nil
 }
 
-// LoadTransactionAddresses allows an eager lookup of values, cached into the
+// LoadTransactionHashSupports allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (transactionL) LoadTransactionAddresses(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+func (transactionL) LoadTransactionHashSupports(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
 	var slice []*Transaction
 	var object *Transaction
 
@@ -1193,13 +1477,13 @@ func (transactionL) LoadTransactionAddresses(e boil.Executor, singular bool, may
 		if object.R == nil {
 			object.R = &transactionR{}
 		}
-		args[object.ID] = struct{}{}
+		args[object.Hash] = struct{}{}
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &transactionR{}
 			}
-			args[obj.ID] = struct{}{}
+			args[obj.Hash] = struct{}{}
 		}
 	}
 
@@ -1215,8 +1499,8 @@ func (transactionL) LoadTransactionAddresses(e boil.Executor, singular bool, may
 	}
 
 	query := NewQuery(
-		qm.From(`transaction_address`),
-		qm.WhereIn(`transaction_address.transaction_id in ?`, argsSlice...),
+		qm.From(`support`),
+		qm.WhereIn(`support.transaction_hash_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1224,19 +1508,125 @@ func (transactionL) LoadTransactionAddresses(e boil.Executor, singular bool, may
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transaction_address")
+		return errors.Wrap(err, "failed to eager load support")
 	}
 
-	var resultSlice []*TransactionAddress
+	var resultSlice []*Support
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transaction_address")
+		return errors.Wrap(err, "failed to bind eager loaded slice support")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transaction_address")
+		return errors.Wrap(err, "failed to close results in eager load on support")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction_address")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for support")
+	}
+
+	if singular {
+		object.R.TransactionHashSupports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &supportR{}
+			}
+			foreign.R.TransactionHash = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.Hash, foreign.TransactionHashID) {
+				local.R.TransactionHashSupports = append(local.R.TransactionHashSupports, foreign)
+				if foreign.R == nil {
+					foreign.R = &supportR{}
+				}
+				foreign.R.TransactionHash = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactionAddresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transactionL) LoadTransactionAddresses(e boil.Executor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transaction_address`),
+		qm.WhereIn(`transaction_address.transaction_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transaction_address")
+	}
+
+	var resultSlice []*TransactionAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transaction_address")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transaction_address")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transaction_address")
 	}
 
 	if singular {
@@ -1622,6 +2012,89 @@ func (o *Transaction) RemoveTransactionHashClaims(exec boil.Executor, related ..
 	return nil
 }
 
+// AddTransactionHashClaimVersionsG adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashClaimVersions.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Uses the global database handle.
+func (o *Transaction) AddTransactionHashClaimVersionsG(insert bool, related ...*ClaimVersion) error {
+	return o.AddTransactionHashClaimVersions(boil.GetDB(), insert, related...)
+}
+
+// AddTransactionHashClaimVersionsP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashClaimVersions.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Panics on error.
+func (o *Transaction) AddTransactionHashClaimVersionsP(exec boil.Executor, insert bool, related ...*ClaimVersion) {
+	if err := o.AddTransactionHashClaimVersions(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTransactionHashClaimVersionsGP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashClaimVersions.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Uses the global database handle and panics on error.
+func (o *Transaction) AddTransactionHashClaimVersionsGP(insert bool, related ...*ClaimVersion) {
+	if err := o.AddTransactionHashClaimVersions(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTransactionHashClaimVersions adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashClaimVersions.
+// Sets related.R.TransactionHashTransaction appropriately.
+func (o *Transaction) AddTransactionHashClaimVersions(exec boil.Executor, insert bool, related ...*ClaimVersion) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TransactionHash = o.Hash
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `claim_version` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"transaction_hash"}),
+				strmangle.WhereClause("`", "`", 0, claimVersionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Hash, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TransactionHash = o.Hash
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			TransactionHashClaimVersions: related,
+		}
+	} else {
+		o.R.TransactionHashClaimVersions = append(o.R.TransactionHashClaimVersions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &claimVersionR{
+				TransactionHashTransaction: o,
+			}
+		} else {
+			rel.R.TransactionHashTransaction = o
+		}
+	}
+	return nil
+}
+
 // AddInputsG adds the given related objects to the existing relationships
 // of the transaction, optionally inserting them as new records.
 // Appends related to o.R.Inputs.
@@ -1705,6 +2178,227 @@ func (o *Transaction) AddInputs(exec boil.Executor, insert bool, related ...*Inp
 	return nil
 }
 
+// AddTransactionHashNotificationOutboxesG adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashNotificationOutboxes.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Uses the global database handle.
+func (o *Transaction) AddTransactionHashNotificationOutboxesG(insert bool, related ...*NotificationOutbox) error {
+	return o.AddTransactionHashNotificationOutboxes(boil.GetDB(), insert, related...)
+}
+
+// AddTransactionHashNotificationOutboxesP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashNotificationOutboxes.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Panics on error.
+func (o *Transaction) AddTransactionHashNotificationOutboxesP(exec boil.Executor, insert bool, related ...*NotificationOutbox) {
+	if err := o.AddTransactionHashNotificationOutboxes(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTransactionHashNotificationOutboxesGP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashNotificationOutboxes.
+// Sets related.R.TransactionHashTransaction appropriately.
+// Uses the global database handle and panics on error.
+func (o *Transaction) AddTransactionHashNotificationOutboxesGP(insert bool, related ...*NotificationOutbox) {
+	if err := o.AddTransactionHashNotificationOutboxes(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTransactionHashNotificationOutboxes adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionHashNotificationOutboxes.
+// Sets related.R.TransactionHashTransaction appropriately.
+func (o *Transaction) AddTransactionHashNotificationOutboxes(exec boil.Executor, insert bool, related ...*NotificationOutbox) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransactionHash, o.Hash)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_outbox` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"transaction_hash"}),
+				strmangle.WhereClause("`", "`", 0, notificationOutboxPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Hash, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransactionHash, o.Hash)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			TransactionHashNotificationOutboxes: related,
+		}
+	} else {
+		o.R.TransactionHashNotificationOutboxes = append(o.R.TransactionHashNotificationOutboxes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationOutboxR{
+				TransactionHashTransaction: o,
+			}
+		} else {
+			rel.R.TransactionHashTransaction = o
+		}
+	}
+	return nil
+}
+
+// SetTransactionHashNotificationOutboxesG removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Replaces o.R.TransactionHashNotificationOutboxes with related.
+// Sets related.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Uses the global database handle.
+func (o *Transaction) SetTransactionHashNotificationOutboxesG(insert bool, related ...*NotificationOutbox) error {
+	return o.SetTransactionHashNotificationOutboxes(boil.GetDB(), insert, related...)
+}
+
+// SetTransactionHashNotificationOutboxesP removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Replaces o.R.TransactionHashNotificationOutboxes with related.
+// Sets related.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Panics on error.
+func (o *Transaction) SetTransactionHashNotificationOutboxesP(exec boil.Executor, insert bool, related ...*NotificationOutbox) {
+	if err := o.SetTransactionHashNotificationOutboxes(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashNotificationOutboxesGP removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Replaces o.R.TransactionHashNotificationOutboxes with related.
+// Sets related.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Uses the global database handle and panics on error.
+func (o *Transaction) SetTransactionHashNotificationOutboxesGP(insert bool, related ...*NotificationOutbox) {
+	if err := o.SetTransactionHashNotificationOutboxes(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTransactionHashNotificationOutboxes removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+// Replaces o.R.TransactionHashNotificationOutboxes with related.
+// Sets related.R.TransactionHashTransaction's TransactionHashNotificationOutboxes accordingly.
+func (o *Transaction) SetTransactionHashNotificationOutboxes(exec boil.Executor, insert bool, related ...*NotificationOutbox) error {
+	query := "update `notification_outbox` set `transaction_hash` = null where `transaction_hash` = ?"
+	values := []interface{}{o.Hash}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TransactionHashNotificationOutboxes {
+			queries.SetScanner(&rel.TransactionHash, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TransactionHashTransaction = nil
+		}
+		o.R.TransactionHashNotificationOutboxes = nil
+	}
+
+	return o.AddTransactionHashNotificationOutboxes(exec, insert, related...)
+}
+
+// RemoveTransactionHashNotificationOutboxesG relationships from objects passed in.
+// Removes related items from R.TransactionHashNotificationOutboxes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransactionHashTransaction.
+// Uses the global database handle.
+func (o *Transaction) RemoveTransactionHashNotificationOutboxesG(related ...*NotificationOutbox) error {
+	return o.RemoveTransactionHashNotificationOutboxes(boil.GetDB(), related...)
+}
+
+// RemoveTransactionHashNotificationOutboxesP relationships from objects passed in.
+// Removes related items from R.TransactionHashNotificationOutboxes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransactionHashTransaction.
+// Panics on error.
+func (o *Transaction) RemoveTransactionHashNotificationOutboxesP(exec boil.Executor, related ...*NotificationOutbox) {
+	if err := o.RemoveTransactionHashNotificationOutboxes(exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveTransactionHashNotificationOutboxesGP relationships from objects passed in.
+// Removes related items from R.TransactionHashNotificationOutboxes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransactionHashTransaction.
+// Uses the global database handle and panics on error.
+func (o *Transaction) RemoveTransactionHashNotificationOutboxesGP(related ...*NotificationOutbox) {
+	if err := o.RemoveTransactionHashNotificationOutboxes(boil.GetDB(), related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveTransactionHashNotificationOutboxes relationships from objects passed in.
+// Removes related items from R.TransactionHashNotificationOutboxes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransactionHashTransaction.
+func (o *Transaction) RemoveTransactionHashNotificationOutboxes(exec boil.Executor, related ...*NotificationOutbox) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransactionHash, nil)
+		if rel.R != nil {
+			rel.R.TransactionHashTransaction = nil
+		}
+		if err = rel.Update(exec, boil.Whitelist("transaction_hash")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TransactionHashNotificationOutboxes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TransactionHashNotificationOutboxes)
+			if ln > 1 && i < ln-1 {
+				o.R.TransactionHashNotificationOutboxes[i] = o.R.TransactionHashNotificationOutboxes[ln-1]
+			}
+			o.R.TransactionHashNotificationOutboxes = o.R.TransactionHashNotificationOutboxes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddOutputsG adds the given related objects to the existing relationships
 // of the transaction, optionally inserting them as new records.
 // Appends related to o.R.Outputs.
@@ -2009,6 +2703,227 @@ func (o *Transaction) RemoveTransactionByHashPurchases(exec boil.Executor, relat
 	return nil
 }
 
+// AddSpentTransactionHashSupportsG adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.SpentTransactionHashSupports.
+// Sets related.R.SpentTransactionHashTransaction appropriately.
+// Uses the global database handle.
+func (o *Transaction) AddSpentTransactionHashSupportsG(insert bool, related ...*Support) error {
+	return o.AddSpentTransactionHashSupports(boil.GetDB(), insert, related...)
+}
+
+// AddSpentTransactionHashSupportsP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.SpentTransactionHashSupports.
+// Sets related.R.SpentTransactionHashTransaction appropriately.
+// Panics on error.
+func (o *Transaction) AddSpentTransactionHashSupportsP(exec boil.Executor, insert bool, related ...*Support) {
+	if err := o.AddSpentTransactionHashSupports(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddSpentTransactionHashSupportsGP adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.SpentTransactionHashSupports.
+// Sets related.R.SpentTransactionHashTransaction appropriately.
+// Uses the global database handle and panics on error.
+func (o *Transaction) AddSpentTransactionHashSupportsGP(insert bool, related ...*Support) {
+	if err := o.AddSpentTransactionHashSupports(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddSpentTransactionHashSupports adds the given related objects to the existing relationships
+// of the transaction, optionally inserting them as new records.
+// Appends related to o.R.SpentTransactionHashSupports.
+// Sets related.R.SpentTransactionHashTransaction appropriately.
+func (o *Transaction) AddSpentTransactionHashSupports(exec boil.Executor, insert bool, related ...*Support) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SpentTransactionHash, o.Hash)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `support` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"spent_transaction_hash"}),
+				strmangle.WhereClause("`", "`", 0, supportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Hash, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SpentTransactionHash, o.Hash)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transactionR{
+			SpentTransactionHashSupports: related,
+		}
+	} else {
+		o.R.SpentTransactionHashSupports = append(o.R.SpentTransactionHashSupports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &supportR{
+				SpentTransactionHashTransaction: o,
+			}
+		} else {
+			rel.R.SpentTransactionHashTransaction = o
+		}
+	}
+	return nil
+}
+
+// SetSpentTransactionHashSupportsG removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Replaces o.R.SpentTransactionHashSupports with related.
+// Sets related.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Uses the global database handle.
+func (o *Transaction) SetSpentTransactionHashSupportsG(insert bool, related ...*Support) error {
+	return o.SetSpentTransactionHashSupports(boil.GetDB(), insert, related...)
+}
+
+// SetSpentTransactionHashSupportsP removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Replaces o.R.SpentTransactionHashSupports with related.
+// Sets related.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Panics on error.
+func (o *Transaction) SetSpentTransactionHashSupportsP(exec boil.Executor, insert bool, related ...*Support) {
+	if err := o.SetSpentTransactionHashSupports(exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetSpentTransactionHashSupportsGP removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Replaces o.R.SpentTransactionHashSupports with related.
+// Sets related.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Uses the global database handle and panics on error.
+func (o *Transaction) SetSpentTransactionHashSupportsGP(insert bool, related ...*Support) {
+	if err := o.SetSpentTransactionHashSupports(boil.GetDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetSpentTransactionHashSupports removes all previously related items of the
+// transaction replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+// Replaces o.R.SpentTransactionHashSupports with related.
+// Sets related.R.SpentTransactionHashTransaction's SpentTransactionHashSupports accordingly.
+func (o *Transaction) SetSpentTransactionHashSupports(exec boil.Executor, insert bool, related ...*Support) error {
+	query := "update `support` set `spent_transaction_hash` = null where `spent_transaction_hash` = ?"
+	values := []interface{}{o.Hash}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SpentTransactionHashSupports {
+			queries.SetScanner(&rel.SpentTransactionHash, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SpentTransactionHashTransaction = nil
+		}
+		o.R.SpentTransactionHashSupports = nil
+	}
+
+	return o.AddSpentTransactionHashSupports(exec, insert, related...)
+}
+
+// RemoveSpentTransactionHashSupportsG relationships from objects passed in.
+// Removes related items from R.SpentTransactionHashSupports (uses pointer comparison, removal does not keep order)
+// Sets related.R.SpentTransactionHashTransaction.
+// Uses the global database handle.
+func (o *Transaction) RemoveSpentTransactionHashSupportsG(related ...*Support) error {
+	return o.RemoveSpentTransactionHashSupports(boil.GetDB(), related...)
+}
+
+// RemoveSpentTransactionHashSupportsP relationships from objects passed in.
+// Removes related items from R.SpentTransactionHashSupports (uses pointer comparison, removal does not keep order)
+// Sets related.R.SpentTransactionHashTransaction.
+// Panics on error.
+func (o *Transaction) RemoveSpentTransactionHashSupportsP(exec boil.Executor, related ...*Support) {
+	if err := o.RemoveSpentTransactionHashSupports(exec, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveSpentTransactionHashSupportsGP relationships from objects passed in.
+// Removes related items from R.SpentTransactionHashSupports (uses pointer comparison, removal does not keep order)
+// Sets related.R.SpentTransactionHashTransaction.
+// Uses the global database handle and panics on error.
+func (o *Transaction) RemoveSpentTransactionHashSupportsGP(related ...*Support) {
+	if err := o.RemoveSpentTransactionHashSupports(boil.GetDB(), related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// RemoveSpentTransactionHashSupports relationships from objects passed in.
+// Removes related items from R.SpentTransactionHashSupports (uses pointer comparison, removal does not keep order)
+// Sets related.R.SpentTransactionHashTransaction.
+func (o *Transaction) RemoveSpentTransactionHashSupports(exec boil.Executor, related ...*Support) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SpentTransactionHash, nil)
+		if rel.R != nil {
+			rel.R.SpentTransactionHashTransaction = nil
+		}
+		if err = rel.Update(exec, boil.Whitelist("spent_transaction_hash")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SpentTransactionHashSupports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SpentTransactionHashSupports)
+			if ln > 1 && i < ln-1 {
+				o.R.SpentTransactionHashSupports[i] = o.R.SpentTransactionHashSupports[ln-1]
+			}
+			o.R.SpentTransactionHashSupports = o.R.SpentTransactionHashSupports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddTransactionHashSupportsG adds the given related objects to the existing relationships
 // of the transaction, optionally inserting them as new records.
 // Appends related to o.R.TransactionHashSupports.
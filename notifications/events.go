@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/lbryio/chainquery/global"
 	"github.com/lbryio/chainquery/model"
 	"github.com/lbryio/chainquery/sockety"
 
@@ -14,30 +15,184 @@ import (
 	"github.com/OdyseeTeam/sockety/socketyapi"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cast"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-const payment = "payment"
-const newClaim = "new_claim"
+// Types of events.
+const (
+	payment        = "payment"
+	newClaim       = "new_claim"
+	claimUpdate    = "claim_update"
+	channelCreated = "channel_created"
+	newSupport     = "support"
+	abandon        = "abandon"
+	newPurchase    = "purchase"
+	reorg          = "reorg"
+)
+
+// EventTypes are the types of events subscribers can subscribe to.
+var EventTypes = []string{payment, newClaim, claimUpdate, channelCreated, newSupport, abandon, newPurchase, reorg}
 
-// PaymentEvent event to notify subscribers of a payment transaction
-func PaymentEvent(lbc float64, address, txid string, vout uint) {
+// PaymentEvent event to notify subscribers of a payment transaction, in the mempool or mined in a block
+func PaymentEvent(lbc float64, address, txid string, vout uint, blockHash string) error {
 	values := url.Values{}
 	values.Add("lbc", cast.ToString(lbc))
 	values.Add("tx_id", txid)
 	values.Add("vout", cast.ToString(vout))
 	values.Add("address", address)
-	Notify(payment, values)
+	attributes := Attributes{Mempool: blockHash == mempoolBlockHash, Address: address, Amount: lbc}
+	err := Notify(payment, txid, blockHash, attributes, values)
+	if err != nil {
+		return err
+	}
 	sockety.SendNotification(socketyapi.SendNotificationArgs{
 		Service: socketyapi.BlockChain,
 		Type:    "payments",
 		IDs:     []string{"payments", address, strconv.Itoa(int(lbc * 0.001))},
 		Data:    map[string]interface{}{"lbc": lbc, "address": address, "txid": txid, "vout": vout},
 	})
+	return nil
+}
+
+// ClaimEvent event to notify subscribers of a new claim that's been published, with the amount of its bid
+func ClaimEvent(claim *model.Claim, tx model.Transaction, amount float64, claimData *c.StakeHelper) error {
+	values, ok := claimValues(claim, tx, claimData)
+	if !ok {
+		return nil
+	}
+	return Notify(newClaim, tx.Hash, tx.BlockHashID.String, claimAttributes(claim, tx, amount, claimData), values)
+}
+
+// ClaimUpdateEvent notifies subscribers of the update of a claim, with the amount of its new bid. It carries the same
+// values as the new claim event.
+func ClaimUpdateEvent(claim *model.Claim, tx model.Transaction, amount float64, claimData *c.StakeHelper) error {
+	values, ok := claimValues(claim, tx, claimData)
+	if !ok {
+		return nil
+	}
+	values.Add("vout", cast.ToString(claim.VoutUpdate.Uint))
+	values.Add("amount", cast.ToString(amount))
+	return publish(claimUpdate, tx.Hash, tx.BlockHashID.String, claimAttributes(claim, tx, amount, claimData), values, claim.ClaimID)
 }
 
-// ClaimEvent event to notify subscribers of a new claim that's been published
-func ClaimEvent(claim *model.Claim, tx model.Transaction, claimData *c.StakeHelper) {
+// ChannelCreatedEvent notifies subscribers of a new channel, with the amount of its bid.
+func ChannelCreatedEvent(channel *model.Claim, tx model.Transaction, amount float64) error {
+	values := url.Values{}
+	values.Add("claim_id", channel.ClaimID)
+	values.Add("name", channel.Name)
+	values.Add("tx_id", tx.Hash)
+	values.Add("vout", cast.ToString(channel.Vout))
+	values.Add("amount", cast.ToString(amount))
+	values.Add("claim_address", channel.ClaimAddress)
+	if !channel.Title.IsZero() {
+		values.Add("title", channel.Title.String)
+	}
+	if !channel.Description.IsZero() {
+		values.Add("description", channel.Description.String)
+	}
+	if !channel.ThumbnailURL.IsZero() {
+		values.Add("thumbnail_url", channel.ThumbnailURL.String)
+	}
+	attributes := Attributes{
+		Mempool:   tx.BlockHashID.String == mempoolBlockHash,
+		Amount:    amount,
+		ClaimType: global.ChannelClaimType,
+		ChannelID: channel.ClaimID,
+	}
+	return publish(channelCreated, tx.Hash, tx.BlockHashID.String, attributes, values, channel.ClaimID)
+}
+
+// SupportEvent notifies subscribers of a support of a claim, or of a tip when sent by someone other than its owner.
+func SupportEvent(support *model.Support, claimID, name string, tx model.Transaction) error {
+	values := url.Values{}
+	values.Add("claim_id", claimID)
+	values.Add("name", name)
+	values.Add("tx_id", tx.Hash)
+	values.Add("vout", cast.ToString(support.Vout))
+	values.Add("amount", cast.ToString(support.SupportAmount))
+	values.Add("is_tip", strconv.FormatBool(support.IsTip))
+	if !support.SupporterAddress.IsZero() {
+		values.Add("supporter_address", support.SupporterAddress.String)
+	}
+	if !support.SupportedByClaimID.IsZero() {
+		values.Add("supported_by_claim_id", support.SupportedByClaimID.String)
+	}
+	attributes := Attributes{Mempool: tx.BlockHashID.String == mempoolBlockHash, Amount: support.SupportAmount}
+	return publish(newSupport, tx.Hash, tx.BlockHashID.String, attributes, values, claimID)
+}
+
+// AbandonEvent notifies subscribers of a claim abandoned by a transaction spending its last outpoint.
+func AbandonEvent(claim *model.Claim, tx model.Transaction) error {
+	values := url.Values{}
+	values.Add("claim_id", claim.ClaimID)
+	values.Add("name", claim.Name)
+	if !claim.Type.IsZero() {
+		values.Add("type", claim.Type.String)
+	}
+	values.Add("tx_id", tx.Hash)
+	if !claim.PublisherID.IsZero() {
+		values.Add("channel_claim_id", claim.PublisherID.String)
+	}
+	attributes := Attributes{
+		Mempool:   tx.BlockHashID.String == mempoolBlockHash,
+		ClaimType: claim.Type.String,
+		ChannelID: claim.PublisherID.String,
+	}
+	if claim.Type.String == global.ChannelClaimType {
+		attributes.ChannelID = claim.ClaimID
+	}
+	return publish(abandon, tx.Hash, tx.BlockHashID.String, attributes, values, claim.ClaimID)
+}
+
+// PurchaseEvent notifies subscribers of a purchase of a claim. The fee and what was paid for it are only known once
+// the purchase is resolved.
+func PurchaseEvent(purchase *model.Purchase, tx model.Transaction) error {
+	values := url.Values{}
+	values.Add("claim_id", purchase.ClaimID.String)
+	values.Add("tx_id", tx.Hash)
+	values.Add("vout", cast.ToString(purchase.Vout))
+	values.Add("is_resolved", strconv.FormatBool(purchase.IsResolved))
+	amount := float64(purchase.AmountSatoshi) / 1e8
+	if purchase.IsResolved {
+		values.Add("amount", cast.ToString(amount))
+		values.Add("fee", cast.ToString(purchase.Fee))
+	}
+	optional := map[string]null.String{
+		"publisher_id":  purchase.PublisherID,
+		"fee_currency":  purchase.FeeCurrency,
+		"fee_address":   purchase.FeeAddress,
+		"buyer_address": purchase.BuyerAddress,
+	}
+	for key, value := range optional {
+		if !value.IsZero() {
+			values.Add(key, value.String)
+		}
+	}
+	if !purchase.FeeSatisfied.IsZero() {
+		values.Add("fee_satisfied", strconv.FormatBool(purchase.FeeSatisfied.Bool))
+	}
+	attributes := Attributes{
+		Mempool:   tx.BlockHashID.String == mempoolBlockHash,
+		Address:   purchase.FeeAddress.String,
+		Amount:    amount,
+		ChannelID: purchase.PublisherID.String,
+	}
+	return publish(newPurchase, tx.Hash, tx.BlockHashID.String, attributes, values, purchase.ClaimID.String)
+}
+
+// ReorgEvent notifies subscribers of a reorg, with the hashes of the blocks it removed from the tip down.
+func ReorgEvent(depth int, height, lastMatchingHeight uint64, removedBlocks []string) error {
+	values := url.Values{}
+	values.Add("depth", strconv.Itoa(depth))
+	values.Add("height", strconv.FormatUint(height, 10))
+	values.Add("last_matching_height", strconv.FormatUint(lastMatchingHeight, 10))
+	values.Add("removed_blocks", strings.Join(removedBlocks, ","))
+	return publish(reorg, "", "", Attributes{}, values, strconv.FormatUint(height, 10))
+}
+
+// claimValues are the values of a claim event. Claims without a title and unlisted claims are not notified.
+func claimValues(claim *model.Claim, tx model.Transaction, claimData *c.StakeHelper) (url.Values, bool) {
 	values := url.Values{}
 	values.Add("claim_id", claim.ClaimID)
 	values.Add("name", claim.Name)
@@ -45,7 +200,7 @@ func ClaimEvent(claim *model.Claim, tx model.Transaction, claimData *c.StakeHelp
 		values.Add("type", claim.Type.String)
 	}
 	if claim.Title.IsZero() || claim.Title.String == "" {
-		return //we can't use claims without a title
+		return nil, false //we can't use claims without a title
 	}
 	values.Add("title", claim.Title.String)
 	if !claim.Description.IsZero() {
@@ -94,7 +249,44 @@ func ClaimEvent(claim *model.Claim, tx model.Transaction, claimData *c.StakeHelp
 	}
 	//skip unlisted claims from being broadcast
 	if isUnlisted {
-		return
+		return nil, false
+	}
+	return values, true
+}
+
+func claimAttributes(claim *model.Claim, tx model.Transaction, amount float64, claimData *c.StakeHelper) Attributes {
+	attributes := Attributes{
+		Mempool:   tx.BlockHashID.String == mempoolBlockHash,
+		Amount:    amount,
+		ClaimType: claim.Type.String,
+		ChannelID: claim.PublisherID.String,
+		Tags:      claimData.Claim.GetTags(),
+	}
+	if claim.Type.String == global.ChannelClaimType {
+		attributes.ChannelID = claim.ClaimID
 	}
-	Notify(newClaim, values)
+	return attributes
+}
+
+// publish notifies the subscribers of an event and mirrors it to sockety as an Event, under the "event" type with the
+// event type as category.
+func publish(t, txHash, blockHash string, attributes Attributes, values url.Values, ids ...string) error {
+	err := Notify(t, txHash, blockHash, attributes, values)
+	if err != nil {
+		return err
+	}
+	category := t
+	sockety.SendNotification(socketyapi.SendNotificationArgs{
+		Service:  socketyapi.BlockChain,
+		Type:     "event",
+		Category: &category,
+		IDs:      append([]string{"events", t}, ids...),
+		Data:     map[string]interface{}{"event": newEvent(t, txHash, blockHash, values)},
+	})
+	return nil
+}
+
+// AbandonEventWanted tells if abandoned claims are notified, so processing only looks them up when they are.
+func AbandonEventWanted() bool {
+	return sockety.Enabled() || anySubscriber(func(s subscriber) bool { return s.Type == abandon })
 }
@@ -0,0 +1,81 @@
+package notifications
+
+import (
+	"strings"
+)
+
+// Attributes are what subscriber filters select events on. An event leaves out the attributes it doesn't have.
+type Attributes struct {
+	// Mempool is set for events of unconfirmed transactions.
+	Mempool bool
+	// Address is the address paid.
+	Address string
+	// Amount is the LBC paid, or the bid of a claim.
+	Amount float64
+	// ClaimType is the type of a claim, like global.StreamClaimType.
+	ClaimType string
+	// ChannelID is the claim id of the channel signing a claim, or of the channel itself.
+	ChannelID string
+	// Tags are the tags of a claim.
+	Tags []string
+}
+
+// Filter selects the events delivered to a subscriber. Each criteria set must be met, an event without the attribute a
+// criteria is on doesn't meet it. The zero Filter selects every event.
+type Filter struct {
+	// Addresses the payments must be to.
+	Addresses []string
+	// MinAmount is the least LBC paid or bid.
+	MinAmount float64
+	// ClaimTypes the claims must be of.
+	ClaimTypes []string
+	// ChannelIDs of the channels the claims must be in.
+	ChannelIDs []string
+	// Tags of which the claims must have at least one.
+	Tags []string
+	// ExcludeMempool drops the events of unconfirmed transactions.
+	ExcludeMempool bool
+	// ExcludeConfirmed drops the events of mined transactions. The new_claim and channel_created events are only sent
+	// once mined, it is rejected for their subscribers.
+	ExcludeConfirmed bool
+}
+
+// Matches tells if the filter selects an event with the attributes.
+func (f Filter) Matches(attributes Attributes) bool {
+	if f.ExcludeMempool && attributes.Mempool || f.ExcludeConfirmed && !attributes.Mempool {
+		return false
+	}
+	if len(f.Addresses) > 0 && !containsFold(f.Addresses, attributes.Address, false) {
+		return false
+	}
+	if f.MinAmount > 0 && attributes.Amount < f.MinAmount {
+		return false
+	}
+	if len(f.ClaimTypes) > 0 && !containsFold(f.ClaimTypes, attributes.ClaimType, true) {
+		return false
+	}
+	if len(f.ChannelIDs) > 0 && !containsFold(f.ChannelIDs, attributes.ChannelID, false) {
+		return false
+	}
+	if len(f.Tags) > 0 {
+		for _, tag := range attributes.Tags {
+			if containsFold(f.Tags, tag, true) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsFold(list []string, value string, fold bool) bool {
+	if value == "" {
+		return false
+	}
+	for _, item := range list {
+		if item == value || fold && strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
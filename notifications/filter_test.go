@@ -0,0 +1,39 @@
+package notifications
+
+import (
+	"testing"
+)
+
+func TestFilterMatches(t *testing.T) {
+	payment := Attributes{Address: "bAddress", Amount: 5}
+	mempoolPayment := Attributes{Mempool: true, Address: "bAddress", Amount: 5}
+	claim := Attributes{Amount: 1, ClaimType: "stream", ChannelID: "channel", Tags: []string{"Music", "art"}}
+
+	tests := []struct {
+		name       string
+		filter     Filter
+		attributes Attributes
+		matches    bool
+	}{
+		{"empty filter", Filter{}, payment, true},
+		{"allowed address", Filter{Addresses: []string{"bOther", "bAddress"}}, payment, true},
+		{"other address", Filter{Addresses: []string{"bOther"}}, payment, false},
+		{"amount reached", Filter{MinAmount: 5}, payment, true},
+		{"amount too small", Filter{MinAmount: 5.1}, payment, false},
+		{"mempool excluded", Filter{ExcludeMempool: true}, mempoolPayment, false},
+		{"confirmed excluded", Filter{ExcludeConfirmed: true}, payment, false},
+		{"mempool only", Filter{ExcludeConfirmed: true}, mempoolPayment, true},
+		{"claim type", Filter{ClaimTypes: []string{"Stream"}}, claim, true},
+		{"other claim type", Filter{ClaimTypes: []string{"channel"}}, claim, false},
+		{"channel", Filter{ChannelIDs: []string{"channel"}}, claim, true},
+		{"other channel", Filter{ChannelIDs: []string{"other"}}, claim, false},
+		{"tag", Filter{Tags: []string{"music"}}, claim, true},
+		{"other tag", Filter{Tags: []string{"news"}}, claim, false},
+		{"claim filter on payment", Filter{ClaimTypes: []string{"stream"}}, payment, false},
+	}
+	for _, test := range tests {
+		if test.filter.Matches(test.attributes) != test.matches {
+			t.Errorf("%s: expected match to be %t", test.name, test.matches)
+		}
+	}
+}
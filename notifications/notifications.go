@@ -3,43 +3,69 @@ package notifications
 import (
 	"net/http"
 	"net/url"
+	"slices"
 	"sync"
 	"time"
 
-	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
 
 	"github.com/lbryio/lbry.go/v2/extras/errors"
 
-	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type subscriber struct {
+	SubscriberOptions
 	URL    string
 	Type   string
 	Params url.Values
+	// Settings are the params the subscriber was set with, its settings included.
+	Settings map[string]interface{}
+	// Stored tells the subscriber was added through the API rather than the config file.
+	Stored bool
 }
 
+// SubscriberOptions are the settings of a subscriber besides its url and static params.
+type SubscriberOptions struct {
+	// Format is the payload format, FormatForm if empty.
+	Format string
+	// Secret signs the payloads if not empty.
+	Secret string
+	// Filter selects the events the subscriber gets.
+	Filter Filter
+	// Confirmations are the depths at which the event is sent again, with the EventConfirmed status.
+	Confirmations []uint
+	// Reverted sends events removed by a reorg again, with the EventReverted status.
+	Reverted bool
+}
+
+// subscriptions are the subscribers of each type, those configured and those stored merged.
 var subscriptions map[string][]subscriber
+var configured, stored []subscriber
 var subscriptionsMu sync.RWMutex
-var notificationWorkerOnce sync.Once
-var notificationQueue chan notificationJob
 var notificationClient *http.Client
 var notificationClientTimeout time.Duration
 var notificationClientMu sync.Mutex
 var Timeout = 20 * time.Second
 
-type notificationJob struct {
-	Type   string
-	Values url.Values
+// AddSubscriber adds a subscriber to the subscribers list for a type
+func AddSubscriber(address, subType string, options SubscriberOptions, params map[string]interface{}) {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	configured = append(configured, newSubscriber(address, subType, options, params))
+	mergeSubscriptions()
 }
 
-// AddSubscriber adds a subscriber to the subscribers list for a type
-func AddSubscriber(address, subType string, params map[string]interface{}) {
+// ClearSubscribers clears the list of subscribers set by the config file, the stored subscribers are kept.
+func ClearSubscribers() {
 	subscriptionsMu.Lock()
 	defer subscriptionsMu.Unlock()
-	if subscriptions == nil {
-		subscriptions = make(map[string][]subscriber)
-	}
+	configured = nil
+	mergeSubscriptions()
+}
+
+func newSubscriber(address, subType string, options SubscriberOptions, params map[string]interface{}) subscriber {
 	urlParams := url.Values{}
 	for param, v := range params {
 		value, ok := v.(string)
@@ -47,73 +73,93 @@ func AddSubscriber(address, subType string, params map[string]interface{}) {
 			urlParams.Set(param, value)
 		}
 	}
-	subscriptions[subType] = append(subscriptions[subType], subscriber{URL: address, Type: subType, Params: urlParams})
-}
-
-// ClearSubscribers clears the list of subscribers
-func ClearSubscribers() {
-	subscriptionsMu.Lock()
-	defer subscriptionsMu.Unlock()
-	subscriptions = make(map[string][]subscriber)
-}
-
-// Notify notifies the list of subscribers for a type
-func Notify(t string, values url.Values) {
-	notificationWorkerOnce.Do(startNotificationWorkers)
-	job := notificationJob{Type: t, Values: copyValues(values)}
-	select {
-	case notificationQueue <- job:
-	default:
-		logrus.Warn("notification queue full; dropping notification")
+	if options.Format == "" {
+		options.Format = FormatForm
 	}
+	return subscriber{SubscriberOptions: options, URL: address, Type: subType, Params: urlParams, Settings: params}
 }
 
-func startNotificationWorkers() {
-	notificationQueue = make(chan notificationJob, 1000)
-	for worker := 0; worker < 20; worker++ {
-		go notificationWorker()
+// mergeSubscriptions merges the configured and stored subscribers into the subscriptions. A subscriber of the config
+// file takes precedence over a stored one of the same url and type.
+func mergeSubscriptions() {
+	subscriptions = make(map[string][]subscriber)
+	for _, s := range configured {
+		subscriptions[s.Type] = append(subscriptions[s.Type], s)
 	}
-}
-
-func notificationWorker() {
-	for job := range notificationQueue {
-		processNotification(job)
+	for _, s := range stored {
+		if !slices.ContainsFunc(subscriptions[s.Type], func(c subscriber) bool { return c.URL == s.URL }) {
+			subscriptions[s.Type] = append(subscriptions[s.Type], s)
+		}
 	}
 }
 
-func processNotification(job notificationJob) {
+// Notify queues a notification of a type for each subscriber of the type whose filter matches the attributes of the
+// event. The notifications are stored in the outbox along with the transaction they are about, so they are only
+// delivered once its block is processed and are removed with it if the block is rolled back. The block hash is that of
+// the transaction, it is part of the event id. An error means the notification could not be queued.
+func Notify(t, txHash, blockHash string, attributes Attributes, values url.Values) error {
 	subscriptionsMu.RLock()
-	subs := append([]subscriber(nil), subscriptions[job.Type]...)
+	subs := append([]subscriber(nil), subscriptions[t]...)
 	subscriptionsMu.RUnlock()
+	id := eventID(t, blockHash, values)
 	for _, s := range subs {
-		values := copyValues(job.Values)
+		if !s.Filter.Matches(attributes) {
+			continue
+		}
+		subValues := copyValues(values)
 		for param, value := range s.Params {
-			values.Set(param, value[0])
+			subValues.Set(param, value[0])
+		}
+		// The notification of the event itself is at depth 0, followed by one per confirmation depth for the events of
+		// a mined transaction. The event of a transaction seen in the mempool is notified again once it is mined, its
+		// confirmations are only queued then.
+		depths := []uint{0}
+		if txHash != "" && !attributes.Mempool {
+			depths = append(depths, s.Confirmations...)
+		}
+		for _, depth := range depths {
+			notification := &model.NotificationOutbox{
+				EventID:         stageEventID(id, depth, false),
+				Subscriber:      s.URL,
+				Type:            t,
+				Payload:         subValues.Encode(),
+				TransactionHash: null.NewString(txHash, txHash != ""),
+				Depth:           depth,
+				Status:          OutboxPending,
+			}
+			err := notification.InsertG(boil.Infer())
+			if err != nil {
+				return errors.Prefix("Notify", errors.Err(err))
+			}
 		}
-		s.notify(values)
-		metrics.Notifications.WithLabelValues(job.Type).Inc()
 	}
+	return nil
 }
 
-func (s subscriber) notify(values url.Values) {
-	res, err := notificationHTTPClient().PostForm(s.URL, values)
-	if err != nil {
-		logrus.Error(errors.Prefix("Notify", errors.Err(err)))
-		return
+// anySubscriber tells if a subscriber of any type meets a condition.
+func anySubscriber(condition func(s subscriber) bool) bool {
+	subscriptionsMu.RLock()
+	defer subscriptionsMu.RUnlock()
+	for _, subs := range subscriptions {
+		for _, s := range subs {
+			if condition(s) {
+				return true
+			}
+		}
 	}
-	defer func() {
-		err = res.Body.Close()
-		if err != nil {
-			logrus.Error(errors.Prefix("Notify", errors.Err(err)))
+	return false
+}
+
+// subscriberOf returns the subscriber of a type with a url, if there is one.
+func subscriberOf(t, address string) (subscriber, bool) {
+	subscriptionsMu.RLock()
+	defer subscriptionsMu.RUnlock()
+	for _, s := range subscriptions[t] {
+		if s.URL == address {
+			return s, true
 		}
-	}()
-	//b, err := io.ReadAll(res.Body)
-	//if err != nil {
-	//	logrus.Error(errors.Prefix("Notify", errors.Err(err)))
-	//}
-	//if res.StatusCode != http.StatusOK {
-	//	logrus.Errorln(string(b))
-	//}
+	}
+	return subscriber{}, false
 }
 
 func notificationHTTPClient() *http.Client {
@@ -0,0 +1,291 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Statuses of a notification in the outbox.
+const (
+	OutboxPending   = "pending"
+	OutboxDelivered = "delivered"
+	OutboxDead      = "dead"
+)
+
+// MaxAttempts is the number of failed deliveries after which a notification is moved to the dead letters.
+var MaxAttempts = 10
+
+const outboxRetryDelay = 10 * time.Second
+const outboxMaxRetryDelay = time.Hour
+
+// outboxDeliveriesPerRun limits the notifications delivered to a subscriber per run so a backlog doesn't hold the
+// delivery of the other subscribers.
+const outboxDeliveriesPerRun = 500
+
+// outboxRetentionDays is how long delivered notifications are kept for inspection.
+const outboxRetentionDays = 7
+
+// blockProcessingComplete and mempoolBlockHash are those of the processing package, which depends on this one.
+const blockProcessingComplete = "complete"
+const mempoolBlockHash = "MEMPOOL"
+
+var deliveryRunning atomic.Bool
+
+// DeliverOutbox delivers the pending notifications of the outbox. Each subscriber gets its notifications in the order
+// they were queued: a failed delivery is retried with exponential backoff and holds back the later notifications of the
+// subscriber until it succeeds or is moved to the dead letters after MaxAttempts. Notifications waiting for a
+// confirmation depth are ordered apart, per depth, so they don't hold back the others.
+func DeliverOutbox() {
+	if !deliveryRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer deliveryRunning.Store(false)
+
+	var lanes []struct {
+		Subscriber string `boil:"subscriber"`
+		Depth      uint   `boil:"depth"`
+	}
+	err := queries.Raw(`SELECT DISTINCT subscriber, depth FROM notification_outbox WHERE status = ?`, OutboxPending).
+		BindG(context.Background(), &lanes)
+	if err != nil {
+		logrus.Error(errors.Prefix("Notification Outbox", errors.Err(err)))
+		return
+	}
+	head, err := headHeight()
+	if err != nil {
+		logrus.Error(errors.Prefix("Notification Outbox", err))
+		return
+	}
+	var wg sync.WaitGroup
+	for _, lane := range lanes {
+		wg.Add(1)
+		go func(subscriber string, depth uint) {
+			defer wg.Done()
+			err := deliverToSubscriber(subscriber, depth, head)
+			if err != nil {
+				logrus.Error(errors.Prefix("Notification Outbox("+subscriber+")", err))
+			}
+		}(lane.Subscriber, lane.Depth)
+	}
+	wg.Wait()
+
+	err = updateBacklogMetric()
+	if err != nil {
+		logrus.Error(errors.Prefix("Notification Outbox: could not count the backlog", err))
+	}
+	_, err = boil.GetDB().Exec(`
+		DELETE FROM notification_outbox
+		WHERE status = ? AND delivered_at < DATE_SUB(NOW(), INTERVAL ? DAY)
+		LIMIT 10000`, OutboxDelivered, outboxRetentionDays)
+	if err != nil {
+		logrus.Error(errors.Prefix("Notification Outbox: could not remove delivered notifications", errors.Err(err)))
+	}
+}
+
+func deliverToSubscriber(subscriber string, depth uint, head null.Uint64) error {
+	for i := 0; i < outboxDeliveriesPerRun; i++ {
+		notification, err := nextNotification(subscriber, depth, head)
+		if err != nil {
+			return err
+		}
+		if notification == nil {
+			return nil
+		}
+		label := subscriberLabel(subscriber)
+		s, ok := subscriberOf(notification.Type, subscriber)
+		if !ok {
+			// The notifications of a subscriber removed are not sent, they are kept as dead letters to replay if it is
+			// added again. Until the stored subscribers are loaded, a subscriber missing may just not be loaded yet.
+			if !storedSubscribersLoaded.Load() {
+				return nil
+			}
+			err = deadLetter(&notification.NotificationOutbox, "the subscriber is no longer configured")
+			if err != nil {
+				return err
+			}
+			metrics.NotificationDeliveries.WithLabelValues(label, "dead").Inc()
+			continue
+		}
+		start := time.Now()
+		err = postNotification(s, notification)
+		metrics.NotificationDeliveryLatency.WithLabelValues(label).Observe(time.Since(start).Seconds())
+		if err != nil {
+			dead, err := recordFailedDelivery(&notification.NotificationOutbox, err)
+			if dead {
+				metrics.NotificationDeliveries.WithLabelValues(label, "dead").Inc()
+			} else {
+				metrics.NotificationDeliveries.WithLabelValues(label, "failure").Inc()
+			}
+			if err != nil || !dead {
+				return err
+			}
+			continue
+		}
+		_, err = boil.GetDB().Exec(`
+			UPDATE notification_outbox
+			SET status = ?, attempts = attempts + 1, last_error = NULL, delivered_at = NOW()
+			WHERE id = ?`, OutboxDelivered, notification.ID)
+		if err != nil {
+			return errors.Err(err)
+		}
+		metrics.Notifications.WithLabelValues(notification.Type).Inc()
+		metrics.NotificationDeliveries.WithLabelValues(label, "success").Inc()
+	}
+	return nil
+}
+
+// headHeight is the height of the last block processed, null before any is. The first blocks from the top are the
+// only ones that can still be processing.
+func headHeight() (null.Uint64, error) {
+	var head struct {
+		Height null.Uint64 `boil:"height"`
+	}
+	err := queries.Raw(`
+		SELECT height FROM block WHERE processing_state IS NULL OR processing_state = ? ORDER BY height DESC LIMIT 1`,
+		blockProcessingComplete).BindG(context.Background(), &head)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return head.Height, errors.Err(err)
+	}
+	return head.Height, nil
+}
+
+// nextNotification returns the oldest pending notification of a subscriber at a confirmation depth if it is due. It is
+// not due while waiting for a retry, while the block of its transaction is still being processed or until the block
+// has as many confirmations as the depth at the head height of the delivery run. A confirmation of a transaction back
+// in the mempool is skipped rather than holding up the lane, it is due again once the transaction is mined.
+func nextNotification(subscriber string, depth uint, head null.Uint64) (*outboxNotification, error) {
+	notification := &outboxNotification{}
+	err := queries.Raw(`
+		SELECT n.*, IF(b.hash = ?, NULL, b.hash) AS block_hash, IF(b.hash = ?, NULL, b.height) AS block_height,
+			? AS head_height
+		FROM notification_outbox n
+		LEFT JOIN transaction t ON t.hash = n.transaction_hash
+		LEFT JOIN block b ON b.hash = t.block_hash_id
+		WHERE n.id = (
+				SELECT MIN(o.id)
+				FROM notification_outbox o
+				LEFT JOIN transaction ot ON ot.hash = o.transaction_hash
+				WHERE o.status = ? AND o.subscriber = ? AND o.depth = ? AND (o.depth = 0 OR ot.block_hash_id <> ?))
+			AND n.next_attempt_at <= NOW()
+			AND (n.transaction_hash IS NULL OR b.processing_state IS NULL OR b.processing_state = ?)
+			AND (n.depth = 0 OR (b.hash <> ? AND b.height + n.depth <= ? + 1))`,
+		mempoolBlockHash, mempoolBlockHash, head, OutboxPending, subscriber, depth, mempoolBlockHash,
+		blockProcessingComplete, mempoolBlockHash, head).BindG(context.Background(), notification)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Err(err)
+	}
+	return notification, nil
+}
+
+// RevertEventsOfBlock queues the reverted notification of each event of a block removed by a reorg, for the subscribers
+// opted into reverted events that may have received it. It has to run before the block is deleted, which deletes the
+// notifications of the block still queued.
+func RevertEventsOfBlock(blockHash string) error {
+	if !anySubscriber(func(s subscriber) bool { return s.Reverted }) {
+		return nil
+	}
+	var sent []*outboxNotification
+	err := queries.Raw(`
+		SELECT n.*, b.hash AS block_hash, b.height AS block_height, NULL AS head_height
+		FROM notification_outbox n
+		INNER JOIN transaction t ON t.hash = n.transaction_hash
+		INNER JOIN block b ON b.hash = t.block_hash_id
+		WHERE b.hash = ? AND n.depth = 0 AND n.reverted = 0 AND (n.status = ? OR n.attempts > 0)
+		ORDER BY n.id`, blockHash, OutboxDelivered).BindG(context.Background(), &sent)
+	if err != nil {
+		return errors.Prefix("Notification Outbox: could not get the notifications of block "+blockHash, errors.Err(err))
+	}
+	for _, notification := range sent {
+		if s, ok := subscriberOf(notification.Type, notification.Subscriber); !ok || !s.Reverted {
+			continue
+		}
+		reverted := &model.NotificationOutbox{
+			EventID:           stageEventID(notification.EventID, 0, true),
+			Subscriber:        notification.Subscriber,
+			Type:              notification.Type,
+			Payload:           notification.Payload,
+			Reverted:          true,
+			RevertedBlockHash: notification.BlockHash,
+			RevertedHeight:    null.NewUint(uint(notification.BlockHeight.Uint64), notification.BlockHeight.Valid),
+			Status:            OutboxPending,
+		}
+		err := reverted.InsertG(boil.Infer())
+		if err != nil {
+			return errors.Prefix("Notification Outbox: could not queue reverted notification", errors.Err(err))
+		}
+	}
+	return nil
+}
+
+// recordFailedDelivery schedules the retry of a notification, or moves it to the dead letters once it failed
+// MaxAttempts times.
+func recordFailedDelivery(notification *model.NotificationOutbox, cause error) (dead bool, err error) {
+	attempts := notification.Attempts + 1
+	status := OutboxPending
+	if int(attempts) >= MaxAttempts {
+		status = OutboxDead
+		logrus.Warningf("Notification Outbox: moving notification %d to the dead letters after %d attempts: %s",
+			notification.ID, attempts, cause.Error())
+	}
+	_, err = boil.GetDB().Exec(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = ?, last_error = ?, next_attempt_at = DATE_ADD(NOW(), INTERVAL ? SECOND)
+		WHERE id = ?`, status, attempts, cause.Error(), int(retryDelay(attempts).Seconds()), notification.ID)
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return status == OutboxDead, nil
+}
+
+// deadLetter moves a notification to the dead letters without delivering it.
+func deadLetter(notification *model.NotificationOutbox, reason string) error {
+	logrus.Warningf("Notification Outbox: moving notification %d to the dead letters: %s", notification.ID, reason)
+	_, err := boil.GetDB().Exec(`UPDATE notification_outbox SET status = ?, last_error = ? WHERE id = ?`,
+		OutboxDead, reason, notification.ID)
+	return errors.Err(err)
+}
+
+// retryDelay is the delay before the next delivery of a notification that failed a number of times. It doubles with
+// every failure.
+func retryDelay(attempts uint) time.Duration {
+	delay := outboxRetryDelay
+	for i := uint(1); i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
+
+// ReplayDeadLetters moves dead letters back to the outbox to be delivered again, ahead of the notifications queued
+// after them. The dead letter with the id is replayed, or all those of the subscriber if no id is passed. It returns the
+// number of notifications replayed.
+func ReplayDeadLetters(id uint64, subscriber string) (int64, error) {
+	where, arg := "id = ?", interface{}(id)
+	if id == 0 {
+		where, arg = "subscriber = ?", subscriber
+	}
+	result, err := boil.GetDB().Exec(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = 0, last_error = NULL, next_attempt_at = NOW()
+		WHERE status = ? AND `+where, OutboxPending, OutboxDead, arg)
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	replayed, err := result.RowsAffected()
+	return replayed, errors.Err(err)
+}
@@ -0,0 +1,135 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sort"
+
+	"github.com/lbryio/chainquery/metrics"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// SubscriberStatus is the delivery status of a subscriber. The last success is only known while the delivered
+// notifications are kept in the outbox. The last error is that of the last failed delivery, cleared once the
+// notification is delivered.
+type SubscriberStatus struct {
+	Subscriber    string      `boil:"subscriber" json:"subscriber"`
+	Types         []string    `boil:"-" json:"types"`
+	Configured    bool        `boil:"-" json:"configured"`
+	Backlog       uint64      `boil:"backlog" json:"backlog"`
+	DeadLetters   uint64      `boil:"dead_letters" json:"dead_letters"`
+	OldestPending null.Time   `boil:"oldest_pending" json:"oldest_pending"`
+	LastSuccess   null.Time   `boil:"last_success" json:"last_success"`
+	LastError     null.String `boil:"-" json:"last_error"`
+	LastErrorAt   null.Time   `boil:"-" json:"last_error_at"`
+}
+
+// Status returns the delivery status of the subscribers configured or with notifications in the outbox.
+func Status() ([]*SubscriberStatus, error) {
+	var statuses []*SubscriberStatus
+	err := queries.Raw(`
+		SELECT subscriber,
+			CAST(SUM(status = ?) AS UNSIGNED) AS backlog,
+			CAST(SUM(status = ?) AS UNSIGNED) AS dead_letters,
+			MIN(IF(status = ?, created_at, NULL)) AS oldest_pending,
+			MAX(delivered_at) AS last_success
+		FROM notification_outbox
+		GROUP BY subscriber`, OutboxPending, OutboxDead, OutboxPending).BindG(context.Background(), &statuses)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	var lastErrors []struct {
+		Subscriber  string    `boil:"subscriber"`
+		LastError   string    `boil:"last_error"`
+		LastErrorAt null.Time `boil:"last_error_at"`
+	}
+	err = queries.Raw(`
+		SELECT subscriber, last_error, last_error_at
+		FROM (
+			SELECT subscriber, last_error, modified_at AS last_error_at,
+				ROW_NUMBER() OVER (PARTITION BY subscriber ORDER BY modified_at DESC, id DESC) AS n
+			FROM notification_outbox
+			WHERE last_error IS NOT NULL
+		) e
+		WHERE n = 1`).BindG(context.Background(), &lastErrors)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+
+	bySubscriber := make(map[string]*SubscriberStatus, len(statuses))
+	for _, status := range statuses {
+		bySubscriber[status.Subscriber] = status
+	}
+	for _, lastError := range lastErrors {
+		if status := bySubscriber[lastError.Subscriber]; status != nil {
+			status.LastError = null.StringFrom(lastError.LastError)
+			status.LastErrorAt = lastError.LastErrorAt
+		}
+	}
+	subscriptionsMu.RLock()
+	for t, subs := range subscriptions {
+		for _, s := range subs {
+			status := bySubscriber[s.URL]
+			if status == nil {
+				status = &SubscriberStatus{Subscriber: s.URL}
+				bySubscriber[s.URL] = status
+				statuses = append(statuses, status)
+			}
+			status.Configured = true
+			status.Types = append(status.Types, t)
+		}
+	}
+	subscriptionsMu.RUnlock()
+	for _, status := range statuses {
+		sort.Strings(status.Types)
+		if status.Types == nil {
+			status.Types = []string{}
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Subscriber < statuses[j].Subscriber })
+	return statuses, nil
+}
+
+// updateBacklogMetric sets the backlog gauge of each subscriber with pending notifications.
+func updateBacklogMetric() error {
+	var backlogs []struct {
+		Subscriber string `boil:"subscriber"`
+		Backlog    uint64 `boil:"backlog"`
+	}
+	err := queries.Raw(`
+		SELECT subscriber, COUNT(*) AS backlog FROM notification_outbox WHERE status = ? GROUP BY subscriber`,
+		OutboxPending).BindG(context.Background(), &backlogs)
+	if err != nil {
+		return errors.Err(err)
+	}
+	metrics.NotificationBacklog.Reset()
+	for _, backlog := range backlogs {
+		metrics.NotificationBacklog.WithLabelValues(subscriberLabel(backlog.Subscriber)).Set(float64(backlog.Backlog))
+	}
+	return nil
+}
+
+// subscriberLabel labels the metrics of a subscriber: its url without the credentials and query it may carry, followed by
+// a hash of the whole url so subscribers differing only by those get their own label.
+func subscriberLabel(address string) string {
+	hash := sha256.Sum256([]byte(address))
+	return redactURL(address) + "#" + hex.EncodeToString(hash[:4])
+}
+
+// redactURL strips a url of the credentials, query and fragment it may carry.
+func redactURL(address string) string {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "invalid"
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
@@ -0,0 +1,279 @@
+package notifications
+
+import (
+	"database/sql"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sort"
+	"sync/atomic"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Subscriber is a subscriber as set in the config file or through the API: the url the events of a type are posted to
+// and its params, its settings among them.
+type Subscriber struct {
+	URL    string                 `json:"url"`
+	Type   string                 `json:"type"`
+	Params map[string]interface{} `json:"params"`
+	Stored bool                   `json:"stored"`
+}
+
+// ErrConfiguredSubscriber is the error removing a subscriber of the config file through the API.
+var ErrConfiguredSubscriber = errors.Base("the subscriber is set in the config file")
+
+// storedSubscribersLoaded tells the stored subscribers were loaded, so a subscriber missing is one removed.
+var storedSubscribersLoaded atomic.Bool
+
+// SetSubscribers replaces the subscribers of the config file. If one of them is invalid, the subscribers in place are
+// kept. The stored subscribers are not affected.
+func SetSubscribers(subs []Subscriber) error {
+	parsed := make([]subscriber, 0, len(subs))
+	for _, s := range subs {
+		p, err := parseSubscriber(s)
+		if err != nil {
+			return err
+		}
+		// Notifications are delivered to the subscriber of their type and url, which has to be the only one.
+		if slices.ContainsFunc(parsed, func(o subscriber) bool { return o.URL == p.URL && o.Type == p.Type }) {
+			return errors.Err("subscriber %s of %s is declared twice", p.URL, p.Type)
+		}
+		parsed = append(parsed, p)
+	}
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	configured = parsed
+	mergeSubscriptions()
+	return nil
+}
+
+// Subscribers returns the subscribers in use ordered by url and type, with their secrets hidden: the secret, the static
+// params and the credentials and query of the url.
+func Subscribers() []Subscriber {
+	subscriptionsMu.RLock()
+	var subs []Subscriber
+	for _, typeSubs := range subscriptions {
+		for _, s := range typeSubs {
+			subs = append(subs, Subscriber{URL: redactURL(s.URL), Type: s.Type, Params: hideParams(s.Settings), Stored: s.Stored})
+		}
+	}
+	subscriptionsMu.RUnlock()
+	sort.Slice(subs, func(i, j int) bool {
+		if subs[i].URL != subs[j].URL {
+			return subs[i].URL < subs[j].URL
+		}
+		return subs[i].Type < subs[j].Type
+	})
+	return subs
+}
+
+// hideParams returns the params of a subscriber with the values of its secret and of its static params, like an
+// auth_token, hidden.
+func hideParams(settings map[string]interface{}) map[string]interface{} {
+	params := maps.Clone(settings)
+	static := maps.Clone(settings)
+	// The settings were parsed when the subscriber was set. A setting left in static on an error is hidden as well.
+	_, _ = ParseSubscriberOptions(static)
+	static["secret"] = nil
+	for key := range static {
+		if _, ok := params[key]; ok {
+			params[key] = "********"
+		}
+	}
+	return params
+}
+
+// LoadStoredSubscribers loads the subscribers stored through the API, replacing those loaded before. A stored subscriber
+// that is no longer valid is skipped.
+func LoadStoredSubscribers() error {
+	rows, err := model.NotificationSubscribers(qm.OrderBy(model.NotificationSubscriberColumns.ID)).AllG()
+	if err != nil {
+		return errors.Err(err)
+	}
+	parsed := make([]subscriber, 0, len(rows))
+	for _, row := range rows {
+		s := Subscriber{URL: row.URL, Type: row.Type, Stored: true}
+		err := json.Unmarshal([]byte(row.Params), &s.Params)
+		if err != nil {
+			logrus.Warning(errors.Prefix("stored subscriber "+row.URL, errors.Err(err)))
+			continue
+		}
+		p, err := parseSubscriber(s)
+		if err != nil {
+			logrus.Warning(errors.Prefix("stored subscriber", err))
+			continue
+		}
+		parsed = append(parsed, p)
+	}
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	stored = parsed
+	mergeSubscriptions()
+	storedSubscribersLoaded.Store(true)
+	return nil
+}
+
+// StoreSubscriber validates a subscriber and stores it, replacing the stored subscriber of the same url and type. A
+// subscriber of the config file can't be stored, it would be shadowed by the config file.
+func StoreSubscriber(s Subscriber) error {
+	_, err := parseSubscriber(s)
+	if err != nil {
+		return err
+	}
+	subscriptionsMu.RLock()
+	inConfig := slices.ContainsFunc(configured, func(c subscriber) bool { return c.URL == s.URL && c.Type == s.Type })
+	subscriptionsMu.RUnlock()
+	if inConfig {
+		return errors.Err("subscriber %s of %s is set in the config file", s.URL, s.Type)
+	}
+	params := maps.Clone(s.Params)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	delete(params, "url")
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return errors.Err(err)
+	}
+
+	//using UpsertG fails because sqlboiler doesn't consider multi column unique keys as valid. hence the manual "upsert" logic here.
+	row, err := model.NotificationSubscribers(
+		model.NotificationSubscriberWhere.URL.EQ(s.URL),
+		model.NotificationSubscriberWhere.Type.EQ(s.Type)).OneG()
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		row = &model.NotificationSubscriber{URL: s.URL, Type: s.Type, Params: string(encoded)}
+		err = row.InsertG(boil.Infer())
+	case err == nil:
+		row.Params = string(encoded)
+		err = row.UpdateG(boil.Whitelist(model.NotificationSubscriberColumns.Params))
+	}
+	if err != nil {
+		return errors.Err(err)
+	}
+	return LoadStoredSubscribers()
+}
+
+// RemoveStoredSubscriber removes the stored subscribers of a url, of a type or of all types if it is empty. It returns
+// the number of subscribers removed. The subscribers of the config file are kept, removing the one of a type fails with
+// ErrConfiguredSubscriber.
+func RemoveStoredSubscriber(address, subType string) (int64, error) {
+	if subType != "" {
+		subscriptionsMu.RLock()
+		inConfig := slices.ContainsFunc(configured, func(c subscriber) bool { return c.URL == address && c.Type == subType })
+		subscriptionsMu.RUnlock()
+		if inConfig {
+			return 0, errors.Err(ErrConfiguredSubscriber)
+		}
+	}
+	where, args := "url = ?", []interface{}{address}
+	if subType != "" {
+		where, args = where+" AND type = ?", append(args, subType)
+	}
+	result, err := boil.GetDB().Exec(`DELETE FROM notification_subscriber WHERE `+where, args...)
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	return removed, LoadStoredSubscribers()
+}
+
+// minedOnlyTypes are the types of events not sent for transactions in the mempool, a subscriber of them can't exclude
+// the confirmed ones.
+var minedOnlyTypes = []string{newClaim, channelCreated}
+
+// parseSubscriber parses a subscriber, its params are left untouched.
+func parseSubscriber(s Subscriber) (subscriber, error) {
+	if !slices.Contains(EventTypes, s.Type) {
+		return subscriber{}, errors.Err("unknown subscription type %s", s.Type)
+	}
+	if s.URL == "" {
+		return subscriber{}, errors.Err("url is required")
+	}
+	params := maps.Clone(s.Params)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	delete(params, "url")
+	settings := maps.Clone(params)
+	options, err := ParseSubscriberOptions(params)
+	if err == nil && options.Filter.ExcludeConfirmed && slices.Contains(minedOnlyTypes, s.Type) {
+		err = errors.Err("exclude_confirmed: %s events are only sent once mined", s.Type)
+	}
+	if err != nil {
+		return subscriber{}, errors.Prefix("subscriber "+s.URL, err)
+	}
+	p := newSubscriber(s.URL, s.Type, options, params)
+	p.Settings = settings
+	p.Stored = s.Stored
+	return p, nil
+}
+
+// ParseSubscriberOptions takes the settings of a subscriber out of its params, what is left are static params sent
+// along with each event.
+func ParseSubscriberOptions(params map[string]interface{}) (SubscriberOptions, error) {
+	var options SubscriberOptions
+	texts := map[string]*string{"format": &options.Format, "secret": &options.Secret}
+	lists := map[string]*[]string{
+		"addresses":   &options.Filter.Addresses,
+		"claim_types": &options.Filter.ClaimTypes,
+		"channel_ids": &options.Filter.ChannelIDs,
+		"tags":        &options.Filter.Tags,
+	}
+	flags := map[string]*bool{
+		"exclude_mempool":   &options.Filter.ExcludeMempool,
+		"exclude_confirmed": &options.Filter.ExcludeConfirmed,
+		"reverted":          &options.Reverted,
+	}
+	for key, value := range params {
+		var err error
+		switch {
+		case texts[key] != nil:
+			*texts[key], err = cast.ToStringE(value)
+		case lists[key] != nil:
+			*lists[key], err = cast.ToStringSliceE(value)
+		case flags[key] != nil:
+			*flags[key], err = cast.ToBoolE(value)
+		case key == "min_amount":
+			options.Filter.MinAmount, err = cast.ToFloat64E(value)
+		case key == "confirmations":
+			options.Confirmations, err = confirmationDepths(value)
+		default:
+			continue
+		}
+		if err != nil {
+			return options, errors.Prefix(key, err)
+		}
+		delete(params, key)
+	}
+	if options.Format != "" && options.Format != FormatForm && options.Format != FormatJSON {
+		return options, errors.Err("format must be %s or %s", FormatForm, FormatJSON)
+	}
+	return options, nil
+}
+
+// confirmationDepths are the confirmation depths of a subscriber, skipping depths the event is already sent at.
+func confirmationDepths(value interface{}) ([]uint, error) {
+	list, err := cast.ToIntSliceE(value)
+	if err != nil {
+		return nil, err
+	}
+	var depths []uint
+	for _, depth := range list {
+		if depth > 0 && !slices.Contains(depths, uint(depth)) {
+			depths = append(depths, uint(depth))
+		}
+	}
+	return depths, nil
+}
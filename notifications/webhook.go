@@ -0,0 +1,227 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// Payload formats of a subscriber.
+const (
+	// FormatForm posts the event values url encoded, the format subscribers always had.
+	FormatForm = "form"
+	// FormatJSON posts the event as an Event.
+	FormatJSON = "json"
+)
+
+// EventSchemaVersion is the version of the Event envelope, bumped on incompatible changes.
+const EventSchemaVersion = 1
+
+// Statuses of an event.
+const (
+	// EventNew is the status of an event when it happens.
+	EventNew = "new"
+	// EventConfirmed is the status of an event sent again once it reached a confirmation depth of the subscriber.
+	EventConfirmed = "confirmed"
+	// EventReverted is the status of an event sent again because a reorg removed its block.
+	EventReverted = "reverted"
+)
+
+// Headers of a webhook request. The signature is only sent to subscribers with a secret.
+const (
+	EventIDHeader   = "X-Chainquery-Event-Id"
+	TimestampHeader = "X-Chainquery-Timestamp"
+	SignatureHeader = "X-Chainquery-Signature"
+)
+
+// Event is the JSON payload of a notification. The id is the same for every delivery of an event, to every subscriber,
+// and even if the block of the event is processed again, so receivers can use it to dedupe. Follow-ups of an event,
+// once confirmed or reverted, have their own id. Confirmations are counted when the notification is sent, 0 while
+// unconfirmed or once reverted, and the depth is the confirmation depth reached by a confirmed event.
+type Event struct {
+	ID            string            `json:"id"`
+	Type          string            `json:"type"`
+	SchemaVersion int               `json:"schema_version"`
+	Status        string            `json:"status"`
+	Confirmations uint64            `json:"confirmations"`
+	Depth         uint              `json:"depth,omitempty"`
+	Height        null.Uint64       `json:"height"`
+	BlockHash     null.String       `json:"block_hash"`
+	TxID          null.String       `json:"tx_id"`
+	CreatedAt     time.Time         `json:"created_at"`
+	Data          map[string]string `json:"data"`
+}
+
+// outboxNotification is a notification of the outbox with the block of its transaction, if mined, and the height of
+// the chain head.
+type outboxNotification struct {
+	model.NotificationOutbox `boil:",bind"`
+	BlockHash                null.String `boil:"block_hash"`
+	BlockHeight              null.Uint64 `boil:"block_height"`
+	HeadHeight               null.Uint64 `boil:"head_height"`
+}
+
+// status is the event status of the notification.
+func (n *outboxNotification) status() string {
+	switch {
+	case n.Reverted:
+		return EventReverted
+	case n.Depth > 0:
+		return EventConfirmed
+	}
+	return EventNew
+}
+
+// confirmations is the number of blocks on top of and including the block of the notification.
+func (n *outboxNotification) confirmations() uint64 {
+	if n.Reverted || !n.BlockHeight.Valid || !n.HeadHeight.Valid || n.HeadHeight.Uint64 < n.BlockHeight.Uint64 {
+		return 0
+	}
+	return n.HeadHeight.Uint64 - n.BlockHeight.Uint64 + 1
+}
+
+// block is the block of the notification, the one removed for a reverted notification.
+func (n *outboxNotification) block() (null.String, null.Uint64) {
+	if n.Reverted {
+		return n.RevertedBlockHash, null.NewUint64(uint64(n.RevertedHeight.Uint), n.RevertedHeight.Valid)
+	}
+	return n.BlockHash, n.BlockHeight
+}
+
+// newEvent is the Event of an event as it happens, before its block is complete.
+func newEvent(t, txHash, blockHash string, values url.Values) Event {
+	return Event{
+		ID:            eventID(t, blockHash, values),
+		Type:          t,
+		SchemaVersion: EventSchemaVersion,
+		Status:        EventNew,
+		TxID:          null.NewString(txHash, txHash != ""),
+		CreatedAt:     time.Now().UTC(),
+		Data:          eventData(values),
+	}
+}
+
+func eventData(values url.Values) map[string]string {
+	data := make(map[string]string, len(values))
+	for key := range values {
+		data[key] = values.Get(key)
+	}
+	return data
+}
+
+// eventID derives the id of an event from its type, values and the block of its transaction. The event of a
+// transaction seen in the mempool and the events of each block it is mined in have their own ids, so the event of a
+// transaction mined again after a reorg isn't taken for the one reverted.
+func eventID(t, blockHash string, values url.Values) string {
+	key := t + "\n" + values.Encode()
+	if blockHash != "" && blockHash != mempoolBlockHash {
+		key += "\n" + blockHash
+	}
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// stageEventID derives the id of a follow-up of an event, confirmed at a depth or reverted. The event itself, at depth 0
+// and not reverted, keeps its id. Notifications queued before events had ids have none, nor do their follow-ups.
+func stageEventID(id string, depth uint, reverted bool) string {
+	if id == "" || (depth == 0 && !reverted) {
+		return id
+	}
+	hash := sha256.Sum256([]byte(id + ":" + strconv.FormatUint(uint64(depth), 10) + ":" + strconv.FormatBool(reverted)))
+	return hex.EncodeToString(hash[:])
+}
+
+// webhookRequest builds the request delivering a notification to a subscriber at a time. The time is sent in the
+// timestamp header and is part of the signature, so receivers can reject replayed requests.
+func webhookRequest(s subscriber, notification *outboxNotification, now time.Time) (*http.Request, error) {
+	values, err := url.ParseQuery(notification.Payload)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	blockHash, height := notification.block()
+	txID := notification.TransactionHash
+	if notification.Reverted {
+		txID = null.NewString(values.Get("tx_id"), values.Get("tx_id") != "")
+	}
+	var body []byte
+	contentType := "application/x-www-form-urlencoded"
+	if s.Format == FormatJSON {
+		event := Event{
+			ID:            notification.EventID,
+			Type:          notification.Type,
+			SchemaVersion: EventSchemaVersion,
+			Status:        notification.status(),
+			Confirmations: notification.confirmations(),
+			Depth:         notification.Depth,
+			Height:        height,
+			BlockHash:     blockHash,
+			TxID:          txID,
+			CreatedAt:     notification.CreatedAt.UTC(),
+			Data:          eventData(values),
+		}
+		body, err = json.Marshal(event)
+		if err != nil {
+			return nil, errors.Err(err)
+		}
+		contentType = "application/json"
+	} else {
+		values.Set("event_status", notification.status())
+		values.Set("confirmations", strconv.FormatUint(notification.confirmations(), 10))
+		if notification.Depth > 0 {
+			values.Set("depth", strconv.FormatUint(uint64(notification.Depth), 10))
+		}
+		body = []byte(values.Encode())
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", contentType)
+	if notification.EventID != "" {
+		req.Header.Set(EventIDHeader, notification.EventID)
+	}
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	if s.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Signature(s.Secret, timestamp, body))
+	}
+	return req, nil
+}
+
+// Signature is the hex encoded HMAC-SHA256, keyed with the subscriber secret, of the timestamp header, a dot and the
+// request body.
+func Signature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func postNotification(s subscriber, notification *outboxNotification) error {
+	req, err := webhookRequest(s, notification, time.Now())
+	if err != nil {
+		return err
+	}
+	res, err := notificationHTTPClient().Do(req)
+	if err != nil {
+		return errors.Err(err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Err("subscriber responded with status %d", res.StatusCode)
+	}
+	return nil
+}
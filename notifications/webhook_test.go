@@ -0,0 +1,174 @@
+package notifications
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestEventIDIsStable(t *testing.T) {
+	values := url.Values{"tx_id": {"abc"}, "vout": {"1"}}
+	reordered := url.Values{"vout": {"1"}, "tx_id": {"abc"}}
+	if eventID(payment, "block", values) != eventID(payment, "block", reordered) {
+		t.Fatal("expected the same event id for the same values")
+	}
+	if eventID(payment, "block", values) == eventID(newClaim, "block", values) {
+		t.Fatal("expected different event ids for different types")
+	}
+}
+
+func TestEventIDOfEachBlock(t *testing.T) {
+	values := url.Values{"tx_id": {"abc"}, "vout": {"1"}}
+	mempool := eventID(payment, mempoolBlockHash, values)
+	if mempool != eventID(payment, "", values) {
+		t.Fatal("expected the event of a transaction in the mempool to have the id of an event without a block")
+	}
+	mined, remined := eventID(payment, "block", values), eventID(payment, "other block", values)
+	if mined == mempool || mined == remined {
+		t.Fatal("expected the events of each block to have their own ids")
+	}
+}
+
+func TestWebhookRequestJSONIsSigned(t *testing.T) {
+	notification := &outboxNotification{
+		NotificationOutbox: model.NotificationOutbox{
+			EventID:         "event",
+			Type:            payment,
+			Payload:         "address=bXyz&lbc=1.5",
+			TransactionHash: null.StringFrom("abc"),
+			CreatedAt:       time.Unix(1600000000, 0),
+		},
+		BlockHash:   null.StringFrom("blockhash"),
+		BlockHeight: null.Uint64From(42),
+	}
+	s := subscriber{SubscriberOptions: SubscriberOptions{Format: FormatJSON, Secret: "secret"}, URL: "http://localhost/event"}
+	req, err := webhookRequest(s, notification, time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var event Event
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.ID != "event" || event.SchemaVersion != EventSchemaVersion || event.Height.Uint64 != 42 ||
+		event.BlockHash.String != "blockhash" || event.TxID.String != "abc" || event.Data["lbc"] != "1.5" {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	if req.Header.Get(EventIDHeader) != "event" || req.Header.Get(TimestampHeader) != "1700000000" {
+		t.Fatalf("unexpected headers %v", req.Header)
+	}
+	if req.Header.Get(SignatureHeader) != "sha256="+Signature("secret", 1700000000, body) {
+		t.Fatalf("unexpected signature %s", req.Header.Get(SignatureHeader))
+	}
+}
+
+func TestWebhookRequestFormIsUnsignedWithoutSecret(t *testing.T) {
+	notification := &outboxNotification{NotificationOutbox: model.NotificationOutbox{EventID: "event", Payload: "lbc=1.5"}}
+	req, err := webhookRequest(subscriber{SubscriberOptions: SubscriberOptions{Format: FormatForm}, URL: "http://localhost/event"}, notification, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if string(body) != "confirmations=0&event_status=new&lbc=1.5" || req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+		t.Fatalf("unexpected form request %s", string(body))
+	}
+	if req.Header.Get(SignatureHeader) != "" {
+		t.Fatal("expected no signature without a secret")
+	}
+}
+
+func TestStageEventID(t *testing.T) {
+	if stageEventID("event", 0, false) != "event" {
+		t.Fatal("expected the event to keep its id")
+	}
+	ids := map[string]bool{"event": true}
+	for _, id := range []string{stageEventID("event", 1, false), stageEventID("event", 6, false), stageEventID("event", 0, true)} {
+		if ids[id] {
+			t.Fatalf("expected a distinct id for each follow-up, got %s twice", id)
+		}
+		ids[id] = true
+	}
+	if stageEventID("", 6, false) != "" || stageEventID("", 0, true) != "" {
+		t.Fatal("expected the follow-ups of an event without an id to have none")
+	}
+}
+
+func TestWebhookRequestWithoutEventID(t *testing.T) {
+	notification := &outboxNotification{NotificationOutbox: model.NotificationOutbox{Payload: "lbc=1.5"}}
+	req, err := webhookRequest(subscriber{URL: "http://localhost/event"}, notification, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := req.Header[EventIDHeader]; ok {
+		t.Fatal("expected no event id header for a notification without an id")
+	}
+}
+
+func TestWebhookRequestConfirmed(t *testing.T) {
+	notification := &outboxNotification{
+		NotificationOutbox: model.NotificationOutbox{EventID: "confirmed", Payload: "lbc=1.5", Depth: 6},
+		BlockHeight:        null.Uint64From(100),
+		HeadHeight:         null.Uint64From(106),
+	}
+	req, err := webhookRequest(subscriber{URL: "http://localhost/event"}, notification, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(req.Body)
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Get("event_status") != EventConfirmed || values.Get("confirmations") != "7" || values.Get("depth") != "6" {
+		t.Fatalf("unexpected confirmed notification %s", string(body))
+	}
+}
+
+func TestWebhookRequestReverted(t *testing.T) {
+	notification := &outboxNotification{
+		NotificationOutbox: model.NotificationOutbox{
+			EventID:           "reverted",
+			Type:              payment,
+			Payload:           "lbc=1.5&tx_id=abc",
+			Reverted:          true,
+			RevertedBlockHash: null.StringFrom("orphan"),
+			RevertedHeight:    null.UintFrom(100),
+		},
+		HeadHeight: null.Uint64From(106),
+	}
+	s := subscriber{SubscriberOptions: SubscriberOptions{Format: FormatJSON}, URL: "http://localhost/event"}
+	req, err := webhookRequest(s, notification, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event Event
+	err = json.NewDecoder(req.Body).Decode(&event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Status != EventReverted || event.Confirmations != 0 || event.BlockHash.String != "orphan" ||
+		event.Height.Uint64 != 100 || event.TxID.String != "abc" {
+		t.Fatalf("unexpected reverted event %+v", event)
+	}
+}
+
+func TestNewEventMatchesTheQueuedEvent(t *testing.T) {
+	values := url.Values{"claim_id": {"abc"}, "amount": {"1"}}
+	event := newEvent(newSupport, "tx", "block", values)
+	if event.ID != eventID(newSupport, "block", values) || event.Status != EventNew || event.TxID.String != "tx" ||
+		event.Data["claim_id"] != "abc" {
+		t.Fatalf("unexpected event %+v", event)
+	}
+}
@@ -0,0 +1,150 @@
+package publisher
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lbryio/chainquery/datastore"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var getClaimVersionAtHeight = datastore.GetClaimVersionAtHeight
+
+// AddressTransaction is the data of an address_transaction change, what a transaction debits and credits an address.
+type AddressTransaction struct {
+	TransactionHash string  `boil:"transaction_hash" json:"tx_id"`
+	Address         string  `boil:"address" json:"address"`
+	DebitAmount     float64 `boil:"debit_amount" json:"debit_amount"`
+	CreditAmount    float64 `boil:"credit_amount" json:"credit_amount"`
+}
+
+// changesOfBlock loads the changes of a block: the block, the claims it creates or updates, the supports it creates or
+// spends, its purchases and what its transactions debit and credit addresses. Reverted, it is the deletes of the
+// entities the block created, the block first, and the updates of those it changed without creating them: the claims
+// it updated, as they were before it, and the supports it spent, unspent again.
+func changesOfBlock(block *model.Block, reverted bool) ([]Change, error) {
+	txs, err := model.Transactions(
+		qm.Select(model.TransactionColumns.Hash),
+		model.TransactionWhere.BlockHashID.EQ(null.StringFrom(block.Hash))).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	hashes := make([]interface{}, len(txs))
+	inBlock := make(map[string]bool, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash
+		inBlock[tx.Hash] = true
+	}
+
+	op := func(created bool) string {
+		switch {
+		case !created:
+			return OpUpdate
+		case reverted:
+			return OpDelete
+		}
+		return OpInsert
+	}
+	change := func(entity, key string, created bool, data interface{}) Change {
+		return Change{Entity: entity, Op: op(created), Key: key, Height: block.Height, BlockHash: block.Hash, Data: data}
+	}
+	changes := []Change{change(EntityBlock, block.Hash, true, block)}
+	if len(hashes) == 0 {
+		return changes, nil
+	}
+
+	claims, err := model.Claims(
+		qm.WhereIn(model.ClaimColumns.TransactionHashID+" IN ?", hashes...),
+		qm.OrIn(model.ClaimColumns.TransactionHashUpdate+" IN ?", hashes...)).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	for _, claim := range claims {
+		created := inBlock[claim.TransactionHashID.String]
+		if reverted && !created {
+			// The claim keeps the update of the block until the block processed in its place updates it again, it is
+			// published as it was before the block.
+			revertClaimUpdate(claim, block.Height)
+		}
+		changes = append(changes, change(EntityClaim, claim.ClaimID, created, claim))
+	}
+
+	supports, err := model.Supports(
+		qm.WhereIn(model.SupportColumns.TransactionHashID+" IN ?", hashes...),
+		qm.OrIn(model.SupportColumns.SpentTransactionHash+" IN ?", hashes...)).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	for _, support := range supports {
+		created := inBlock[support.TransactionHashID.String]
+		if reverted && !created {
+			// The spend is released once the block is removed, the support is published as it will be.
+			support.SpentTransactionHash = null.String{}
+			support.SpentHeight = null.Uint{}
+		}
+		key := support.TransactionHashID.String + ":" + strconv.Itoa(int(support.Vout))
+		changes = append(changes, change(EntitySupport, key, created, support))
+	}
+
+	purchases, err := model.Purchases(qm.WhereIn(model.PurchaseColumns.TransactionByHashID+" IN ?", hashes...)).AllG()
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	for _, purchase := range purchases {
+		key := purchase.TransactionByHashID.String + ":" + strconv.Itoa(int(purchase.Vout))
+		changes = append(changes, change(EntityPurchase, key, true, purchase))
+	}
+
+	var addressTransactions []*AddressTransaction
+	err = queries.Raw(`
+		SELECT t.hash AS transaction_hash, a.address, ta.debit_amount, ta.credit_amount
+		FROM transaction t
+		INNER JOIN transaction_address ta ON ta.transaction_id = t.id
+		INNER JOIN address a ON a.id = ta.address_id
+		WHERE t.block_hash_id = ?
+		ORDER BY t.id, a.id`, block.Hash).BindG(context.Background(), &addressTransactions)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	for _, addressTransaction := range addressTransactions {
+		key := addressTransaction.TransactionHash + ":" + addressTransaction.Address
+		changes = append(changes, change(EntityAddressTransaction, key, true, addressTransaction))
+	}
+	return changes, nil
+}
+
+// revertClaimUpdate sets a claim back to its version before a height. A claim processed before its versions were kept
+// has none to go back to, it is left as it is.
+func revertClaimUpdate(claim *model.Claim, height uint64) {
+	var version *model.ClaimVersion
+	if height > 1 {
+		version = getClaimVersionAtHeight(claim.ClaimID, uint(height-1))
+	}
+	if version == nil {
+		logrus.Warningf("Change Publisher: no version of claim %s before height %d, it is published as it is", claim.ClaimID,
+			height)
+		return
+	}
+	claim.TransactionHashUpdate = null.NewString(version.TransactionHash, version.IsUpdate)
+	claim.VoutUpdate = null.NewUint(version.Vout, version.IsUpdate)
+	claim.Height = version.Height
+	claim.Name = version.Name
+	claim.ClaimAddress = version.ClaimAddress
+	claim.PublisherID = version.PublisherID
+	claim.Type = version.Type
+	claim.Title = version.Title
+	claim.Description = version.Description
+	claim.ThumbnailURL = version.ThumbnailURL
+	claim.Fee = version.Fee
+	claim.FeeCurrency = version.FeeCurrency
+	claim.FeeAddress = version.FeeAddress
+	claim.ValueAsHex = version.ValueAsHex
+	claim.ValueAsJSON = version.ValueAsJSON
+}
@@ -0,0 +1,36 @@
+package publisher
+
+import (
+	"sync"
+)
+
+// Memory keeps the changes published in memory, for tests and embedding.
+type Memory struct {
+	mu      sync.Mutex
+	changes []Change
+}
+
+// NewMemory returns an empty in-memory publisher.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Publish appends the changes.
+func (m *Memory) Publish(changes []Change) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changes = append(m.changes, changes...)
+	return nil
+}
+
+// Changes returns the changes published so far, oldest first.
+func (m *Memory) Changes() []Change {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Change(nil), m.changes...)
+}
+
+// Close does nothing, the changes are kept.
+func (m *Memory) Close() error {
+	return nil
+}
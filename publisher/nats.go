@@ -0,0 +1,79 @@
+package publisher
+
+import (
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Timeout bounds connecting to the server and the acknowledgement of a publish.
+var Timeout = 20 * time.Second
+
+// NATS publishes each change as JSON to the subject <subject>.<entity>.<op> of a NATS server. A publish waits for the
+// server to acknowledge it and fails if it doesn't, or while the connection is lost: the client reconnects on its own
+// and the outbox publishes the block again.
+type NATS struct {
+	url     *url.URL
+	subject string
+
+	mu   sync.Mutex
+	conn *nats.Conn
+}
+
+// NewNATS returns a publisher to the NATS server of a nats:// url, connecting on the first publish.
+func NewNATS(u *url.URL, subject string) *NATS {
+	if subject == "" {
+		subject = "chainquery"
+	}
+	return &NATS{url: u, subject: subject}
+}
+
+// Publish publishes the changes in order.
+func (n *NATS) Publish(changes []Change) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn == nil || n.conn.IsClosed() {
+		conn, err := nats.Connect(n.url.String(),
+			nats.Name("chainquery"),
+			nats.Timeout(Timeout),
+			nats.MaxReconnects(-1),
+			// Publishing while reconnecting fails rather than buffering, the outbox retries the block.
+			nats.ReconnectBufSize(-1))
+		if err != nil {
+			return errors.Prefix("NATS connect", errors.Err(err))
+		}
+		n.conn = conn
+	}
+	for _, change := range changes {
+		data, err := json.Marshal(change)
+		if err != nil {
+			return errors.Err(err)
+		}
+		err = n.conn.Publish(n.subject+"."+change.Entity+"."+change.Op, data)
+		if err != nil {
+			return errors.Prefix("NATS publish", errors.Err(err))
+		}
+	}
+	// The server answers the ping of a flush once it processed everything published before it.
+	err := n.conn.FlushTimeout(Timeout)
+	if err != nil {
+		return errors.Prefix("NATS publish", errors.Err(err))
+	}
+	return nil
+}
+
+// Close closes the connection to the server.
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		n.conn.Close()
+		n.conn = nil
+	}
+	return nil
+}
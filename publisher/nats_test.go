@@ -0,0 +1,83 @@
+package publisher
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeNATS accepts one connection, acknowledges pings and sends the messages published before a ping by subject.
+func fakeNATS(t *testing.T) (string, <-chan map[string]Change) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	published := make(chan map[string]Change, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_, _ = conn.Write([]byte("INFO {\"server_id\":\"test\",\"max_payload\":1048576,\"proto\":1}\r\n"))
+		reader := bufio.NewReader(conn)
+		messages := make(map[string]Change)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "PUB":
+				size, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, size+2)
+				_, _ = io.ReadFull(reader, payload)
+				var change Change
+				_ = json.Unmarshal(payload[:size], &change)
+				messages[fields[1]] = change
+			case "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+				if len(messages) > 0 {
+					published <- messages
+					messages = make(map[string]Change)
+				}
+			}
+		}
+	}()
+	return listener.Addr().String(), published
+}
+
+func TestNATSPublishesBySubject(t *testing.T) {
+	address, published := fakeNATS(t)
+	n := NewNATS(&url.URL{Scheme: "nats", Host: address}, "cq")
+	defer func() { _ = n.Close() }()
+
+	err := n.Publish([]Change{
+		{Entity: EntityClaim, Op: OpInsert, Key: "claimid", Height: 10},
+		{Entity: EntitySupport, Op: OpDelete, Key: "tx:0", Height: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := <-published
+	if messages["cq.claim.insert"].Key != "claimid" || messages["cq.support.delete"].Key != "tx:0" {
+		t.Fatalf("unexpected messages %+v", messages)
+	}
+}
+
+func TestConfigureRejectsUnknownScheme(t *testing.T) {
+	defer Set(nil)
+	if err := Configure("kafka://localhost:9092", ""); err == nil {
+		t.Fatal("expected an error for an unsupported publisher")
+	}
+	if err := Configure("memory://", ""); err != nil || !Enabled() {
+		t.Fatalf("expected the memory publisher to be enabled, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package publisher
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Statuses of the changes of a block in the outbox.
+const (
+	OutboxPending   = "pending"
+	OutboxPublished = "published"
+)
+
+const outboxRetryDelay = 10 * time.Second
+const outboxMaxRetryDelay = 10 * time.Minute
+
+// outboxPublishesPerRun limits the blocks published per run so the job gives way to a configuration change.
+const outboxPublishesPerRun = 500
+
+// outboxRetentionDays is how long published changes are kept for inspection.
+const outboxRetentionDays = 7
+
+var publishRunning atomic.Bool
+
+// stage writes the changes of a block to the outbox. A block processed again, after its processing failed, replaces
+// the changes of its previous processing not yet published.
+func stage(block *model.Block, reverted bool, changes []Change) error {
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		return errors.Err(err)
+	}
+	if !reverted {
+		_, err = boil.GetDB().Exec(`DELETE FROM change_outbox WHERE block_hash = ? AND reverted = 0 AND status = ?`,
+			block.Hash, OutboxPending)
+		if err != nil {
+			return errors.Err(err)
+		}
+	}
+	staged := &model.ChangeOutbox{
+		BlockHash: block.Hash,
+		Height:    block.Height,
+		Reverted:  reverted,
+		Payload:   string(payload),
+		Status:    OutboxPending,
+	}
+	return errors.Err(staged.InsertG(boil.Infer()))
+}
+
+// PublishOutbox publishes the changes staged in the outbox, block after block in the order they were staged. A failed
+// publish is retried with exponential backoff and holds back the blocks staged after it until it succeeds.
+func PublishOutbox() {
+	if !Enabled() || !publishRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer publishRunning.Store(false)
+
+	for i := 0; i < outboxPublishesPerRun; i++ {
+		published, err := publishNext()
+		if err != nil {
+			logrus.Error(errors.Prefix("Change Publisher", err))
+			break
+		}
+		if !published {
+			break
+		}
+	}
+	_, err := boil.GetDB().Exec(`
+		DELETE FROM change_outbox
+		WHERE status = ? AND published_at < DATE_SUB(NOW(), INTERVAL ? DAY)
+		LIMIT 10000`, OutboxPublished, outboxRetentionDays)
+	if err != nil {
+		logrus.Error(errors.Prefix("Change Publisher: could not remove published changes", errors.Err(err)))
+	}
+}
+
+// publishNext publishes the changes of the oldest block staged if it is due. It tells if there was one to publish.
+func publishNext() (bool, error) {
+	staged := &model.ChangeOutbox{}
+	err := queries.Raw(`
+		SELECT * FROM change_outbox
+		WHERE id = (SELECT MIN(id) FROM change_outbox WHERE status = ?) AND next_attempt_at <= NOW()`,
+		OutboxPending).BindG(context.Background(), staged)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Err(err)
+	}
+	changes, err := stagedChanges(staged)
+	if err == nil {
+		err = publish(changes)
+	}
+	if err != nil {
+		attempts := staged.Attempts + 1
+		_, updateErr := boil.GetDB().Exec(`
+			UPDATE change_outbox
+			SET attempts = ?, last_error = ?, next_attempt_at = DATE_ADD(NOW(), INTERVAL ? SECOND)
+			WHERE id = ?`, attempts, err.Error(), int(retryDelay(attempts).Seconds()), staged.ID)
+		if updateErr != nil {
+			return false, errors.Err(updateErr)
+		}
+		return false, errors.Prefix("block "+staged.BlockHash, err)
+	}
+	_, err = boil.GetDB().Exec(`
+		UPDATE change_outbox SET status = ?, attempts = attempts + 1, last_error = NULL, published_at = NOW()
+		WHERE id = ?`, OutboxPublished, staged.ID)
+	if err != nil {
+		return false, errors.Err(err)
+	}
+	return true, nil
+}
+
+// stagedChanges decodes the changes of a block staged, their data is published as it was staged.
+func stagedChanges(staged *model.ChangeOutbox) ([]Change, error) {
+	var decoded []struct {
+		Change
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal([]byte(staged.Payload), &decoded)
+	if err != nil {
+		return nil, errors.Err(err)
+	}
+	changes := make([]Change, len(decoded))
+	for i, change := range decoded {
+		changes[i] = change.Change
+		changes[i].Data = change.Data
+	}
+	return changes, nil
+}
+
+// retryDelay is the delay before publishing again the changes of a block that failed a number of times. It doubles
+// with every failure.
+func retryDelay(attempts uint) time.Duration {
+	delay := outboxRetryDelay
+	for i := uint(1); i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
@@ -0,0 +1,141 @@
+package publisher
+
+import (
+	"net/url"
+	"sync"
+
+	"github.com/lbryio/chainquery/metrics"
+	"github.com/lbryio/chainquery/model"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+)
+
+// Operations of a change.
+const (
+	OpInsert = "insert"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// Entities changed.
+const (
+	EntityBlock              = "block"
+	EntityClaim              = "claim"
+	EntitySupport            = "support"
+	EntityPurchase           = "purchase"
+	EntityAddressTransaction = "address_transaction"
+)
+
+// Change is a change of an entity by a block. The key identifies the entity within its kind and the data is the entity
+// as stored, or as it was before a delete.
+type Change struct {
+	Entity    string      `json:"entity"`
+	Op        string      `json:"op"`
+	Key       string      `json:"key"`
+	Height    uint64      `json:"height"`
+	BlockHash string      `json:"block_hash"`
+	Data      interface{} `json:"data"`
+}
+
+// Publisher publishes the changes of a block, in order.
+type Publisher interface {
+	Publish(changes []Change) error
+	Close() error
+}
+
+var current Publisher
+var currentURL, currentSubject string
+var currentMu sync.RWMutex
+
+// Configure sets the publisher from its url: nats://[user:password@]host[:port] publishes to NATS under the subject,
+// memory:// keeps the changes in memory and an empty url disables publishing. It does nothing if the url and subject
+// did not change.
+func Configure(rawURL, subject string) error {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	if rawURL == currentURL && subject == currentSubject {
+		return nil
+	}
+	var p Publisher
+	if rawURL != "" {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return errors.Err(err)
+		}
+		switch u.Scheme {
+		case "nats":
+			p = NewNATS(u, subject)
+		case "memory":
+			p = NewMemory()
+		default:
+			return errors.Err("unsupported change publisher %s", u.Scheme)
+		}
+	}
+	set(p)
+	currentURL, currentSubject = rawURL, subject
+	return nil
+}
+
+// Set replaces the publisher, closing the previous one. A nil publisher disables publishing.
+func Set(p Publisher) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	set(p)
+	currentURL, currentSubject = "", ""
+}
+
+func set(p Publisher) {
+	if current != nil {
+		_ = current.Close()
+	}
+	current = p
+}
+
+// Enabled tells if changes are published.
+func Enabled() bool {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	return current != nil
+}
+
+// BlockCommitted stages the changes of a block in the outbox, to be published by PublishOutbox. It has to run before the
+// block is marked complete, so a block is never complete without its changes staged.
+func BlockCommitted(block *model.Block) error {
+	if !Enabled() {
+		return nil
+	}
+	changes, err := changesOfBlock(block, false)
+	if err != nil {
+		return err
+	}
+	return stage(block, false, changes)
+}
+
+// BlockReverted stages the changes of a block removed by a reorg in the outbox, to be published by PublishOutbox. It
+// has to run before the block is deleted.
+func BlockReverted(block *model.Block) error {
+	if !Enabled() {
+		return nil
+	}
+	changes, err := changesOfBlock(block, true)
+	if err != nil {
+		return err
+	}
+	return stage(block, true, changes)
+}
+
+func publish(changes []Change) error {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	if current == nil {
+		return errors.Err("no change publisher")
+	}
+	err := current.Publish(changes)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		metrics.PublishedChanges.WithLabelValues(change.Entity, change.Op).Inc()
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package publisher
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lbryio/chainquery/model"
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var testBlock = &model.Block{ID: 7, Hash: "block", Height: 100}
+
+func mockDB(t *testing.T) sqlmock.Sqlmock {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := boil.GetDB()
+	boil.SetDB(db)
+	t.Cleanup(func() {
+		boil.SetDB(old)
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		_ = db.Close()
+	})
+	return mock
+}
+
+// expectBlockChanges expects the queries of the changes of testBlock: a transaction creating claim1 and support tx:0,
+// updating claim2 created in an earlier block and spending support earlier:1. Reverted, the version of claim2 before
+// the block is looked up: the update earlier-update:1.
+func expectBlockChanges(mock sqlmock.Sqlmock, reverted bool) {
+	mock.ExpectQuery("SELECT .* FROM `transaction`").
+		WithArgs(testBlock.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow("tx"))
+	mock.ExpectQuery("SELECT .* FROM `claim`").
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id", "transaction_hash_id", "transaction_hash_update",
+			"vout_update", "title", "height"}).
+			AddRow("claim1", "tx", "tx", 0, "new", testBlock.Height).
+			AddRow("claim2", "earlier", "tx", 0, "after", testBlock.Height))
+	if reverted {
+		mock.ExpectQuery("SELECT .* FROM `claim_version`").
+			WithArgs("claim2", uint(testBlock.Height-1)).
+			WillReturnRows(sqlmock.NewRows([]string{"claim_id", "transaction_hash", "vout", "height", "is_update",
+				"title"}).
+				AddRow("claim2", "earlier-update", 1, 90, true, "before"))
+	}
+	mock.ExpectQuery("SELECT .* FROM `support`").
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_hash_id", "vout", "spent_transaction_hash", "spent_height"}).
+			AddRow("tx", 0, nil, nil).
+			AddRow("earlier", 1, "tx", 100))
+	mock.ExpectQuery("SELECT .* FROM `purchase`").
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_by_hash_id", "vout"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT t.hash AS transaction_hash")).
+		WithArgs(testBlock.Hash).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_hash", "address", "debit_amount", "credit_amount"}).
+			AddRow("tx", "bAddress", 0, 1.5))
+}
+
+// summary lists the changes as entity op key, with the last outpoint, height and title of the claims and the spent
+// transaction of the supports.
+func summary(changes []Change) []string {
+	var lines []string
+	for _, change := range changes {
+		line := change.Entity + " " + change.Op + " " + change.Key
+		if claim, ok := change.Data.(*model.Claim); ok {
+			line += " update:" + claim.TransactionHashUpdate.String + ":" + strconv.Itoa(int(claim.VoutUpdate.Uint)) +
+				" height:" + strconv.Itoa(int(claim.Height)) + " " + claim.Title.String
+		}
+		if support, ok := change.Data.(*model.Support); ok {
+			line += " spent:" + support.SpentTransactionHash.String
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestChangesOfBlock(t *testing.T) {
+	mock := mockDB(t)
+	expectBlockChanges(mock, false)
+
+	changes, err := changesOfBlock(testBlock, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"block insert block",
+		"claim insert claim1 update:tx:0 height:100 new",
+		"claim update claim2 update:tx:0 height:100 after",
+		"support insert tx:0 spent:",
+		"support update earlier:1 spent:tx",
+		"address_transaction insert tx:bAddress",
+	}
+	if got := summary(changes); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected changes %q, got %q", expected, got)
+	}
+}
+
+func TestChangesOfRevertedBlock(t *testing.T) {
+	mock := mockDB(t)
+	expectBlockChanges(mock, true)
+
+	changes, err := changesOfBlock(testBlock, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"block delete block",
+		"claim delete claim1 update:tx:0 height:100 new",
+		"claim update claim2 update:earlier-update:1 height:90 before",
+		"support delete tx:0 spent:",
+		"support update earlier:1 spent:",
+		"address_transaction delete tx:bAddress",
+	}
+	if got := summary(changes); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected changes %q, got %q", expected, got)
+	}
+}
+
+// payloadCapture matches any argument and keeps the payload of the changes staged.
+type payloadCapture struct {
+	payload *string
+}
+
+func (c payloadCapture) Match(v driver.Value) bool {
+	if s, ok := v.(string); ok && strings.HasPrefix(s, "[") {
+		*c.payload = s
+	}
+	return true
+}
+
+func TestBlockIsPublishedThroughTheOutbox(t *testing.T) {
+	memory := NewMemory()
+	Set(memory)
+	defer Set(nil)
+	mock := mockDB(t)
+
+	expectBlockChanges(mock, false)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM change_outbox WHERE block_hash = ?")).
+		WithArgs(testBlock.Hash, OutboxPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	var payload string
+	capture := payloadCapture{payload: &payload}
+	mock.ExpectExec("INSERT INTO `change_outbox`").
+		WithArgs(capture, capture, capture, capture, capture, capture).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT .* FROM `change_outbox`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "reverted", "attempts", "next_attempt_at", "created_at",
+			"modified_at"}).
+			AddRow(1, false, 0, time.Now(), time.Now(), time.Now()))
+	err := BlockCommitted(testBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(memory.Changes()) != 0 {
+		t.Fatal("expected the changes to be staged, not published")
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM change_outbox")).
+		WithArgs(OutboxPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "block_hash", "height", "payload", "status", "attempts"}).
+			AddRow(1, testBlock.Hash, testBlock.Height, payload, OutboxPending, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE change_outbox SET status = ?")).
+		WithArgs(OutboxPublished, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM change_outbox")).
+		WithArgs(OutboxPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM change_outbox")).
+		WithArgs(OutboxPublished, outboxRetentionDays).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	PublishOutbox()
+
+	changes := memory.Changes()
+	if len(changes) != 6 || changes[0].Entity != EntityBlock || changes[2].Key != "claim2" || changes[2].Op != OpUpdate {
+		t.Fatalf("unexpected changes published %+v", changes)
+	}
+	var claim model.Claim
+	err = json.Unmarshal(changes[2].Data.(json.RawMessage), &claim)
+	if err != nil || claim.TransactionHashID.String != "earlier" {
+		t.Fatalf("expected the data of the claim as staged, got %s", changes[2].Data)
+	}
+}
+
+func TestFailedPublishHoldsBackTheBlock(t *testing.T) {
+	Set(failingPublisher{})
+	defer Set(nil)
+	mock := mockDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM change_outbox")).
+		WithArgs(OutboxPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "block_hash", "payload", "status", "attempts"}).
+			AddRow(1, testBlock.Hash, `[{"entity":"block","op":"insert","key":"block"}]`, OutboxPending, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE change_outbox")).
+		WithArgs(uint(3), "broker is down", int(retryDelay(3).Seconds()), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM change_outbox")).
+		WithArgs(OutboxPublished, outboxRetentionDays).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	PublishOutbox()
+}
+
+var errBrokerDown = errors.Base("broker is down")
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish([]Change) error {
+	return errBrokerDown
+}
+
+func (failingPublisher) Close() error {
+	return nil
+}
+
+func TestRevertedClaimUpdateOfAClaimWithoutVersions(t *testing.T) {
+	mock := mockDB(t)
+	mock.ExpectQuery("SELECT .* FROM `claim_version`").
+		WithArgs("claim", uint(testBlock.Height-1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	claim := &model.Claim{ClaimID: "claim"}
+	claim.TransactionHashUpdate.SetValid("tx")
+	revertClaimUpdate(claim, testBlock.Height)
+	if claim.TransactionHashUpdate.String != "tx" {
+		t.Fatalf("expected the claim to be left as it is, got %+v", claim)
+	}
+}
@@ -27,19 +27,31 @@ var socketyWorkerOnce sync.Once
 var socketyQueue chan socketyapi.SendNotificationArgs
 var Timeout = 20 * time.Second
 
+// Enabled tells if notifications are sent to sockety.
+func Enabled() bool {
+	return Token != "" && URL != ""
+}
+
 // SendNotification sends the notification to socket using client
 func SendNotification(args socketyapi.SendNotificationArgs) {
-	if Token == "" || URL == "" {
+	if !Enabled() {
 		return
 	}
 	socketyWorkerOnce.Do(startSocketyWorkers)
 	select {
 	case socketyQueue <- args:
+		metrics.SocketyQueueDepth.Set(float64(len(socketyQueue)))
 	default:
+		metrics.SocketyDeliveries.WithLabelValues("dropped").Inc()
 		logrus.Warn("sockety notification queue full; dropping notification")
 	}
 }
 
+// QueueDepth is the number of notifications waiting to be sent.
+func QueueDepth() int {
+	return len(socketyQueue)
+}
+
 func startSocketyWorkers() {
 	socketyQueue = make(chan socketyapi.SendNotificationArgs, 1000)
 	for worker := 0; worker < 4; worker++ {
@@ -49,6 +61,7 @@ func startSocketyWorkers() {
 
 func socketyWorker() {
 	for args := range socketyQueue {
+		metrics.SocketyQueueDepth.Set(float64(len(socketyQueue)))
 		sendNotification(args)
 	}
 }
@@ -58,7 +71,10 @@ func sendNotification(args socketyapi.SendNotificationArgs) {
 	client := getSocketyClient()
 	_, err := client.SendNotification(args)
 	if err != nil {
+		metrics.SocketyDeliveries.WithLabelValues("failure").Inc()
 		logrus.Error(errors.Prefix("Socket Send Notification", err))
+	} else {
+		metrics.SocketyDeliveries.WithLabelValues("success").Inc()
 	}
 	metrics.SocketyNotifications.WithLabelValues(args.Type, null.StringFromPtr(args.Category).String, null.StringFromPtr(args.SubCategory).String).Inc()
 }
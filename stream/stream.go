@@ -0,0 +1,435 @@
+package stream
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lbryio/chainquery/daemon/processing"
+	"github.com/lbryio/chainquery/metrics"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Types of messages.
+const (
+	TypeBlock       = "block"
+	TypeTransaction = "transaction"
+	TypeClaim       = "claim"
+	TypePayment     = "payment"
+	// TypeReorg tells clients the blocks from its height on were replaced, they are streamed again.
+	TypeReorg = "reorg"
+)
+
+// Types are the message types clients can select.
+var Types = []string{TypeBlock, TypeTransaction, TypeClaim, TypePayment, TypeReorg}
+
+// MaxResumeBlocks is how far back from the head a client can resume.
+const MaxResumeBlocks = 1000
+
+// PollInterval is how often the stream looks for new blocks and mempool transactions.
+var PollInterval = time.Second
+
+// reorgWindow is how many blocks below the head are checked for a reorg.
+const reorgWindow = 100
+
+// replayBatchBlocks is the number of blocks loaded at once while a client catches up.
+const replayBatchBlocks = 50
+
+// clientBuffer is the number of messages a client can lag behind before it is dropped.
+const clientBuffer = 1000
+
+// Message is a message of the stream. Messages of mined blocks have the height of their block, mempool transactions
+// have none.
+type Message struct {
+	Type   string      `json:"type"`
+	Height uint64      `json:"height,omitempty"`
+	Data   interface{} `json:"data"`
+
+	addresses []string
+	channelID string
+	name      string
+}
+
+// Block is the data of a block message.
+type Block struct {
+	Hash              string      `boil:"hash" json:"hash"`
+	Height            uint64      `boil:"height" json:"height"`
+	PreviousBlockHash null.String `boil:"previous_block_hash" json:"previous_block_hash"`
+	BlockTime         uint64      `boil:"block_time" json:"block_time"`
+	TXCount           int         `boil:"tx_count" json:"tx_count"`
+}
+
+// Transaction is the data of a mempool transaction message, with the addresses it debits or credits.
+type Transaction struct {
+	ID        uint64   `boil:"id" json:"-"`
+	Hash      string   `boil:"hash" json:"tx_id"`
+	Value     float64  `boil:"value" json:"value"`
+	Addresses []string `boil:"-" json:"addresses"`
+
+	AddressList null.String `boil:"addresses" json:"-"`
+}
+
+// Claim is the data of a claim message, sent for new claims and updates.
+type Claim struct {
+	ClaimID         string      `boil:"claim_id" json:"claim_id"`
+	Name            string      `boil:"name" json:"name"`
+	Type            null.String `boil:"type" json:"type"`
+	Title           null.String `boil:"title" json:"title"`
+	ThumbnailURL    null.String `boil:"thumbnail_url" json:"thumbnail_url"`
+	ChannelClaimID  null.String `boil:"publisher_id" json:"channel_claim_id"`
+	TransactionHash string      `boil:"transaction_hash" json:"tx_id"`
+	Vout            uint        `boil:"vout" json:"vout"`
+	BidState        string      `boil:"bid_state" json:"bid_state"`
+	Height          uint64      `boil:"height" json:"height"`
+}
+
+// Payment is the data of a payment message, LBC credited to an address by a transaction.
+type Payment struct {
+	TransactionHash string  `boil:"transaction_hash" json:"tx_id"`
+	Address         string  `boil:"address" json:"address"`
+	Amount          float64 `boil:"amount" json:"amount"`
+	Height          uint64  `boil:"height" json:"height"`
+}
+
+// Filter selects the messages of a client. A list left empty selects everything. Addresses select the payments and
+// mempool transactions, channel ids and names the claims: a claim is selected if it is in one of the channels or has
+// one of the names.
+type Filter struct {
+	Types      []string
+	Addresses  []string
+	ChannelIDs []string
+	Names      []string
+}
+
+// Matches tells if the filter selects a message.
+func (f Filter) Matches(m Message) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, m.Type) {
+		return false
+	}
+	switch m.Type {
+	case TypePayment, TypeTransaction:
+		if len(f.Addresses) == 0 {
+			return true
+		}
+		for _, address := range m.addresses {
+			if slices.Contains(f.Addresses, address) {
+				return true
+			}
+		}
+		return false
+	case TypeClaim:
+		if len(f.ChannelIDs) == 0 && len(f.Names) == 0 {
+			return true
+		}
+		return slices.Contains(f.ChannelIDs, m.channelID) || slices.Contains(f.Names, m.name)
+	}
+	return true
+}
+
+type hub struct {
+	mu            sync.Mutex
+	running       bool
+	clients       map[chan Message]struct{}
+	head          uint64
+	hashes        map[uint64]string
+	lastMempoolID uint64
+}
+
+var streamHub = &hub{clients: make(map[chan Message]struct{})}
+
+// Subscribe subscribes a client to the live messages. It returns the height of the last block streamed, the messages
+// received are all past it, and a function to unsubscribe. The channel is closed if the client falls too far behind.
+func Subscribe() (<-chan Message, uint64, func(), error) {
+	h := streamHub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.running {
+		err := h.start()
+		if err != nil {
+			return nil, 0, nil, err
+		}
+	}
+	client := make(chan Message, clientBuffer)
+	h.clients[client] = struct{}{}
+	metrics.StreamClients.Inc()
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.clients[client]; ok {
+			delete(h.clients, client)
+			close(client)
+			metrics.StreamClients.Dec()
+		}
+	}
+	return client, h.head, unsubscribe, nil
+}
+
+// start starts polling from the current head. It is called with the lock held.
+func (h *hub) start() error {
+	head, err := headHeight()
+	if err != nil {
+		return err
+	}
+	var last struct {
+		ID null.Uint64 `boil:"id"`
+	}
+	err = queries.Raw(`SELECT MAX(id) AS id FROM transaction`).BindG(context.Background(), &last)
+	if err != nil {
+		return errors.Err(err)
+	}
+	h.head = head
+	h.hashes = make(map[uint64]string)
+	h.lastMempoolID = last.ID.Uint64
+	h.running = true
+	go h.poll()
+	return nil
+}
+
+// poll streams the new blocks and mempool transactions until no client is left.
+func (h *hub) poll() {
+	for {
+		time.Sleep(PollInterval)
+		h.mu.Lock()
+		if len(h.clients) == 0 {
+			h.running = false
+			h.mu.Unlock()
+			return
+		}
+		head := h.head
+		h.mu.Unlock()
+
+		err := h.pollBlocks(head)
+		if err != nil {
+			logrus.Error(errors.Prefix("Stream", err))
+		}
+		err = h.pollMempool()
+		if err != nil {
+			logrus.Error(errors.Prefix("Stream", err))
+		}
+	}
+}
+
+type blockState struct {
+	Hash            string      `boil:"hash"`
+	Height          uint64      `boil:"height"`
+	ProcessingState null.String `boil:"processing_state"`
+}
+
+// pollBlocks streams the blocks completed on top of the head, after the reorg of the blocks replaced if any. Blocks
+// are streamed in order, so the head only moves up to the first block still being processed.
+func (h *hub) pollBlocks(head uint64) error {
+	from := uint64(0)
+	if head > reorgWindow {
+		from = head - reorgWindow
+	}
+	var blocks []blockState
+	err := queries.Raw(`
+		SELECT hash, height, processing_state FROM block WHERE height > ? ORDER BY height LIMIT ?`,
+		from, reorgWindow+replayBatchBlocks).BindG(context.Background(), &blocks)
+	if err != nil {
+		return errors.Err(err)
+	}
+	h.mu.Lock()
+	for _, block := range blocks {
+		hash, ok := h.hashes[block.Height]
+		if block.Height <= head && ok && hash != block.Hash {
+			for height := range h.hashes {
+				if height >= block.Height {
+					delete(h.hashes, height)
+				}
+			}
+			head = block.Height - 1
+			h.broadcast(Message{Type: TypeReorg, Height: block.Height, Data: map[string]uint64{"height": block.Height}})
+			break
+		}
+	}
+	h.head = head
+	h.mu.Unlock()
+
+	newHead := head
+	for _, block := range blocks {
+		if block.Height <= newHead {
+			continue
+		}
+		if block.Height != newHead+1 || !isComplete(block.ProcessingState) {
+			break
+		}
+		newHead = block.Height
+	}
+	if newHead == head {
+		return nil
+	}
+	messages, err := MessagesOfBlocks(head+1, newHead)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.head != head {
+		return nil
+	}
+	for _, message := range messages {
+		h.broadcast(message)
+	}
+	for _, block := range blocks {
+		if block.Height > head && block.Height <= newHead {
+			h.hashes[block.Height] = block.Hash
+		}
+		if newHead > reorgWindow && block.Height < newHead-reorgWindow {
+			delete(h.hashes, block.Height)
+		}
+	}
+	h.head = newHead
+	return nil
+}
+
+// pollMempool streams the transactions added to the mempool, once their addresses are stored.
+func (h *hub) pollMempool() error {
+	h.mu.Lock()
+	lastID := h.lastMempoolID
+	h.mu.Unlock()
+	var transactions []*Transaction
+	err := queries.Raw(`
+		SELECT t.id, t.hash, t.value, GROUP_CONCAT(DISTINCT a.address) AS addresses
+		FROM transaction t
+		LEFT JOIN transaction_address ta ON ta.transaction_id = t.id
+		LEFT JOIN address a ON a.id = ta.address_id
+		WHERE t.id > ? AND t.block_hash_id = ? AND t.created_at < DATE_SUB(NOW(), INTERVAL 2 SECOND)
+		GROUP BY t.id
+		ORDER BY t.id
+		LIMIT 500`, lastID, processing.MempoolBlockHash).BindG(context.Background(), &transactions)
+	if err != nil {
+		return errors.Err(err)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, tx := range transactions {
+		tx.Addresses = []string{}
+		if tx.AddressList.String != "" {
+			tx.Addresses = strings.Split(tx.AddressList.String, ",")
+		}
+		h.broadcast(Message{Type: TypeTransaction, Data: tx, addresses: tx.Addresses})
+		h.lastMempoolID = tx.ID
+	}
+	return nil
+}
+
+// broadcast sends a message to the clients, dropping those too far behind. It is called with the lock held.
+func (h *hub) broadcast(message Message) {
+	for client := range h.clients {
+		select {
+		case client <- message:
+		default:
+			delete(h.clients, client)
+			close(client)
+			metrics.StreamClients.Dec()
+		}
+	}
+}
+
+// Replay sends the messages of the blocks between two heights, included, in order.
+func Replay(from, to uint64, send func(Message) error) error {
+	for start := from; start <= to; start += replayBatchBlocks {
+		end := min(start+replayBatchBlocks-1, to)
+		messages, err := MessagesOfBlocks(start, end)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			err := send(message)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MessagesOfBlocks loads the messages of the blocks between two heights, included: each block followed by its claims
+// and payments.
+func MessagesOfBlocks(from, to uint64) ([]Message, error) {
+	var blocks []*Block
+	err := queries.Raw(`
+		SELECT hash, height, previous_block_hash, block_time, tx_count
+		FROM block
+		WHERE height BETWEEN ? AND ?
+		ORDER BY height`, from, to).BindG(context.Background(), &blocks)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Err(err)
+	}
+	var claims []*Claim
+	err = queries.Raw(`
+		SELECT claim_id, name, type, title, thumbnail_url, publisher_id,
+			COALESCE(transaction_hash_update, transaction_hash_id) AS transaction_hash, COALESCE(vout_update, vout) AS vout,
+			bid_state, height
+		FROM claim
+		WHERE height BETWEEN ? AND ?
+		ORDER BY height, id`, from, to).BindG(context.Background(), &claims)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Err(err)
+	}
+	var payments []*Payment
+	err = queries.Raw(`
+		SELECT t.hash AS transaction_hash, a.address, ta.credit_amount AS amount, b.height
+		FROM block b
+		INNER JOIN transaction t ON t.block_hash_id = b.hash
+		INNER JOIN transaction_address ta ON ta.transaction_id = t.id
+		INNER JOIN address a ON a.id = ta.address_id
+		WHERE b.height BETWEEN ? AND ? AND ta.credit_amount > 0
+		ORDER BY b.height, t.id, a.id`, from, to).BindG(context.Background(), &payments)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Err(err)
+	}
+
+	messages := make([]Message, 0, len(blocks)+len(claims)+len(payments))
+	c, p := 0, 0
+	for _, block := range blocks {
+		messages = append(messages, Message{Type: TypeBlock, Height: block.Height, Data: block})
+		for ; c < len(claims) && claims[c].Height <= block.Height; c++ {
+			claim := claims[c]
+			messages = append(messages, Message{
+				Type:      TypeClaim,
+				Height:    claim.Height,
+				Data:      claim,
+				channelID: claim.ChannelClaimID.String,
+				name:      claim.Name,
+			})
+		}
+		for ; p < len(payments) && payments[p].Height <= block.Height; p++ {
+			payment := payments[p]
+			messages = append(messages, Message{
+				Type:      TypePayment,
+				Height:    payment.Height,
+				Data:      payment,
+				addresses: []string{payment.Address},
+			})
+		}
+	}
+	return messages, nil
+}
+
+// headHeight is the height of the last block processed.
+func headHeight() (uint64, error) {
+	var head struct {
+		Height null.Uint64 `boil:"height"`
+	}
+	err := queries.Raw(`
+		SELECT MAX(height) AS height FROM block WHERE processing_state IS NULL OR processing_state = ?`,
+		processing.BlockProcessingStateComplete).BindG(context.Background(), &head)
+	if err != nil {
+		return 0, errors.Err(err)
+	}
+	return head.Height.Uint64, nil
+}
+
+func isComplete(state null.String) bool {
+	return !state.Valid || state.String == processing.BlockProcessingStateComplete
+}
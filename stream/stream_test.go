@@ -0,0 +1,194 @@
+package stream
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/lbryio/chainquery/daemon/processing"
+
+	"github.com/lbryio/lbry.go/v2/extras/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestFilterMatches(t *testing.T) {
+	payment := Message{Type: TypePayment, addresses: []string{"bAddress"}}
+	claim := Message{Type: TypeClaim, channelID: "channel", name: "name"}
+	block := Message{Type: TypeBlock}
+
+	testCases := []struct {
+		filter   Filter
+		message  Message
+		expected bool
+	}{
+		{Filter{}, payment, true},
+		{Filter{Types: []string{TypeBlock}}, payment, false},
+		{Filter{Addresses: []string{"bAddress"}}, payment, true},
+		{Filter{Addresses: []string{"bOther"}}, payment, false},
+		{Filter{Addresses: []string{"bOther"}}, block, true},
+		{Filter{Addresses: []string{"bOther"}}, claim, true},
+		{Filter{ChannelIDs: []string{"other"}, Names: []string{"name"}}, claim, true},
+		{Filter{ChannelIDs: []string{"other"}}, claim, false},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.filter.Matches(testCase.message); got != testCase.expected {
+			t.Errorf("case %d: expected %t, got %t", i, testCase.expected, got)
+		}
+	}
+}
+
+func mockDB(t *testing.T) sqlmock.Sqlmock {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := boil.GetDB()
+	boil.SetDB(db)
+	t.Cleanup(func() {
+		boil.SetDB(old)
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		_ = db.Close()
+	})
+	return mock
+}
+
+// expectMessagesOfBlocks expects the queries of the messages of blocks between two heights, without claims or payments.
+func expectMessagesOfBlocks(mock sqlmock.Sqlmock, from, to uint64, hashes map[uint64]string) {
+	blocks := sqlmock.NewRows([]string{"hash", "height", "previous_block_hash", "block_time", "tx_count"})
+	for height := from; height <= to; height++ {
+		blocks.AddRow(hashes[height], height, hashes[height-1], 1700000000+height, 1)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM block")).WithArgs(from, to).WillReturnRows(blocks)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM claim")).WithArgs(from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"claim_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM block b")).WithArgs(from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_hash"}))
+}
+
+// summary lists the messages as type height, with the hash of the blocks.
+func summary(messages []Message) []string {
+	var lines []string
+	for _, message := range messages {
+		line := message.Type + " " + strconv.FormatUint(message.Height, 10)
+		if block, ok := message.Data.(*Block); ok {
+			line += " " + block.Hash
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func received(client chan Message) []Message {
+	var messages []Message
+	for len(client) > 0 {
+		messages = append(messages, <-client)
+	}
+	return messages
+}
+
+func TestPollBlocksStreamsReorg(t *testing.T) {
+	mock := mockDB(t)
+	client := make(chan Message, clientBuffer)
+	h := &hub{clients: map[chan Message]struct{}{client: {}}, head: 10, hashes: map[uint64]string{9: "a9", 10: "a10"}}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT hash, height, processing_state FROM block")).
+		WithArgs(0, reorgWindow+replayBatchBlocks).
+		WillReturnRows(sqlmock.NewRows([]string{"hash", "height", "processing_state"}).
+			AddRow("a9", 9, processing.BlockProcessingStateComplete).
+			AddRow("b10", 10, processing.BlockProcessingStateComplete).
+			AddRow("b11", 11, nil).
+			AddRow("b12", 12, "pending"))
+	expectMessagesOfBlocks(mock, 10, 11, map[uint64]string{9: "a9", 10: "b10", 11: "b11"})
+	err := h.pollBlocks(h.head)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"reorg 10", "block 10 b10", "block 11 b11"}
+	if got := summary(received(client)); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected messages %q, got %q", expected, got)
+	}
+	if h.head != 11 || !reflect.DeepEqual(h.hashes, map[uint64]string{9: "a9", 10: "b10", 11: "b11"}) {
+		t.Fatalf("unexpected head %d with hashes %v", h.head, h.hashes)
+	}
+}
+
+func TestReplayInBatches(t *testing.T) {
+	mock := mockDB(t)
+	hashes := map[uint64]string{}
+	for height := uint64(0); height <= 60; height++ {
+		hashes[height] = "h" + strconv.FormatUint(height, 10)
+	}
+	expectMessagesOfBlocks(mock, 1, replayBatchBlocks, hashes)
+	expectMessagesOfBlocks(mock, replayBatchBlocks+1, 60, hashes)
+
+	var messages []Message
+	err := Replay(1, 60, func(message Message) error {
+		messages = append(messages, message)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 60 || messages[0].Height != 1 || messages[59].Height != 60 {
+		t.Fatalf("expected the blocks 1 to 60 in order, got %q", summary(messages))
+	}
+}
+
+func TestReplayStopsOnSendError(t *testing.T) {
+	mock := mockDB(t)
+	expectMessagesOfBlocks(mock, 1, 2, map[uint64]string{1: "h1", 2: "h2"})
+
+	sent := 0
+	err := Replay(1, 2, func(Message) error {
+		sent++
+		return errors.Base("client gone")
+	})
+	if err == nil || sent != 1 {
+		t.Fatalf("expected the replay to stop at the first error, sent %d (%v)", sent, err)
+	}
+}
+
+func TestHubFansOutAndDropsSlowClients(t *testing.T) {
+	defer func(h *hub) { streamHub = h }(streamHub)
+	streamHub = &hub{running: true, head: 7, clients: make(map[chan Message]struct{})}
+
+	first, head, unsubscribeFirst, err := Subscribe()
+	if err != nil || head != 7 {
+		t.Fatalf("expected to subscribe at head 7, got %d (%v)", head, err)
+	}
+	second, _, unsubscribeSecond, err := Subscribe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	slow := make(chan Message)
+	streamHub.clients[slow] = struct{}{}
+
+	streamHub.mu.Lock()
+	streamHub.broadcast(Message{Type: TypeBlock, Height: 8})
+	streamHub.mu.Unlock()
+	for _, client := range []<-chan Message{first, second} {
+		if message := <-client; message.Height != 8 {
+			t.Fatalf("expected block 8, got %+v", message)
+		}
+	}
+	if _, ok := <-slow; ok {
+		t.Fatal("expected the slow client to be closed")
+	}
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	if _, ok := <-first; ok {
+		t.Fatal("expected the client to be closed when it unsubscribes")
+	}
+	if len(streamHub.clients) != 1 {
+		t.Fatalf("expected a single client left, got %d", len(streamHub.clients))
+	}
+	unsubscribeSecond()
+}
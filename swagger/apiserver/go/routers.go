@@ -42,6 +42,12 @@ func NewRouter() *mux.Router {
 			Handler(handler)
 	}
 
+	router.
+		Methods(strings.ToUpper("Get")).
+		Path("/api/stream").
+		Name("Stream").
+		Handler(Logger(http.HandlerFunc(StreamAction), "Stream"))
+
 	router.Handle("/metrics", promBasicAuthWrapper(promhttp.Handler()))
 
 	return router
@@ -69,6 +75,27 @@ var routes = Routes{
 		AddressSummaryAction,
 	},
 
+	Route{
+		"AddressLabels",
+		strings.ToUpper("Get"),
+		"/api/addresslabels",
+		AddressLabelsAction,
+	},
+
+	Route{
+		"SetAddressLabel",
+		strings.ToUpper("Get"),
+		"/api/addresslabels/set",
+		SetAddressLabelAction,
+	},
+
+	Route{
+		"RemoveAddressLabel",
+		strings.ToUpper("Get"),
+		"/api/addresslabels/remove",
+		RemoveAddressLabelAction,
+	},
+
 	Route{
 		"ChainQueryStatus",
 		strings.ToUpper("Get"),
@@ -110,6 +137,125 @@ var routes = Routes{
 		"/api/sync/txvalues",
 		SyncTransactionValue,
 	},
+
+	Route{
+		"ClaimVersions",
+		strings.ToUpper("Get"),
+		"/api/claim/{claim_id}/versions",
+		ClaimVersionsAction,
+	},
+
+	Route{
+		"ClaimSupporters",
+		strings.ToUpper("Get"),
+		"/api/claim/{claim_id}/supporters",
+		ClaimSupportersAction,
+	},
+
+	Route{
+		"ChannelTips",
+		strings.ToUpper("Get"),
+		"/api/channel/{claim_id}/tips",
+		ChannelTipsAction,
+	},
+
+	Route{
+		"ChannelStats",
+		strings.ToUpper("Get"),
+		"/api/channel/{claim_id}/stats",
+		ChannelStatsAction,
+	},
+
+	Route{
+		"ClaimOriginal",
+		strings.ToUpper("Get"),
+		"/api/claim/{claim_id}/original",
+		ClaimOriginalAction,
+	},
+
+	Route{
+		"NameState",
+		strings.ToUpper("Get"),
+		"/api/name/{name}",
+		NameStateAction,
+	},
+
+	Route{
+		"NameHistory",
+		strings.ToUpper("Get"),
+		"/api/name/{name}/history",
+		NameHistoryAction,
+	},
+
+	Route{
+		"Collection",
+		strings.ToUpper("Get"),
+		"/api/collection/{claim_id}",
+		CollectionAction,
+	},
+
+	Route{
+		"Search",
+		strings.ToUpper("Get"),
+		"/api/search",
+		SearchAction,
+	},
+
+	Route{
+		"Resolve",
+		strings.ToUpper("Get"),
+		"/api/resolve",
+		ResolveAction,
+	},
+
+	Route{
+		"ChainStats",
+		strings.ToUpper("Get"),
+		"/api/chainstats",
+		ChainStatsAction,
+	},
+
+	Route{
+		"NotificationOutbox",
+		strings.ToUpper("Get"),
+		"/api/notifications/outbox",
+		NotificationOutboxAction,
+	},
+
+	Route{
+		"ReplayNotifications",
+		strings.ToUpper("Get"),
+		"/api/notifications/replay",
+		ReplayNotificationsAction,
+	},
+
+	Route{
+		"NotificationStatus",
+		strings.ToUpper("Get"),
+		"/api/notifications/status",
+		NotificationStatusAction,
+	},
+
+	Route{
+		"NotificationSubscribers",
+		strings.ToUpper("Get"),
+		"/api/notifications/subscribers",
+		NotificationSubscribersAction,
+	},
+
+	Route{
+		"AddNotificationSubscriber",
+		strings.ToUpper("Post"),
+		"/api/notifications/subscribers/add",
+		AddNotificationSubscriberAction,
+	},
+
+	Route{
+		"RemoveNotificationSubscriber",
+		strings.ToUpper("Post"),
+		"/api/notifications/subscribers/remove",
+		RemoveNotificationSubscriberAction,
+	},
 }
 
 var PromPassword string
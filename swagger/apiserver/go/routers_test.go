@@ -17,12 +17,33 @@ func TestRouterExposesExpectedRoutes(t *testing.T) {
 		{method: http.MethodGet, path: "/api/"},
 		{method: http.MethodGet, path: "/api/sql"},
 		{method: http.MethodGet, path: "/api/addresssummary"},
+		{method: http.MethodGet, path: "/api/addresslabels"},
+		{method: http.MethodGet, path: "/api/addresslabels/set"},
+		{method: http.MethodGet, path: "/api/addresslabels/remove"},
 		{method: http.MethodGet, path: "/api/status"},
 		{method: http.MethodGet, path: "/api/validate"},
 		{method: http.MethodGet, path: "/api/process"},
 		{method: http.MethodGet, path: "/api/sync/name"},
 		{method: http.MethodGet, path: "/api/sync/addresses"},
 		{method: http.MethodGet, path: "/api/sync/txvalues"},
+		{method: http.MethodGet, path: "/api/claim/abc123/versions"},
+		{method: http.MethodGet, path: "/api/claim/abc123/supporters"},
+		{method: http.MethodGet, path: "/api/channel/abc123/tips"},
+		{method: http.MethodGet, path: "/api/channel/abc123/stats"},
+		{method: http.MethodGet, path: "/api/claim/abc123/original"},
+		{method: http.MethodGet, path: "/api/name/abc123"},
+		{method: http.MethodGet, path: "/api/name/abc123/history"},
+		{method: http.MethodGet, path: "/api/collection/abc123"},
+		{method: http.MethodGet, path: "/api/search"},
+		{method: http.MethodGet, path: "/api/resolve"},
+		{method: http.MethodGet, path: "/api/chainstats"},
+		{method: http.MethodGet, path: "/api/notifications/outbox"},
+		{method: http.MethodGet, path: "/api/notifications/replay"},
+		{method: http.MethodGet, path: "/api/notifications/status"},
+		{method: http.MethodGet, path: "/api/notifications/subscribers"},
+		{method: http.MethodPost, path: "/api/notifications/subscribers/add"},
+		{method: http.MethodPost, path: "/api/notifications/subscribers/remove"},
+		{method: http.MethodGet, path: "/api/stream"},
 		{method: http.MethodGet, path: "/metrics"},
 	}
 